@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -48,8 +49,10 @@ import (
 	wasm "github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/spf13/cast"
 
 	"scontract/docs"
+
 	pointsmodulekeeper "scontract/x/points/keeper"
 	scontractmodulekeeper "scontract/x/scontract/keeper"
 )
@@ -93,6 +96,7 @@ type App struct {
 	GovKeeper             *govkeeper.Keeper
 	UpgradeKeeper         *upgradekeeper.Keeper
 	AuthzKeeper           authzkeeper.Keeper
+	FeeGrantKeeper        feegrantkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
 	CircuitBreakerKeeper  circuitkeeper.Keeper
 	ParamsKeeper          paramskeeper.Keeper
@@ -111,6 +115,10 @@ type App struct {
 	ScontractKeeper scontractmodulekeeper.Keeper
 	PointsKeeper    pointsmodulekeeper.Keeper
 	WasmKey         *storetypes.KVStoreKey
+
+	// omitPrunedHistory makes export leave out the points transactions past
+	// their retention.
+	omitPrunedHistory bool
 }
 
 func init() {
@@ -188,6 +196,7 @@ func New(
 		&app.GovKeeper,
 		&app.UpgradeKeeper,
 		&app.AuthzKeeper,
+		&app.FeeGrantKeeper,
 		&app.ConsensusParamsKeeper,
 		&app.CircuitBreakerKeeper,
 		&app.ParamsKeeper,
@@ -232,6 +241,14 @@ func New(
 
 	app.sm.RegisterStoreDecoders()
 
+	app.registerUpgradeHandlers()
+
+	app.omitPrunedHistory = cast.ToBool(appOpts.Get(FlagOmitPrunedHistory))
+
+	if err := app.setAnteHandler(); err != nil {
+		panic(err)
+	}
+
 	// A custom InitChainer sets if extra pre-init-genesis logic is required.
 	// This is necessary for manually registered modules that do not support app wiring.
 	// Manually set the module version map as shown below.
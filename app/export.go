@@ -13,8 +13,14 @@ import (
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	pointstypes "scontract/x/points/types"
 )
 
+// FlagOmitPrunedHistory makes export roll the points transactions past their
+// retention into checkpoints instead of exporting them.
+const FlagOmitPrunedHistory = "points-omit-pruned-history"
+
 // ExportAppStateAndValidators exports the state of the application for a genesis
 // file.
 func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
@@ -33,6 +39,15 @@ func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs
 	if err != nil {
 		return servertypes.ExportedApp{}, err
 	}
+	if _, ok := genState[pointstypes.ModuleName]; ok && app.omitPrunedHistory {
+		pointsGenesis, err := app.PointsKeeper.ExportCompactGenesis(ctx)
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		if genState[pointstypes.ModuleName], err = app.appCodec.MarshalJSON(pointsGenesis); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	}
 
 	appState, err := json.MarshalIndent(genState, "", "  ")
 	if err != nil {
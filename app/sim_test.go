@@ -22,6 +22,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -56,6 +58,66 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// invariantRegistry collects the invariants registered by the app modules.
+type invariantRegistry struct {
+	routes     []string
+	invariants []sdk.Invariant
+}
+
+func (r *invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	r.routes = append(r.routes, moduleName+"/"+route)
+	r.invariants = append(r.invariants, invar)
+}
+
+// appInvariants returns the invariants of every app module that registers
+// them.
+func appInvariants(app *App) *invariantRegistry {
+	registry := &invariantRegistry{}
+	for _, m := range app.ModuleManager.Modules {
+		if m, ok := m.(module.HasInvariants); ok {
+			m.RegisterInvariants(registry)
+		}
+	}
+	return registry
+}
+
+// requireInvariants fails t if any invariant of registry is broken in ctx.
+func requireInvariants(t *testing.T, registry *invariantRegistry, ctx sdk.Context) {
+	t.Helper()
+
+	for i, invar := range registry.invariants {
+		msg, broken := invar(ctx)
+		require.False(t, broken, "invariant %s broken: %s", registry.routes[i], msg)
+	}
+}
+
+// assertInvariants runs the invariants of every app module that registers
+// them against the latest committed state of app.
+func assertInvariants(t *testing.T, app *App) {
+	t.Helper()
+
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	requireInvariants(t, appInvariants(app), ctx)
+}
+
+// withInvariantChecks wraps ops so that the invariants of app are checked
+// against the state every delivered operation leaves behind, instead of
+// only once the simulation ends.
+func withInvariantChecks(t *testing.T, app *App, ops []simulationtypes.WeightedOperation) []simulationtypes.WeightedOperation {
+	registry := appInvariants(app)
+	wrapped := make([]simulationtypes.WeightedOperation, len(ops))
+	for i, op := range ops {
+		wrapped[i] = simulation.NewWeightedOperation(op.Weight(), func(r *rand.Rand, bapp *baseapp.BaseApp, ctx sdk.Context, accs []simulationtypes.Account, chainID string) (simulationtypes.OperationMsg, []simulationtypes.FutureOperation, error) {
+			opMsg, futureOps, err := op.Op()(r, bapp, ctx, accs, chainID)
+			if err == nil && opMsg.OK {
+				requireInvariants(t, registry, ctx)
+			}
+			return opMsg, futureOps, err
+		})
+	}
+	return wrapped
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using ignite command:
 // `ignite chain simulate -v --numBlocks 200 --blockSize 50`
@@ -141,7 +203,7 @@ func TestFullAppSimulation(t *testing.T) {
 		app.BaseApp,
 		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.BuildSimulationOperations(app, app.AppCodec(), config, app.TxConfig()),
+		withInvariantChecks(t, app, simtestutil.BuildSimulationOperations(app, app.AppCodec(), config, app.TxConfig())),
 		BlockedAddresses(),
 		config,
 		app.AppCodec(),
@@ -151,6 +213,7 @@ func TestFullAppSimulation(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	assertInvariants(t, app)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -195,6 +258,7 @@ func TestAppImportExport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	assertInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -236,6 +300,7 @@ func TestAppImportExport(t *testing.T) {
 	require.NoError(t, err)
 	err = newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
 	require.NoError(t, err)
+	assertInvariants(t, newApp)
 	fmt.Printf("comparing stores...\n")
 
 	// skip certain prefixes
@@ -315,6 +380,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	assertInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -361,6 +427,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		bApp.AppCodec(),
 	)
 	require.NoError(t, err)
+	assertInvariants(t, newApp)
 }
 
 func TestAppStateDeterminism(t *testing.T) {
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// UpgradeName is the software upgrade that migrates the x/points store to
+// version 3, turning transaction types and settlement statuses into enums.
+const UpgradeName = "points-v3"
+
+// registerUpgradeHandlers registers the handlers of the software upgrades
+// this binary can apply.
+func (app *App) registerUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+}
@@ -47,6 +47,7 @@ func initRootCmd(
 	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{
 		AddFlags: addModuleInitFlags,
 	})
+	addExportFlags(rootCmd)
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
@@ -62,6 +63,15 @@ func initRootCmd(
 func addModuleInitFlags(startCmd *cobra.Command) {
 }
 
+// addExportFlags adds more flags to the export command.
+func addExportFlags(rootCmd *cobra.Command) {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "export" {
+			cmd.Flags().Bool(app.FlagOmitPrunedHistory, false, "Roll the points transactions past their retention into checkpoints instead of exporting them")
+		}
+	}
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -0,0 +1,195 @@
+package ante
+
+import (
+	"context"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"scontract/x/points/types"
+)
+
+// PointsKeeper defines the expected points keeper used to pay fees in points
+// and to look up transfer fees.
+type PointsKeeper interface {
+	FeePayment(ctx context.Context) (types.FeePayment, error)
+	PayFeeInPoints(ctx context.Context, programID, payer string, fee sdk.Coins, maxPoints uint64) (uint64, error)
+	TransferFee(ctx context.Context) (types.TransferFee, error)
+}
+
+// FeePaymentExtensionChecker accepts the FeePaymentOption extension option
+// and rejects every other one. It is meant for the ExtensionOptionChecker of
+// the SDK ante handler options.
+func FeePaymentExtensionChecker(any *codectypes.Any) bool {
+	_, ok := any.GetCachedValue().(*types.FeePaymentOption)
+	return ok
+}
+
+// GetFeePaymentOption returns the FeePaymentOption extension option of tx,
+// if it has one. A tx may not carry more than one.
+func GetFeePaymentOption(tx sdk.Tx) (*types.FeePaymentOption, bool, error) {
+	extTx, ok := tx.(sdkante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, false, nil
+	}
+
+	var found *types.FeePaymentOption
+	for _, any := range extTx.GetExtensionOptions() {
+		option, ok := any.GetCachedValue().(*types.FeePaymentOption)
+		if !ok {
+			continue
+		}
+		if found != nil {
+			return nil, false, errorsmod.Wrap(types.ErrInvalidFeePayment, "more than one fee payment option")
+		}
+		found = option
+	}
+	return found, found != nil, nil
+}
+
+// DeductFeeDecorator deducts the fee of a tx carrying a FeePaymentOption in
+// points of the fee payer, the fee itself being paid from the fee sponsor
+// pool. Any other tx is passed to the SDK DeductFeeDecorator.
+type DeductFeeDecorator struct {
+	accountKeeper sdkante.AccountKeeper
+	pointsKeeper  PointsKeeper
+	txFeeChecker  sdkante.TxFeeChecker
+	fallback      sdkante.DeductFeeDecorator
+}
+
+func NewDeductFeeDecorator(ak sdkante.AccountKeeper, bk authtypes.BankKeeper, fk sdkante.FeegrantKeeper, pk PointsKeeper, tfc sdkante.TxFeeChecker) DeductFeeDecorator {
+	fallback := sdkante.NewDeductFeeDecorator(ak, bk, fk, tfc)
+	if tfc == nil {
+		tfc = checkTxFeeWithValidatorMinGasPrices
+	}
+
+	return DeductFeeDecorator{
+		accountKeeper: ak,
+		pointsKeeper:  pk,
+		txFeeChecker:  tfc,
+		fallback:      fallback,
+	}
+}
+
+func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	option, ok, err := GetFeePaymentOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if !ok {
+		return dfd.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+	if feeTx.FeeGranter() != nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee grants cannot be used when paying fees in points")
+	}
+
+	var priority int64
+	fee := feeTx.GetFee()
+	if !simulate {
+		fee, priority, err = dfd.txFeeChecker(ctx, tx)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	payer := sdk.AccAddress(feeTx.FeePayer())
+	if dfd.accountKeeper.GetAccount(ctx, payer) == nil {
+		return ctx, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", payer)
+	}
+	payerStr, err := dfd.accountKeeper.AddressCodec().BytesToString(payer)
+	if err != nil {
+		return ctx, err
+	}
+
+	if !fee.IsZero() {
+		if _, err := dfd.pointsKeeper.PayFeeInPoints(ctx, option.ProgramId, payerStr, fee, option.MaxPoints); err != nil {
+			return ctx, err
+		}
+	} else if simulate {
+		dfd.simulatePayment(ctx, option, payerStr)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, payerStr),
+		),
+	})
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// simulatePayment pays a one unit fee in points on a discarded branch of the
+// state, so that simulating a tx without a fee still accounts for the gas of
+// paying it. Failures are ignored: the simulated tx has nothing to pay yet.
+func (dfd DeductFeeDecorator) simulatePayment(ctx sdk.Context, option *types.FeePaymentOption, payer string) {
+	feePayment, err := dfd.pointsKeeper.FeePayment(ctx)
+	if err != nil || feePayment.Denom == "" {
+		return
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	_, _ = dfd.pointsKeeper.PayFeeInPoints(cacheCtx, option.ProgramId, payer, sdk.NewCoins(sdk.NewInt64Coin(feePayment.Denom, 1)), 0)
+}
+
+// checkTxFeeWithValidatorMinGasPrices mirrors the SDK default TxFeeChecker:
+// in CheckTx the fee must meet the validator's minimum gas prices, and the
+// priority is the gas price of the fee.
+func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	if ctx.IsCheckTx() {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+
+			glDec := sdkmath.LegacyNewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+		}
+	}
+
+	return feeCoins, txPriority(feeCoins, int64(gas)), nil
+}
+
+// txPriority returns the lowest gas price among the fee coins.
+func txPriority(fee sdk.Coins, gas int64) int64 {
+	var priority int64
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(gas)
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+	return priority
+}
@@ -0,0 +1,402 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/ante"
+	"scontract/x/points/keeper"
+	module "scontract/x/points/module"
+	"scontract/x/points/types"
+)
+
+const (
+	testProgramID = "loyalty"
+	feeDenom      = "stake"
+)
+
+type fixture struct {
+	ctx           sdk.Context
+	keeper        keeper.Keeper
+	txConfig      client.TxConfig
+	bankKeeper    *mockBankKeeper
+	accountKeeper *mockAccountKeeper
+	decorator     ante.DeductFeeDecorator
+	payer         string
+}
+
+// initFixture sets up a points keeper charging two points per stake, a payer
+// holding 1000 points and 1000stake, and a fee sponsor pool of 1000stake.
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx.
+		WithBlockTime(time.Unix(1_700_000_000, 0)).
+		WithBlockHeight(10)
+
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	authorityStr, err := addressCodec.BytesToString(authority)
+	require.NoError(t, err)
+	bankKeeper := newMockBankKeeper()
+	k := keeper.NewKeeper(runtime.NewKVStoreService(storeKey), encCfg.Codec, addressCodec, authority, bankKeeper, nil)
+
+	params := types.DefaultParams()
+	params.FeePayment = types.FeePayment{Denom: feeDenom, PointsPerUnit: math.LegacyNewDec(2)}
+	require.NoError(t, k.Params.Set(ctx, params))
+	require.NoError(t, k.Program.Set(ctx, testProgramID, types.PointProgram{
+		Id:      testProgramID,
+		Name:    "Loyalty",
+		Owner:   authorityStr,
+		Issuers: []string{authorityStr},
+	}))
+
+	payer := sample.AccAddress()
+	ms := keeper.NewMsgServerImpl(k)
+	_, err = ms.AddIssuer(ctx, types.NewMsgAddIssuer(authorityStr, authorityStr, 0))
+	require.NoError(t, err)
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(authorityStr, testProgramID, payer, 1000, "test"))
+	require.NoError(t, err)
+
+	payerAddr := sdk.MustAccAddressFromBech32(payer)
+	bankKeeper.balances[payer] = sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1000))
+	bankKeeper.balances[authtypes.NewModuleAddress(types.FeeSponsorModuleName).String()] = sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1000))
+
+	accountKeeper := &mockAccountKeeper{
+		addressCodec: addressCodec,
+		accounts:     map[string]sdk.AccountI{payer: authtypes.NewBaseAccountWithAddress(payerAddr)},
+	}
+
+	return &fixture{
+		ctx:           ctx,
+		keeper:        k,
+		txConfig:      encCfg.TxConfig,
+		bankKeeper:    bankKeeper,
+		accountKeeper: accountKeeper,
+		decorator:     ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, nil, k, nil),
+		payer:         payer,
+	}
+}
+
+// buildTx builds a tx of the payer paying fee, with option as extension
+// option if it is not nil.
+func (f *fixture) buildTx(t *testing.T, fee sdk.Coins, option *types.FeePaymentOption) sdk.Tx {
+	t.Helper()
+
+	builder := f.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(types.NewMsgTransferPoints(f.payer, testProgramID, sample.AccAddress(), 1)))
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(100_000)
+	if option != nil {
+		any, err := codectypes.NewAnyWithValue(option)
+		require.NoError(t, err)
+		builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(any)
+	}
+	return builder.GetTx()
+}
+
+func (f *fixture) pointBalance(t *testing.T) uint64 {
+	t.Helper()
+
+	balance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, f.payer))
+	require.NoError(t, err)
+	return balance.Balance
+}
+
+func (f *fixture) coins(name string) sdk.Coins {
+	return f.bankKeeper.balances[authtypes.NewModuleAddress(name).String()]
+}
+
+func nextHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+func TestDeductFeeInPoints(t *testing.T) {
+	f := initFixture(t)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100))
+
+	_, err := f.decorator.AnteHandle(f.ctx, f.buildTx(t, fee, &types.FeePaymentOption{ProgramId: testProgramID}), false, nextHandler)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 800, f.pointBalance(t))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1000)), f.bankKeeper.balances[f.payer])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 900)), f.coins(types.FeeSponsorModuleName))
+	require.Equal(t, fee, f.coins(authtypes.FeeCollectorName))
+
+	supply, err := f.keeper.Supply.Get(f.ctx, testProgramID)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, supply.FeesPaid)
+	circulating, ok := supply.Circulating()
+	require.True(t, ok)
+	require.EqualValues(t, 800, circulating)
+
+	tx, err := f.keeper.Transaction.Get(f.ctx, collections.Join(testProgramID, uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, types.TransactionTypeFee, tx.TxType)
+	require.Equal(t, f.payer, tx.Sender)
+	require.EqualValues(t, 200, tx.Amount)
+}
+
+func TestDeductFeeFallsBackWithoutOption(t *testing.T) {
+	f := initFixture(t)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100))
+
+	_, err := f.decorator.AnteHandle(f.ctx, f.buildTx(t, fee, nil), false, nextHandler)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 1000, f.pointBalance(t))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 900)), f.bankKeeper.balances[f.payer])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1000)), f.coins(types.FeeSponsorModuleName))
+	require.Equal(t, fee, f.coins(authtypes.FeeCollectorName))
+}
+
+func TestDeductFeeInPointsErrors(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100))
+	option := &types.FeePaymentOption{ProgramId: testProgramID}
+
+	tests := []struct {
+		desc   string
+		setup  func(*fixture) sdk.Context
+		fee    sdk.Coins
+		option *types.FeePaymentOption
+		err    error
+	}{
+		{
+			desc:   "above max points",
+			fee:    fee,
+			option: &types.FeePaymentOption{ProgramId: testProgramID, MaxPoints: 199},
+			err:    types.ErrInvalidFeePayment,
+		},
+		{
+			desc:   "other fee denom",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			option: option,
+			err:    types.ErrInvalidFeePayment,
+		},
+		{
+			desc: "disabled",
+			setup: func(f *fixture) sdk.Context {
+				params, err := f.keeper.Params.Get(f.ctx)
+				require.NoError(t, err)
+				params.FeePayment = types.DefaultFeePayment
+				require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+				return f.ctx
+			},
+			fee:    fee,
+			option: option,
+			err:    types.ErrInvalidFeePayment,
+		},
+		{
+			desc:   "unknown program",
+			fee:    fee,
+			option: &types.FeePaymentOption{ProgramId: "other"},
+			err:    types.ErrProgramNotFound,
+		},
+		{
+			desc:   "not enough points",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 501)),
+			option: option,
+			err:    types.ErrInsufficientFunds,
+		},
+		{
+			desc: "empty sponsor pool",
+			setup: func(f *fixture) sdk.Context {
+				delete(f.bankKeeper.balances, authtypes.NewModuleAddress(types.FeeSponsorModuleName).String())
+				return f.ctx
+			},
+			fee:    fee,
+			option: option,
+			err:    sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			desc: "below min gas prices in check tx",
+			setup: func(f *fixture) sdk.Context {
+				return f.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(feeDenom, 1)))
+			},
+			fee:    fee,
+			option: option,
+			err:    sdkerrors.ErrInsufficientFee,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := initFixture(t)
+			ctx := f.ctx
+			if tc.setup != nil {
+				ctx = tc.setup(f)
+			}
+			_, err := f.decorator.AnteHandle(ctx, f.buildTx(t, tc.fee, tc.option), false, nextHandler)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestDeductFeeInPointsRejectsFeeGranter(t *testing.T) {
+	f := initFixture(t)
+
+	builder := f.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(types.NewMsgTransferPoints(f.payer, testProgramID, sample.AccAddress(), 1)))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)))
+	builder.SetGasLimit(100_000)
+	builder.SetFeeGranter(sdk.MustAccAddressFromBech32(sample.AccAddress()))
+	any, err := codectypes.NewAnyWithValue(&types.FeePaymentOption{ProgramId: testProgramID})
+	require.NoError(t, err)
+	builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(any)
+
+	_, err = f.decorator.AnteHandle(f.ctx, builder.GetTx(), false, nextHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestDeductFeeInPointsSimulate(t *testing.T) {
+	f := initFixture(t)
+	option := &types.FeePaymentOption{ProgramId: testProgramID}
+
+	// A simulated tx without fee charges the gas of paying one but no points.
+	ctx := f.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err := f.decorator.AnteHandle(ctx, f.buildTx(t, nil, option), true, nextHandler)
+	require.NoError(t, err)
+	withOption := ctx.GasMeter().GasConsumed()
+
+	ctx = f.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = f.decorator.AnteHandle(ctx, f.buildTx(t, nil, nil), true, nextHandler)
+	require.NoError(t, err)
+	require.Greater(t, withOption, ctx.GasMeter().GasConsumed())
+
+	require.EqualValues(t, 1000, f.pointBalance(t))
+
+	// A simulated tx with a fee is charged, skipping the min gas prices.
+	ctx = f.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(feeDenom, 1)))
+	_, err = f.decorator.AnteHandle(ctx, f.buildTx(t, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 10)), option), true, nextHandler)
+	require.NoError(t, err)
+	require.EqualValues(t, 980, f.pointBalance(t))
+}
+
+func TestFeePaymentExtensionChecker(t *testing.T) {
+	option, err := codectypes.NewAnyWithValue(&types.FeePaymentOption{ProgramId: testProgramID})
+	require.NoError(t, err)
+	require.True(t, ante.FeePaymentExtensionChecker(option))
+
+	other, err := codectypes.NewAnyWithValue(&types.MsgSpendPoints{})
+	require.NoError(t, err)
+	require.False(t, ante.FeePaymentExtensionChecker(other))
+}
+
+type mockAccountKeeper struct {
+	addressCodec address.Codec
+	accounts     map[string]sdk.AccountI
+}
+
+func (a *mockAccountKeeper) GetParams(context.Context) authtypes.Params {
+	return authtypes.DefaultParams()
+}
+
+func (a *mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return a.accounts[addr.String()]
+}
+
+func (a *mockAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	a.accounts[acc.GetAddress().String()] = acc
+}
+
+func (a *mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+func (a *mockAccountKeeper) AddressCodec() address.Codec {
+	return a.addressCodec
+}
+
+func (a *mockAccountKeeper) UnorderedTransactionsEnabled() bool {
+	return false
+}
+
+func (a *mockAccountKeeper) RemoveExpiredUnorderedNonces(sdk.Context) error {
+	return nil
+}
+
+func (a *mockAccountKeeper) TryAddUnorderedNonce(sdk.Context, []byte, time.Time) error {
+	return nil
+}
+
+// mockBankKeeper is an in-memory bank keeper that tracks account and module
+// account balances.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (b *mockBankKeeper) IsSendEnabledCoins(context.Context, ...sdk.Coin) error {
+	return nil
+}
+
+func (b *mockBankKeeper) SendCoins(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(from, to, amt)
+}
+
+func (b *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) MintCoins(context.Context, string, sdk.Coins) error {
+	return nil
+}
+
+func (b *mockBankKeeper) BurnCoins(context.Context, string, sdk.Coins) error {
+	return nil
+}
+
+func (b *mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewInt64Coin(denom, 0)
+}
+
+func (b *mockBankKeeper) HasDenomMetaData(context.Context, string) bool {
+	return false
+}
+
+func (b *mockBankKeeper) SetDenomMetaData(context.Context, banktypes.Metadata) {}
+
+func (b *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.balances[from.String()] = balance
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
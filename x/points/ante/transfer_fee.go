@@ -0,0 +1,23 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// TransferFeeDecorator puts the transfer fee lookup of the points keeper in
+// the context, so that a PointsSpendAuthorization executed by the tx can
+// count transfer fees against its spend limit. The params are only read
+// when an authorization needs them.
+type TransferFeeDecorator struct {
+	pointsKeeper PointsKeeper
+}
+
+func NewTransferFeeDecorator(pk PointsKeeper) TransferFeeDecorator {
+	return TransferFeeDecorator{pointsKeeper: pk}
+}
+
+func (tfd TransferFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(types.ContextWithTransferFee(ctx, tfd.pointsKeeper.TransferFee), tx, simulate)
+}
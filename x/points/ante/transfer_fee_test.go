@@ -0,0 +1,33 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/ante"
+	"scontract/x/points/types"
+)
+
+func TestTransferFeeDecorator(t *testing.T) {
+	f := initFixture(t)
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.TransferFee = types.TransferFee{Flat: 3}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	var txCtx sdk.Context
+	_, err = ante.NewTransferFeeDecorator(f.keeper).AnteHandle(f.ctx, f.buildTx(t, nil, nil), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		txCtx = ctx
+		return ctx, nil
+	})
+	require.NoError(t, err)
+
+	// authorizations executed by the tx count the fee of their transfers
+	auth := types.NewPointsTransferAuthorization(testProgramID, 20, nil, 0)
+	resp, err := auth.Accept(txCtx, types.NewMsgTransferPoints(f.payer, testProgramID, sample.AccAddress(), 10))
+	require.NoError(t, err)
+	require.EqualValues(t, 7, resp.Updated.(*types.PointsSpendAuthorization).SpendLimit)
+}
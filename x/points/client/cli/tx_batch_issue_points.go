@@ -0,0 +1,230 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"scontract/x/points/types"
+)
+
+const (
+	FlagBatchSize = "batch-size"
+	FlagExpiresIn = "expires-in"
+
+	// DefaultBatchSize is the number of entries put in one transaction when
+	// --batch-size is not given.
+	DefaultBatchSize = 500
+)
+
+// CmdBatchIssuePoints issues points to every entry of a CSV or JSON file,
+// splitting the entries over as many transactions as needed.
+func CmdBatchIssuePoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-issue-points [program-id] [file]",
+		Short: "Issue points to every recipient listed in a CSV or JSON file",
+		Long: `Issue points to every recipient listed in a CSV or JSON file.
+
+A CSV file holds one "recipient,amount[,reason[,order_reference]]" row per
+entry; a leading header row starting with "recipient" is skipped. A JSON file
+holds an array of objects:
+
+  {"recipient": "...", "amount": "...", "reason": "...",
+   "order_reference": "...", "metadata": {"key": "value"}}
+
+The entries are split into transactions of at most --batch-size entries. Each
+transaction is issued atomically; transactions are sent one after the other
+with increasing sequences.`,
+		Example: fmt.Sprintf("%s tx points batch-issue-points loyalty rewards.csv --batch-size 200 --from issuer", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			batchSize, err := cmd.Flags().GetInt(FlagBatchSize)
+			if err != nil {
+				return err
+			}
+			if batchSize <= 0 || batchSize > types.MaxBatchIssueEntries {
+				return fmt.Errorf("--%s must be between 1 and %d", FlagBatchSize, types.MaxBatchIssueEntries)
+			}
+			expiresIn, err := cmd.Flags().GetDuration(FlagExpiresIn)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			entries, err := ParseBatchIssueEntries(bz)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[1], err)
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			for _, chunk := range SplitBatchIssueEntries(entries, batchSize) {
+				msg := types.NewMsgBatchIssuePoints(creator, args[0], chunk)
+				if expiresIn > 0 {
+					msg.ExpiresIn = &expiresIn
+				}
+				if err := tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg); err != nil {
+					return err
+				}
+				txf = txf.WithSequence(txf.Sequence() + 1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagBatchSize, DefaultBatchSize, "Maximum number of entries per transaction")
+	cmd.Flags().Duration(FlagExpiresIn, 0, "Expiry of the issued points, overriding the point_expiry param (e.g. 720h)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ParseBatchIssueEntries reads batch issuance entries from CSV or JSON. The
+// input is treated as JSON when it starts with '['.
+func ParseBatchIssueEntries(bz []byte) ([]types.BatchIssueEntry, error) {
+	var (
+		entries []types.BatchIssueEntry
+		err     error
+	)
+	if trimmed := bytes.TrimSpace(bz); len(trimmed) > 0 && trimmed[0] == '[' {
+		entries, err = parseBatchIssueJSON(trimmed)
+	} else {
+		entries, err = parseBatchIssueCSV(bytes.NewReader(bz))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("no entries")
+	}
+
+	return entries, nil
+}
+
+func parseBatchIssueJSON(bz []byte) ([]types.BatchIssueEntry, error) {
+	var rows []struct {
+		Recipient      string            `json:"recipient"`
+		Amount         json.RawMessage   `json:"amount"`
+		Reason         string            `json:"reason"`
+		OrderReference string            `json:"order_reference"`
+		Metadata       map[string]string `json:"metadata"`
+	}
+	if err := json.Unmarshal(bz, &rows); err != nil {
+		return nil, err
+	}
+
+	entries := make([]types.BatchIssueEntry, 0, len(rows))
+	for i, row := range rows {
+		// amounts may be given as numbers or, like other uint64 JSON fields, as strings
+		amount, err := parseAmount(strings.Trim(string(row.Amount), `"`))
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		entry := types.BatchIssueEntry{
+			Recipient:      row.Recipient,
+			Amount:         amount,
+			Reason:         row.Reason,
+			OrderReference: row.OrderReference,
+		}
+		// map iteration order is random, sort the metadata so the transaction is deterministic
+		keys := slices.Sorted(maps.Keys(row.Metadata))
+		for _, key := range keys {
+			entry.Metadata = append(entry.Metadata, types.TransactionMetadata{Key: key, Value: row.Metadata[key]})
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func parseBatchIssueCSV(r io.Reader) ([]types.BatchIssueEntry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var entries []types.BatchIssueEntry
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "recipient") {
+			continue
+		}
+		if len(record) < 2 || len(record) > 4 {
+			return nil, fmt.Errorf("line %d: expected recipient,amount[,reason[,order_reference]]", line)
+		}
+
+		amount, err := parseAmount(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entry := types.BatchIssueEntry{Recipient: strings.TrimSpace(record[0]), Amount: amount}
+		if len(record) > 2 {
+			entry.Reason = strings.TrimSpace(record[2])
+		}
+		if len(record) > 3 {
+			entry.OrderReference = strings.TrimSpace(record[3])
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func parseAmount(s string) (uint64, error) {
+	amount, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	if amount == 0 {
+		return 0, errors.New("amount must be positive")
+	}
+
+	return amount, nil
+}
+
+// SplitBatchIssueEntries splits entries into chunks of at most size entries.
+func SplitBatchIssueEntries(entries []types.BatchIssueEntry, size int) [][]types.BatchIssueEntry {
+	chunks := make([][]types.BatchIssueEntry, 0, (len(entries)+size-1)/size)
+	for size < len(entries) {
+		entries, chunks = entries[size:], append(chunks, entries[:size:size])
+	}
+
+	return append(chunks, entries)
+}
@@ -0,0 +1,71 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"scontract/x/points/client/cli"
+	"scontract/x/points/types"
+)
+
+func TestParseBatchIssueEntries(t *testing.T) {
+	want := []types.BatchIssueEntry{
+		{Recipient: "cosmos1a", Amount: 10, Reason: "welcome"},
+		{Recipient: "cosmos1b", Amount: 20},
+	}
+
+	for _, tc := range []struct {
+		desc  string
+		input string
+		err   bool
+	}{
+		{desc: "csv", input: "cosmos1a,10,welcome\ncosmos1b,20\n"},
+		{desc: "csv with header", input: "recipient,amount,reason\ncosmos1a, 10, welcome\n# comment\ncosmos1b,20,\n"},
+		{desc: "json", input: ` [{"recipient":"cosmos1a","amount":10,"reason":"welcome"},{"recipient":"cosmos1b","amount":"20"}]`},
+		{desc: "empty", input: "recipient,amount,reason\n", err: true},
+		{desc: "zero amount", input: "cosmos1a,0\n", err: true},
+		{desc: "bad amount", input: `[{"recipient":"cosmos1a","amount":-1}]`, err: true},
+		{desc: "too many columns", input: "cosmos1a,10,welcome,order-1,extra\n", err: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			entries, err := cli.ParseBatchIssueEntries([]byte(tc.input))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, want, entries)
+		})
+	}
+}
+
+func TestSplitBatchIssueEntries(t *testing.T) {
+	entries := make([]types.BatchIssueEntry, 5)
+
+	chunks := cli.SplitBatchIssueEntries(entries, 2)
+	require.Len(t, chunks, 3)
+	require.Len(t, chunks[0], 2)
+	require.Len(t, chunks[2], 1)
+
+	require.Len(t, cli.SplitBatchIssueEntries(entries, 5), 1)
+	require.Len(t, cli.SplitBatchIssueEntries(entries, 500), 1)
+}
+
+func TestParseBatchIssueEntriesReference(t *testing.T) {
+	want := []types.BatchIssueEntry{{
+		Recipient:      "cosmos1a",
+		Amount:         10,
+		Reason:         "welcome",
+		OrderReference: "order-1",
+	}}
+
+	entries, err := cli.ParseBatchIssueEntries([]byte("cosmos1a,10,welcome,order-1\n"))
+	require.NoError(t, err)
+	require.Equal(t, want, entries)
+
+	want[0].Metadata = []types.TransactionMetadata{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}}
+	entries, err = cli.ParseBatchIssueEntries([]byte(`[{"recipient":"cosmos1a","amount":10,"reason":"welcome","order_reference":"order-1","metadata":{"b":"2","a":"1"}}]`))
+	require.NoError(t, err)
+	require.Equal(t, want, entries)
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/spf13/cobra"
+
+	"scontract/x/points/types"
+)
+
+const (
+	FlagAllowedMerchants  = "allowed-merchants"
+	FlagAllowedRecipients = "allowed-recipients"
+)
+
+// CmdGrantPointsAuthorization grants a PointsSpendAuthorization through
+// x/authz, whose own grant command only knows the built-in authorizations.
+func CmdGrantPointsAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-points-authorization [grantee] [spend|transfer] [program-id] [spend-limit]",
+		Short: "Allow another account to spend or transfer your points up to a limit",
+		Long: `Allow another account to spend or transfer your points of a program up to a
+limit, through x/authz. The grantee submits the message with "tx authz exec".
+
+A spend grant may be restricted to merchants with --allowed-merchants and a
+transfer grant to recipients with --allowed-recipients. With --expires-in both
+the authorization and the authz grant stop being valid after that long.`,
+		Example: fmt.Sprintf("%s tx points grant-points-authorization cosmos1... spend loyalty 1000 --allowed-merchants 1,2 --expires-in 720h --from alice", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			spendLimit, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid spend limit: %w", err)
+			}
+
+			merchants, err := cmd.Flags().GetUintSlice(FlagAllowedMerchants)
+			if err != nil {
+				return err
+			}
+			recipients, err := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+			if err != nil {
+				return err
+			}
+			expiresIn, err := cmd.Flags().GetDuration(FlagExpiresIn)
+			if err != nil {
+				return err
+			}
+
+			var (
+				expiration *time.Time
+				expiresAt  int64
+			)
+			if expiresIn > 0 {
+				t := time.Now().Add(expiresIn)
+				expiration, expiresAt = &t, t.Unix()
+			}
+
+			var authorization *types.PointsSpendAuthorization
+			switch args[1] {
+			case "spend":
+				ids := make([]uint64, len(merchants))
+				for i, id := range merchants {
+					ids[i] = uint64(id)
+				}
+				authorization = types.NewPointsSpendAuthorization(args[2], spendLimit, ids, expiresAt)
+			case "transfer":
+				authorization = types.NewPointsTransferAuthorization(args[2], spendLimit, recipients, expiresAt)
+			default:
+				return fmt.Errorf("authorization type must be spend or transfer, got %q", args[1])
+			}
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().UintSlice(FlagAllowedMerchants, nil, "Merchant ids a spend grant is restricted to")
+	cmd.Flags().StringSlice(FlagAllowedRecipients, nil, "Addresses a transfer grant is restricted to")
+	cmd.Flags().Duration(FlagExpiresIn, 0, "How long the grant stays valid (e.g. 720h)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
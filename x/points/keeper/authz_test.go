@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	module "scontract/x/points/module"
+	"scontract/x/points/types"
+)
+
+// authzFixture is a points keeper next to an authz keeper routing the
+// messages it executes to the points msg server.
+type authzFixture struct {
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	authzKeeper authzkeeper.Keeper
+	authority   string
+}
+
+func initAuthzFixture(t *testing.T) *authzFixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	keys := storetypes.NewKVStoreKeys(types.StoreKey, authzkeeper.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, storetypes.NewTransientStoreKeys("transient_test"), nil).
+		WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	authorityStr, err := addressCodec.BytesToString(authority)
+	require.NoError(t, err)
+	k := keeper.NewKeeper(runtime.NewKVStoreService(keys[types.StoreKey]), encCfg.Codec, addressCodec, authority, newMockBankKeeper(), newMockEpochsKeeper())
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+	require.NoError(t, k.Program.Set(ctx, testProgramID, types.PointProgram{Id: testProgramID, Name: "Loyalty", Owner: authorityStr, Issuers: []string{authorityStr}}))
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	types.RegisterMsgServer(router, keeper.NewMsgServerImpl(k))
+	authzKeeper := authzkeeper.NewKeeper(runtime.NewKVStoreService(keys[authzkeeper.StoreKey]), encCfg.Codec, router, authzAccountKeeper{addressCodec})
+
+	// as the points ante handler would
+	ctx = types.ContextWithTransferFee(ctx, k.TransferFee)
+
+	return &authzFixture{ctx: ctx, keeper: k, authzKeeper: authzKeeper, authority: authorityStr}
+}
+
+// authzAccountKeeper is the account keeper of the authz keeper, which only
+// needs to decode addresses to execute messages.
+type authzAccountKeeper struct {
+	address.Codec
+}
+
+func (ak authzAccountKeeper) AddressCodec() address.Codec { return ak.Codec }
+
+func (authzAccountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI { return nil }
+
+func (authzAccountKeeper) NewAccountWithAddress(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (authzAccountKeeper) SetAccount(context.Context, sdk.AccountI) {}
+
+func TestPointsAuthorizationExec(t *testing.T) {
+	f := initAuthzFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := f.ctx
+
+	owner, grantee, friend := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	_, err := ms.AddIssuer(ctx, types.NewMsgAddIssuer(f.authority, f.authority, 0))
+	require.NoError(t, err)
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(f.authority, testProgramID, owner, 100, "welcome"))
+	require.NoError(t, err)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.TransferFee = types.TransferFee{Flat: 2}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	grant := types.NewPointsTransferAuthorization(testProgramID, 30, []string{friend}, 0)
+	require.NoError(t, f.authzKeeper.SaveGrant(ctx, sdk.MustAccAddressFromBech32(grantee), sdk.MustAccAddressFromBech32(owner), grant, nil))
+	transferMsgType := sdk.MsgTypeURL(&types.MsgTransferPoints{})
+	exec := func(msg sdk.Msg) error {
+		execMsg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(grantee), []sdk.Msg{msg})
+		_, err := f.authzKeeper.Exec(ctx, &execMsg)
+		return err
+	}
+	balanceOf := func(address string) uint64 {
+		balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, address))
+		require.NoError(t, err)
+		return balance.Balance
+	}
+
+	// a transfer to someone else is not authorized
+	err = exec(types.NewMsgTransferPoints(owner, testProgramID, sample.AccAddress(), 5))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the transfer and its fee are taken off the spend limit
+	require.NoError(t, exec(types.NewMsgTransferPoints(owner, testProgramID, friend, 10)))
+	require.EqualValues(t, 88, balanceOf(owner))
+	require.EqualValues(t, 10, balanceOf(friend))
+	left, _ := f.authzKeeper.GetAuthorization(ctx, sdk.MustAccAddressFromBech32(grantee), sdk.MustAccAddressFromBech32(owner), transferMsgType)
+	require.EqualValues(t, 18, left.(*types.PointsSpendAuthorization).SpendLimit)
+
+	// 17 points would cost 19 with the fee
+	err = exec(types.NewMsgTransferPoints(owner, testProgramID, friend, 17))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// using up the limit removes the grant
+	require.NoError(t, exec(types.NewMsgTransferPoints(owner, testProgramID, friend, 16)))
+	require.EqualValues(t, 70, balanceOf(owner))
+	left, _ = f.authzKeeper.GetAuthorization(ctx, sdk.MustAccAddressFromBech32(grantee), sdk.MustAccAddressFromBech32(owner), transferMsgType)
+	require.Nil(t, left)
+	err = exec(types.NewMsgTransferPoints(owner, testProgramID, friend, 1))
+	require.ErrorIs(t, err, authz.ErrNoAuthorizationFound)
+}
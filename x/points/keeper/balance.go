@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"scontract/x/points/types"
+)
+
+// getBalance returns the balance of address in a program, which is empty if
+// the address never held the program's points.
+func (k Keeper) getBalance(ctx context.Context, programID, address string) (types.PointBalance, error) {
+	balance, err := k.PointBalance.Get(ctx, collections.Join(programID, address))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.PointBalance{}, err
+	}
+	return balance, nil
+}
+
+// addBalance credits amount points of a program to address and returns the updated balance.
+// It does not create a lot; use creditLot to give an account points. Like
+// subBalance, it marks the balance changed for the next balance snapshot.
+func (k Keeper) addBalance(ctx context.Context, programID, address string, amount uint64) (types.PointBalance, error) {
+	balance, err := k.getBalance(ctx, programID, address)
+	if err != nil {
+		return types.PointBalance{}, err
+	}
+
+	balance.Index = address
+	balance.Address = address
+	balance.ProgramId = programID
+	if balance.Balance, err = addAmount(balance.Balance, amount); err != nil {
+		return types.PointBalance{}, err
+	}
+	if err := k.PointBalance.Set(ctx, collections.Join(programID, address), balance); err != nil {
+		return types.PointBalance{}, err
+	}
+	if err := k.ChangedBalance.Set(ctx, collections.Join(programID, address)); err != nil {
+		return types.PointBalance{}, err
+	}
+
+	return balance, nil
+}
+
+// subBalance debits amount points of a program from address and returns the updated balance.
+func (k Keeper) subBalance(ctx context.Context, programID, address string, amount uint64) (types.PointBalance, error) {
+	balance, err := k.getBalance(ctx, programID, address)
+	if err != nil {
+		return types.PointBalance{}, err
+	}
+	if balance.Balance < amount {
+		return types.PointBalance{}, errorsmod.Wrapf(types.ErrInsufficientFunds, "balance is %d but needed %d", balance.Balance, amount)
+	}
+
+	balance.Index = address
+	balance.Address = address
+	balance.ProgramId = programID
+	balance.Balance -= amount
+	if err := k.PointBalance.Set(ctx, collections.Join(programID, address), balance); err != nil {
+		return types.PointBalance{}, err
+	}
+	if err := k.ChangedBalance.Set(ctx, collections.Join(programID, address)); err != nil {
+		return types.PointBalance{}, err
+	}
+
+	return balance, nil
+}
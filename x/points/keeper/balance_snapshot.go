@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"scontract/x/points/types"
+)
+
+// snapshotBalanceKey is the primary key of a snapshot balance: program,
+// address and snapshot id, so that the snapshots of an account's balance
+// iterate oldest first.
+type snapshotBalanceKey = collections.Triple[string, string, uint64]
+
+var snapshotBalanceKeyCodec = collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)
+
+// takeBalanceSnapshot records the balances changed since the last snapshot
+// and the supply of every program, at the end of epochNumber of identifier.
+// The first snapshot records every balance.
+func (k Keeper) takeBalanceSnapshot(ctx context.Context, identifier string, epochNumber int64) error {
+	id, err := k.BalanceSnapshotSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	var keys []addressKey
+	collect := func(key addressKey) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	}
+	if id == 0 {
+		err = k.PointBalance.Walk(ctx, nil, func(key addressKey, _ types.PointBalance) (bool, error) { return collect(key) })
+	} else {
+		err = k.ChangedBalance.Walk(ctx, nil, collect)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		balance, err := k.getBalance(ctx, key.K1(), key.K2())
+		if err != nil {
+			return err
+		}
+		if err := k.SnapshotBalance.Set(ctx, collections.Join3(key.K1(), key.K2(), id), types.SnapshotBalance{
+			SnapshotId: id,
+			ProgramId:  key.K1(),
+			Address:    key.K2(),
+			Balance:    balance.Balance,
+		}); err != nil {
+			return err
+		}
+	}
+	if err := k.ChangedBalance.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	var supply []types.PointSupply
+	if err := k.Supply.Walk(ctx, nil, func(_ string, elem types.PointSupply) (bool, error) {
+		supply = append(supply, elem)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	nextTransactionID, err := k.TransactionSeq.Peek(ctx)
+	if err != nil {
+		return err
+	}
+	nextSettlementID, err := k.SettlementSeq.Peek(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	snapshot := types.BalanceSnapshot{
+		Id:                id,
+		EpochIdentifier:   identifier,
+		EpochNumber:       epochNumber,
+		Timestamp:         sdkCtx.BlockTime().Unix(),
+		NextTransactionId: nextTransactionID,
+		NextSettlementId:  nextSettlementID,
+		Supply:            supply,
+		AccountCount:      uint64(len(keys)),
+	}
+	if err := k.BalanceSnapshot.Set(ctx, id, snapshot); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventBalanceSnapshotTaken{
+		SnapshotId:      id,
+		EpochIdentifier: identifier,
+		EpochNumber:     epochNumber,
+		AccountCount:    snapshot.AccountCount,
+	})
+}
+
+// snapshotBalanceAt returns the balance of address in a program as of the
+// snapshot with the given id: the balance recorded by that snapshot or, if
+// it did not change, by the latest snapshot before it.
+func (k Keeper) snapshotBalanceAt(ctx context.Context, programID, address string, snapshotID uint64) (uint64, error) {
+	rng := new(collections.Range[snapshotBalanceKey]).
+		StartInclusive(collections.Join3(programID, address, uint64(0))).
+		EndInclusive(collections.Join3(programID, address, snapshotID)).
+		Descending()
+	iter, err := k.SnapshotBalance.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+	elem, err := iter.Value()
+	if err != nil {
+		return 0, err
+	}
+	return elem.Balance, nil
+}
+
+// latestSnapshotBefore returns the latest balance snapshot taken at or before
+// timestamp, and whether there is one.
+func (k Keeper) latestSnapshotBefore(ctx context.Context, timestamp int64) (types.BalanceSnapshot, bool, error) {
+	var (
+		snapshot types.BalanceSnapshot
+		found    bool
+	)
+	err := k.BalanceSnapshot.Walk(ctx, new(collections.Range[uint64]).Descending(), func(_ uint64, elem types.BalanceSnapshot) (bool, error) {
+		if elem.Timestamp > timestamp {
+			return false, nil
+		}
+		snapshot, found = elem, true
+		return true, nil
+	})
+	return snapshot, found, err
+}
+
+// balanceAt returns the balance of address in a program once every change
+// made at or before timestamp is applied. It starts from the latest snapshot
+// taken by then, or from an empty balance, and replays the transactions and
+// settlements of the account that followed. The transfer fees credited to
+// the points treasury are not transactions of the treasury, so its balance
+// is only exact as of snapshots.
+func (k Keeper) balanceAt(ctx context.Context, programID, address string, timestamp int64) (uint64, *types.BalanceSnapshot, error) {
+	snapshot, found, err := k.latestSnapshotBefore(ctx, timestamp)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var (
+		credits, debits  uint64
+		fromTransaction  uint64
+		fromSettlement   uint64
+		decidedAfter     int64
+		snapshotResponse *types.BalanceSnapshot
+	)
+	if found {
+		balance, err := k.snapshotBalanceAt(ctx, programID, address, snapshot.Id)
+		if err != nil {
+			return 0, nil, err
+		}
+		credits = balance
+		fromTransaction = snapshot.NextTransactionId
+		fromSettlement = snapshot.NextSettlementId
+		// decisions are only made by transactions, which follow the snapshot
+		// when they are in its block
+		decidedAfter = snapshot.Timestamp
+		snapshotResponse = &snapshot
+	}
+
+	checkpoint, err := k.getTransactionCheckpoint(ctx, programID, address)
+	if err != nil {
+		return 0, nil, err
+	}
+	if checkpoint.TransactionCount > 0 && checkpoint.LastTransactionId >= fromTransaction {
+		return 0, nil, errorsmod.Wrapf(types.ErrHistoryPruned, "transactions of %s up to id %d were pruned", address, checkpoint.LastTransactionId)
+	}
+
+	walkTransactions := k.walkAddressTransactions(ctx, programID, address, types.TransactionDirectionUnspecified)
+	if err := walkTransactions(false, &fromTransaction, func(tx types.Transaction) (bool, error) {
+		if tx.Timestamp > timestamp {
+			return true, nil
+		}
+		credit, debit := tx.BalanceChange(address)
+		credits += credit
+		debits += debit
+		return false, nil
+	}); err != nil {
+		return 0, nil, err
+	}
+
+	// settlements take points out of the balance when requested and put them
+	// back when rejected or cancelled
+	idxs := []*indexes.Multi[addressKey, programKey, types.Settlement]{k.Settlement.Indexes.Requester}
+	walkSettlements := walkIndexes(ctx, idxs, collections.Join(programID, address), k.Settlement.Get)
+	if err := walkSettlements(false, nil, func(settlement types.Settlement) (bool, error) {
+		if settlement.Id >= fromSettlement && settlement.Timestamp <= timestamp {
+			debits += settlement.Amount
+		}
+		switch settlement.Status {
+		case types.SettlementStatusRejected, types.SettlementStatusCancelled:
+			if settlement.DecidedAt >= decidedAfter && settlement.DecidedAt <= timestamp {
+				credits += settlement.Amount
+			}
+		}
+		return false, nil
+	}); err != nil {
+		return 0, nil, err
+	}
+
+	if debits > credits {
+		return 0, nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "balance of %s at %d is negative", address, timestamp)
+	}
+	return credits - debits, snapshotResponse, nil
+}
@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestBalanceSnapshots(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	blockTime := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	at := func(hours int) sdk.Context {
+		return ctx.WithBlockTime(blockTime.Add(time.Duration(hours) * time.Hour))
+	}
+
+	alice, bob, carol, dave := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	treasury := authtypes.NewModuleAddress(types.TreasuryModuleName).String()
+	issuePoints(t, f, ctx, alice, 100, nil)
+	issuePoints(t, f, ctx, bob, 50, nil)
+	issuePoints(t, f, ctx, dave, 5, nil)
+
+	// snapshots are disabled until a balance snapshot epoch is set
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(at(1)))
+	_, err := qs.GetBalanceSnapshot(ctx, &types.QueryGetBalanceSnapshotRequest{Id: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	params := types.DefaultParams()
+	params.BalanceSnapshotEpoch = "week"
+	params.TransferFee = types.TransferFee{Flat: 1}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	// the first snapshot records every balance
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(at(2)))
+	requireLastEvent(t, ctx, &types.EventBalanceSnapshotTaken{SnapshotId: 0, EpochIdentifier: "week", EpochNumber: 2, AccountCount: 3})
+
+	_, err = ms.TransferPoints(at(3), types.NewMsgTransferPoints(alice, testProgramID, bob, 30))
+	require.NoError(t, err)
+	_, err = ms.RequestSettlement(at(4), types.NewMsgRequestSettlement(alice, testProgramID, 9))
+	require.NoError(t, err)
+
+	// later snapshots only record the balances that changed
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(at(5)))
+	requireLastEvent(t, ctx, &types.EventBalanceSnapshotTaken{SnapshotId: 1, EpochIdentifier: "week", EpochNumber: 3, AccountCount: 3})
+
+	_, err = ms.CancelSettlement(at(6), types.NewMsgCancelSettlement(alice, testProgramID, 0))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(at(6), types.NewMsgTransferPoints(bob, testProgramID, alice, 10))
+	require.NoError(t, err)
+
+	res, err := qs.ListBalanceSnapshot(ctx, &types.QueryAllBalanceSnapshotRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.BalanceSnapshot, 2)
+	first, second := res.BalanceSnapshot[0], res.BalanceSnapshot[1]
+	require.Equal(t, at(2).BlockTime().Unix(), first.Timestamp)
+	require.Equal(t, []types.PointSupply{{ProgramId: testProgramID, Issued: 155}}, first.Supply)
+	require.Equal(t, []types.PointSupply{{ProgramId: testProgramID, Issued: 155, Transferred: 30, Settled: 9}}, second.Supply)
+	require.Greater(t, second.NextTransactionId, first.NextTransactionId)
+	require.EqualValues(t, 1, second.NextSettlementId)
+
+	t.Run("by snapshot", func(t *testing.T) {
+		tests := []struct {
+			address    string
+			snapshotID uint64
+			balance    uint64
+		}{
+			{address: alice, snapshotID: 0, balance: 100},
+			{address: alice, snapshotID: 1, balance: 60},
+			{address: bob, snapshotID: 1, balance: 80},
+			// dave's balance is carried from the snapshot it last changed in
+			{address: dave, snapshotID: 1, balance: 5},
+			{address: treasury, snapshotID: 0, balance: 0},
+			{address: treasury, snapshotID: 1, balance: 1},
+			{address: carol, snapshotID: 1, balance: 0},
+		}
+		for _, tc := range tests {
+			res, err := qs.BalanceAt(ctx, &types.QueryBalanceAtRequest{ProgramId: testProgramID, Address: tc.address, SnapshotId: tc.snapshotID})
+			require.NoError(t, err)
+			require.Equal(t, &types.QueryBalanceAtResponse{Balance: tc.balance, FromSnapshot: true, SnapshotId: tc.snapshotID}, res)
+		}
+
+		_, err := qs.BalanceAt(ctx, &types.QueryBalanceAtRequest{ProgramId: testProgramID, Address: alice, SnapshotId: 2})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+
+	t.Run("by timestamp", func(t *testing.T) {
+		tests := []struct {
+			desc     string
+			address  string
+			hours    int
+			balance  uint64
+			snapshot *uint64
+		}{
+			{desc: "before any snapshot", address: alice, hours: 0, balance: 100},
+			{desc: "at a snapshot", address: alice, hours: 2, balance: 100, snapshot: new(uint64)},
+			{desc: "transfer and its fee", address: alice, hours: 3, balance: 69, snapshot: new(uint64)},
+			{desc: "transfer received", address: bob, hours: 3, balance: 80, snapshot: new(uint64)},
+			{desc: "settlement requested", address: alice, hours: 4, balance: 60, snapshot: new(uint64)},
+			{desc: "settlement cancelled", address: alice, hours: 6, balance: 79, snapshot: &second.Id},
+			{desc: "after the last change", address: bob, hours: 7, balance: 69, snapshot: &second.Id},
+		}
+		for _, tc := range tests {
+			t.Run(tc.desc, func(t *testing.T) {
+				res, err := qs.BalanceAt(ctx, &types.QueryBalanceAtRequest{ProgramId: testProgramID, Address: tc.address, Timestamp: at(tc.hours).BlockTime().Unix()})
+				require.NoError(t, err)
+				require.Equal(t, tc.balance, res.Balance)
+				require.Equal(t, tc.snapshot != nil, res.FromSnapshot)
+				if tc.snapshot != nil {
+					require.Equal(t, *tc.snapshot, res.SnapshotId)
+				}
+			})
+		}
+
+		for _, address := range []string{alice, bob} {
+			balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, address))
+			require.NoError(t, err)
+			res, err := qs.BalanceAt(ctx, &types.QueryBalanceAtRequest{ProgramId: testProgramID, Address: address, Timestamp: at(7).BlockTime().Unix()})
+			require.NoError(t, err)
+			require.Equal(t, balance.Balance, res.Balance)
+		}
+	})
+
+	t.Run("pruned history", func(t *testing.T) {
+		next, err := f.keeper.TransactionSeq.Peek(ctx)
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.TransactionCheckpoint.Set(ctx, collections.Join(testProgramID, carol), types.TransactionCheckpoint{
+			ProgramId: testProgramID, Address: carol, TransactionCount: 1, LastTransactionId: next - 1,
+		}))
+
+		_, err = qs.BalanceAt(ctx, &types.QueryBalanceAtRequest{ProgramId: testProgramID, Address: carol, Timestamp: at(7).BlockTime().Unix()})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = qs.BalanceAt(ctx, &types.QueryBalanceAtRequest{ProgramId: testProgramID, Address: carol, SnapshotId: 1})
+		require.NoError(t, err)
+	})
+}
+
+func TestBalanceAtIssuer(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	blockTime := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	at := func(hours int) sdk.Context {
+		return ctx.WithBlockTime(blockTime.Add(time.Duration(hours) * time.Hour))
+	}
+
+	issuer, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	alice, employee := sample.AccAddress(), sample.AccAddress()
+
+	// the issuer holds points of its own, which issuing and granting to
+	// others leave untouched
+	issuePoints(t, f, at(1), issuer, 40, nil)
+	issuePoints(t, f, at(2), alice, 100, nil)
+	_, err = ms.CreatePointGrant(at(2), types.NewMsgCreatePointGrant(issuer, testProgramID, employee, "week", 2, 10, false))
+	require.NoError(t, err)
+	for hours := 3; hours <= 4; hours++ {
+		f.epochsKeeper.endEpoch("week")
+		require.NoError(t, f.keeper.ProcessEpochs(at(hours)))
+	}
+	employeeBalance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, employee))
+	require.NoError(t, err)
+	require.EqualValues(t, 10, employeeBalance.Balance)
+
+	_, err = ms.TransferPoints(at(5), types.NewMsgTransferPoints(issuer, testProgramID, alice, 15))
+	require.NoError(t, err)
+
+	tests := []struct {
+		address string
+		hours   int
+		balance uint64
+	}{
+		{address: issuer, hours: 4, balance: 40},
+		{address: issuer, hours: 5, balance: 25},
+		{address: alice, hours: 5, balance: 115},
+		{address: employee, hours: 5, balance: 10},
+	}
+	for _, tc := range tests {
+		res, err := qs.BalanceAt(ctx, &types.QueryBalanceAtRequest{ProgramId: testProgramID, Address: tc.address, Timestamp: at(tc.hours).BlockTime().Unix()})
+		require.NoError(t, err)
+		require.Equal(t, tc.balance, res.Balance)
+	}
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+
+	"scontract/x/points/types"
+)
+
+// Hooks implements the x/epochs hooks of the module.
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks returns the x/epochs hooks of the module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd releases the grant tranches unlocked by the end of an epoch,
+// batches the pending settlements at the end of a settlement batch epoch and
+// snapshots balances at the end of a balance snapshot epoch. An epoch already processed is skipped, so that the hook may be
+// run both by x/epochs and by ProcessEpochs.
+func (h Hooks) AfterEpochEnd(ctx context.Context, identifier string, epochNumber int64) error {
+	processed, err := h.k.ProcessedEpoch.Get(ctx, identifier)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if epochNumber <= processed.EpochNumber {
+		return nil
+	}
+	if err := h.k.ProcessedEpoch.Set(ctx, identifier, types.ProcessedEpoch{Identifier: identifier, EpochNumber: epochNumber}); err != nil {
+		return err
+	}
+
+	if err := h.k.releaseGrants(ctx, identifier, epochNumber); err != nil {
+		return err
+	}
+
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.SettlementBatchEpoch == identifier {
+		if err := h.k.batchSettlements(ctx, identifier, epochNumber); err != nil {
+			return err
+		}
+	}
+	if params.BalanceSnapshotEpoch == identifier {
+		return h.k.takeBalanceSnapshot(ctx, identifier, epochNumber)
+	}
+	return nil
+}
+
+// BeforeEpochStart is a no-op.
+func (h Hooks) BeforeEpochStart(context.Context, string, int64) error {
+	return nil
+}
+
+// ProcessEpochs runs AfterEpochEnd for the last ended epoch of every x/epochs
+// identifier. x/epochs keeps its keeper by value in its app module, so hooks
+// set through depinject never reach it; the module polls the epoch infos
+// from its BeginBlocker instead, after x/epochs has started the new epochs.
+// Like x/epochs, a failing hook is logged and its changes are discarded
+// without halting the chain; the epoch is still marked processed, and the
+// tranches it would have released are caught up at the next epoch end.
+func (k Keeper) ProcessEpochs(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	infos, err := k.epochsKeeper.AllEpochInfos(sdkCtx)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range infos {
+		if !info.EpochCountingStarted || info.CurrentEpoch <= 1 {
+			continue
+		}
+		ended := info.CurrentEpoch - 1
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.Hooks().AfterEpochEnd(cacheCtx, info.Identifier, ended); err != nil {
+			sdkCtx.Logger().Error("points epoch hook failed", "identifier", info.Identifier, "epoch", ended, "err", err)
+			if err := k.ProcessedEpoch.Set(ctx, info.Identifier, types.ProcessedEpoch{Identifier: info.Identifier, EpochNumber: ended}); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"scontract/x/points/types"
+)
+
+// FeePayment returns how fees paid in points are converted.
+func (k Keeper) FeePayment(ctx context.Context) (types.FeePayment, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.FeePayment{}, err
+	}
+	return params.FeePayment, nil
+}
+
+// PayFeeInPoints pays fee on behalf of payer: the points it converts to at
+// the fee_payment rate are burned from payer's lots of the program and the
+// fee is sent from the fee sponsor pool to the fee collector. A non-zero
+// maxPoints caps the points payer accepts to be charged. It returns the
+// points charged.
+func (k Keeper) PayFeeInPoints(ctx context.Context, programID, payer string, fee sdk.Coins, maxPoints uint64) (uint64, error) {
+	if _, err := k.addressCodec.StringToBytes(payer); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if !fee.IsValid() || fee.IsZero() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidFeePayment, "invalid fee %s", fee)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// 1. 수수료를 포인트로 환산 (올림)
+	points, err := params.FeePayment.FeePoints(fee)
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidFeePayment, err.Error())
+	}
+	if maxPoints > 0 && points > maxPoints {
+		return 0, errorsmod.Wrapf(types.ErrInvalidFeePayment, "fee %s costs %d points, more than the %d allowed", fee, points, maxPoints)
+	}
+
+	if _, err := k.getProgram(ctx, programID); err != nil {
+		return 0, err
+	}
+	if err := k.assertDebitAllowed(ctx, payer); err != nil {
+		return 0, err
+	}
+
+	// 2. 만료되지 않은 로트에서 오래된 순으로 차감 후 소각
+	balance, _, err := k.debitLots(ctx, programID, payer, points)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.updateSupply(ctx, programID, func(supply *types.PointSupply) (err error) {
+		supply.FeesPaid, err = addAmount(supply.FeesPaid, points)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+
+	// 3. 스폰서 풀에서 수수료 징수 계정으로 수수료 지급
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.FeeSponsorModuleName, authtypes.FeeCollectorName, fee); err != nil {
+		return 0, errorsmod.Wrap(err, "fee sponsor pool cannot pay the fee")
+	}
+
+	// 4. 거래 기록 추가
+	id, err := k.TransactionSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.Transaction.Set(ctx, collections.Join(programID, id), types.Transaction{
+		Id:        id,
+		ProgramId: programID,
+		Sender:    payer,
+		Amount:    points,
+		TxType:    types.TransactionTypeFee,
+		Timestamp: sdkCtx.BlockTime().Unix(),
+	}); err != nil {
+		return 0, err
+	}
+
+	// 5. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventFeePaidInPoints{
+		TransactionId: id,
+		ProgramId:     programID,
+		Payer:         payer,
+		Points:        points,
+		Fee:           fee.String(),
+		PayerBalance:  balance.Balance,
+	}); err != nil {
+		return 0, err
+	}
+
+	return points, nil
+}
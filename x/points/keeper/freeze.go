@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// assertComplianceOfficer returns an error unless address is the module
+// authority or a compliance officer listed in the params.
+func (k Keeper) assertComplianceOfficer(ctx context.Context, address string) error {
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if bytes.Equal(addr, k.GetAuthority()) {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.IsComplianceOfficer(address) {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not a compliance officer", address)
+	}
+	return nil
+}
+
+// activeFreeze returns the hold on address if one is in force at the current
+// block time.
+func (k Keeper) activeFreeze(ctx context.Context, address string) (types.FrozenAccount, bool, error) {
+	frozen, err := k.FrozenAccount.Get(ctx, address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.FrozenAccount{}, false, nil
+		}
+		return types.FrozenAccount{}, false, err
+	}
+	return frozen, frozen.IsActive(sdk.UnwrapSDKContext(ctx).BlockTime()), nil
+}
+
+// assertDebitAllowed returns an error if address is frozen.
+func (k Keeper) assertDebitAllowed(ctx context.Context, address string) error {
+	frozen, active, err := k.activeFreeze(ctx, address)
+	if err != nil {
+		return err
+	}
+	if active {
+		return errorsmod.Wrapf(types.ErrAccountFrozen, "%s: %s", address, frozen.Reason)
+	}
+	return nil
+}
+
+// assertCreditAllowed returns an error if address is frozen with credits
+// blocked. Refunds of the account's own settlements are not credits.
+func (k Keeper) assertCreditAllowed(ctx context.Context, address string) error {
+	frozen, active, err := k.activeFreeze(ctx, address)
+	if err != nil {
+		return err
+	}
+	if active && frozen.BlockCredits {
+		return errorsmod.Wrapf(types.ErrAccountFrozen, "%s cannot receive points: %s", address, frozen.Reason)
+	}
+	return nil
+}
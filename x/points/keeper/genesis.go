@@ -4,17 +4,24 @@ import (
 	"context"
 
 	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, elem := range genState.ProgramList {
+		if err := k.Program.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
 	for _, elem := range genState.PointBalanceMap {
-		if err := k.PointBalance.Set(ctx, elem.Index, elem); err != nil {
+		if err := k.PointBalance.Set(ctx, collections.Join(elem.ProgramId, elem.Index), elem); err != nil {
 			return err
 		}
 	}
 	for _, elem := range genState.TransactionList {
-		if err := k.Transaction.Set(ctx, elem.Id, elem); err != nil {
+		if err := k.Transaction.Set(ctx, collections.Join(elem.ProgramId, elem.Id), elem); err != nil {
 			return err
 		}
 	}
@@ -23,7 +30,7 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		return err
 	}
 	for _, elem := range genState.SettlementList {
-		if err := k.Settlement.Set(ctx, elem.Id, elem); err != nil {
+		if err := k.Settlement.Set(ctx, collections.Join(elem.ProgramId, elem.Id), elem); err != nil {
 			return err
 		}
 	}
@@ -31,6 +38,106 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	if err := k.SettlementSeq.Set(ctx, genState.SettlementCount); err != nil {
 		return err
 	}
+	for _, elem := range genState.IssuerList {
+		if err := k.Issuer.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.PointLotList {
+		if err := k.PointLot.Set(ctx, newLotKey(elem), elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.PointLotSeq.Set(ctx, genState.PointLotCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.MerchantList {
+		if err := k.Merchant.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.MerchantSeq.Set(ctx, genState.MerchantCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.SupplyList {
+		if err := k.Supply.Set(ctx, elem.ProgramId, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.VelocityOverrideList {
+		if err := k.VelocityOverride.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.VelocityUsageList {
+		if err := k.VelocityUsage.Set(ctx, newVelocityKey(elem), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.FrozenAccountList {
+		if err := k.FrozenAccount.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TransactionCheckpointList {
+		if err := k.TransactionCheckpoint.Set(ctx, collections.Join(elem.ProgramId, elem.Address), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.PointGrantList {
+		if err := k.PointGrant.Set(ctx, collections.Join(elem.ProgramId, elem.Id), elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.PointGrantSeq.Set(ctx, genState.PointGrantCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.ProcessedEpochList {
+		if err := k.ProcessedEpoch.Set(ctx, elem.Identifier, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.SettlementBatchList {
+		if err := k.SettlementBatch.Set(ctx, collections.Join(elem.ProgramId, elem.Id), elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SettlementBatchSeq.Set(ctx, genState.SettlementBatchCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.BalanceSnapshotList {
+		if err := k.BalanceSnapshot.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.BalanceSnapshotSeq.Set(ctx, genState.BalanceSnapshotCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.SnapshotBalanceList {
+		if err := k.SnapshotBalance.Set(ctx, collections.Join3(elem.ProgramId, elem.Address, elem.SnapshotId), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.ChangedBalanceList {
+		if err := k.ChangedBalance.Set(ctx, collections.Join(elem.ProgramId, elem.Address)); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TokenizedLotList {
+		if err := k.TokenizedLot.Set(ctx, newLotKey(elem), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.RefundableSpendList {
+		if err := k.RefundableSpend.Set(ctx, collections.Join(elem.ProgramId, elem.Id), elem); err != nil {
+			return err
+		}
+	}
 
 	return k.Params.Set(ctx, genState.Params)
 }
@@ -44,13 +151,19 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
-	if err := k.PointBalance.Walk(ctx, nil, func(_ string, val types.PointBalance) (stop bool, err error) {
+	if err := k.Program.Walk(ctx, nil, func(_ string, val types.PointProgram) (stop bool, err error) {
+		genesis.ProgramList = append(genesis.ProgramList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.PointBalance.Walk(ctx, nil, func(_ addressKey, val types.PointBalance) (stop bool, err error) {
 		genesis.PointBalanceMap = append(genesis.PointBalanceMap, val)
 		return false, nil
 	}); err != nil {
 		return nil, err
 	}
-	err = k.Transaction.Walk(ctx, nil, func(key uint64, elem types.Transaction) (bool, error) {
+	err = k.Transaction.Walk(ctx, nil, func(key programKey, elem types.Transaction) (bool, error) {
 		genesis.TransactionList = append(genesis.TransactionList, elem)
 		return false, nil
 	})
@@ -62,7 +175,7 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
-	err = k.Settlement.Walk(ctx, nil, func(key uint64, elem types.Settlement) (bool, error) {
+	err = k.Settlement.Walk(ctx, nil, func(key programKey, elem types.Settlement) (bool, error) {
 		genesis.SettlementList = append(genesis.SettlementList, elem)
 		return false, nil
 	})
@@ -74,6 +187,127 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.Issuer.Walk(ctx, nil, func(_ string, val types.Issuer) (stop bool, err error) {
+		genesis.IssuerList = append(genesis.IssuerList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.PointLot.Walk(ctx, nil, func(_ lotKey, elem types.PointLot) (bool, error) {
+		genesis.PointLotList = append(genesis.PointLotList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.PointLotCount, err = k.PointLotSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Merchant.Walk(ctx, nil, func(_ uint64, elem types.Merchant) (bool, error) {
+		genesis.MerchantList = append(genesis.MerchantList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.MerchantCount, err = k.MerchantSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Supply.Walk(ctx, nil, func(_ string, elem types.PointSupply) (bool, error) {
+		genesis.SupplyList = append(genesis.SupplyList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.VelocityOverride.Walk(ctx, nil, func(_ string, elem types.VelocityOverride) (bool, error) {
+		genesis.VelocityOverrideList = append(genesis.VelocityOverrideList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.VelocityUsage.Walk(ctx, nil, func(_ velocityKey, elem types.VelocityUsage) (bool, error) {
+		genesis.VelocityUsageList = append(genesis.VelocityUsageList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.FrozenAccount.Walk(ctx, nil, func(_ string, elem types.FrozenAccount) (bool, error) {
+		genesis.FrozenAccountList = append(genesis.FrozenAccountList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.TransactionCheckpoint.Walk(ctx, nil, func(_ addressKey, elem types.TransactionCheckpoint) (bool, error) {
+		genesis.TransactionCheckpointList = append(genesis.TransactionCheckpointList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.PointGrant.Walk(ctx, nil, func(_ programKey, elem types.PointGrant) (bool, error) {
+		genesis.PointGrantList = append(genesis.PointGrantList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.PointGrantCount, err = k.PointGrantSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.ProcessedEpoch.Walk(ctx, nil, func(_ string, elem types.ProcessedEpoch) (bool, error) {
+		genesis.ProcessedEpochList = append(genesis.ProcessedEpochList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.SettlementBatch.Walk(ctx, nil, func(_ programKey, elem types.SettlementBatch) (bool, error) {
+		genesis.SettlementBatchList = append(genesis.SettlementBatchList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.SettlementBatchCount, err = k.SettlementBatchSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.BalanceSnapshot.Walk(ctx, nil, func(_ uint64, elem types.BalanceSnapshot) (bool, error) {
+		genesis.BalanceSnapshotList = append(genesis.BalanceSnapshotList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.BalanceSnapshotCount, err = k.BalanceSnapshotSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.SnapshotBalance.Walk(ctx, nil, func(_ snapshotBalanceKey, elem types.SnapshotBalance) (bool, error) {
+		genesis.SnapshotBalanceList = append(genesis.SnapshotBalanceList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.ChangedBalance.Walk(ctx, nil, func(key addressKey) (bool, error) {
+		genesis.ChangedBalanceList = append(genesis.ChangedBalanceList, types.ChangedBalance{ProgramId: key.K1(), Address: key.K2()})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.TokenizedLot.Walk(ctx, nil, func(_ lotKey, elem types.PointLot) (bool, error) {
+		genesis.TokenizedLotList = append(genesis.TokenizedLotList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.RefundableSpend.Walk(ctx, nil, func(_ programKey, elem types.Transaction) (bool, error) {
+		genesis.RefundableSpendList = append(genesis.RefundableSpendList, elem)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
 }
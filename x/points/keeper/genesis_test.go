@@ -10,11 +10,32 @@ import (
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params:          types.DefaultParams(),
-		PointBalanceMap: []types.PointBalance{{Index: "0"}, {Index: "1"}}, TransactionList: []types.Transaction{{Id: 0}, {Id: 1}},
-		TransactionCount: 2,
-		SettlementList:   []types.Settlement{{Id: 0}, {Id: 1}},
-		SettlementCount:  2,
+		Params:               types.DefaultParams(),
+		ProgramList:          []types.PointProgram{{Id: testProgramID, Name: "Loyalty"}, {Id: "other", Name: "Other", Issuers: []string{"0"}}},
+		PointBalanceMap:      []types.PointBalance{{ProgramId: testProgramID, Index: "0", Balance: 5}, {ProgramId: "other", Index: "0"}},
+		TransactionList:      []types.Transaction{{ProgramId: testProgramID, Id: 0}, {ProgramId: "other", Id: 1}},
+		TransactionCount:     3,
+		SettlementList:       []types.Settlement{{ProgramId: testProgramID, Id: 0}, {ProgramId: "other", Id: 1}},
+		SettlementCount:      2,
+		IssuerList:           []types.Issuer{{Address: "0"}, {Address: "1"}},
+		PointLotList:         []types.PointLot{{ProgramId: testProgramID, Id: 0, Owner: "0", Amount: 2, ExpiresAt: 100}, {ProgramId: testProgramID, Id: 1, Owner: "0", Amount: 3}},
+		PointLotCount:        2,
+		MerchantList:         []types.Merchant{{Id: 0, Name: "Cafe", Owner: "0", SettlementAddress: "1", Active: true}, {Id: 1, Name: "Bakery", Owner: "1", SettlementAddress: "1"}},
+		MerchantCount:        2,
+		SupplyList:           []types.PointSupply{{ProgramId: testProgramID, Issued: 10, Spent: 1, Transferred: 2, Settled: 3, Expired: 2}},
+		VelocityOverrideList: []types.VelocityOverride{{Address: "0", Limits: types.VelocityLimits{DailySpend: 10}}},
+		FrozenAccountList:    []types.FrozenAccount{{Address: "0", Reason: "court order", FrozenBy: "1", FrozenAt: 10, ExpiresAt: 20, BlockCredits: true}},
+		VelocityUsageList: []types.VelocityUsage{
+			{Kind: types.VelocityIssue, Address: "0", Counterparty: "1", Bucket: 5, Amount: 3},
+			{Kind: types.VelocitySpend, Address: "0", Bucket: 4, Amount: 1},
+			{Kind: types.VelocitySpend, Address: "0", Bucket: 5, Amount: 2},
+		},
+		BalanceSnapshotList:  []types.BalanceSnapshot{{Id: 0, EpochIdentifier: "week", EpochNumber: 2, Timestamp: 10, NextTransactionId: 1, Supply: []types.PointSupply{{ProgramId: testProgramID, Issued: 10}}, AccountCount: 1}},
+		BalanceSnapshotCount: 1,
+		SnapshotBalanceList:  []types.SnapshotBalance{{SnapshotId: 0, ProgramId: testProgramID, Address: "0", Balance: 5}},
+		ChangedBalanceList:   []types.ChangedBalance{{ProgramId: "other", Address: "0"}},
+		TokenizedLotList:     []types.PointLot{{ProgramId: testProgramID, Id: 0, Amount: 1, IssuedAt: 5, ExpiresAt: 100}},
+		RefundableSpendList:  []types.Transaction{{ProgramId: testProgramID, Id: 2, Sender: "0", Recipient: "1", Amount: 4, Refunded: 1, TxType: types.TransactionTypeSpend}},
 	}
 	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
@@ -24,10 +45,26 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
+	require.EqualExportedValues(t, genesisState.ProgramList, got.ProgramList)
 	require.EqualExportedValues(t, genesisState.PointBalanceMap, got.PointBalanceMap)
 	require.EqualExportedValues(t, genesisState.TransactionList, got.TransactionList)
 	require.Equal(t, genesisState.TransactionCount, got.TransactionCount)
 	require.EqualExportedValues(t, genesisState.SettlementList, got.SettlementList)
 	require.Equal(t, genesisState.SettlementCount, got.SettlementCount)
+	require.EqualExportedValues(t, genesisState.IssuerList, got.IssuerList)
+	require.EqualExportedValues(t, genesisState.PointLotList, got.PointLotList)
+	require.Equal(t, genesisState.PointLotCount, got.PointLotCount)
+	require.EqualExportedValues(t, genesisState.MerchantList, got.MerchantList)
+	require.Equal(t, genesisState.MerchantCount, got.MerchantCount)
+	require.EqualExportedValues(t, genesisState.SupplyList, got.SupplyList)
+	require.EqualExportedValues(t, genesisState.VelocityOverrideList, got.VelocityOverrideList)
+	require.EqualExportedValues(t, genesisState.VelocityUsageList, got.VelocityUsageList)
+	require.EqualExportedValues(t, genesisState.FrozenAccountList, got.FrozenAccountList)
+	require.EqualExportedValues(t, genesisState.BalanceSnapshotList, got.BalanceSnapshotList)
+	require.Equal(t, genesisState.BalanceSnapshotCount, got.BalanceSnapshotCount)
+	require.EqualExportedValues(t, genesisState.SnapshotBalanceList, got.SnapshotBalanceList)
+	require.EqualExportedValues(t, genesisState.ChangedBalanceList, got.ChangedBalanceList)
+	require.EqualExportedValues(t, genesisState.TokenizedLotList, got.TokenizedLotList)
+	require.EqualExportedValues(t, genesisState.RefundableSpendList, got.RefundableSpendList)
 
 }
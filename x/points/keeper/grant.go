@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// PointGrantIndexes are the secondary indexes of the PointGrant map.
+type PointGrantIndexes struct {
+	// Beneficiary indexes grants by program and beneficiary address.
+	Beneficiary *indexes.Multi[addressKey, programKey, types.PointGrant]
+	// Epoch indexes grants by epoch identifier and status.
+	Epoch *indexes.Multi[addressKey, programKey, types.PointGrant]
+}
+
+func newPointGrantIndexes(sb *collections.SchemaBuilder) PointGrantIndexes {
+	return PointGrantIndexes{
+		Beneficiary: indexes.NewMulti(
+			sb, types.PointGrantByBeneficiaryKey, "pointGrantByBeneficiary",
+			addressKeyCodec, programKeyCodec,
+			func(_ programKey, grant types.PointGrant) (addressKey, error) {
+				return collections.Join(grant.ProgramId, grant.Beneficiary), nil
+			},
+		),
+		Epoch: indexes.NewMulti(
+			sb, types.PointGrantByEpochKey, "pointGrantByEpoch",
+			addressKeyCodec, programKeyCodec,
+			func(_ programKey, grant types.PointGrant) (addressKey, error) {
+				return grantEpochKey(grant.EpochIdentifier, grant.Status), nil
+			},
+		),
+	}
+}
+
+// grantEpochKey is the Epoch index key of the grants released by the epochs
+// of identifier in status. Statuses are keyed by their enum name.
+func grantEpochKey(identifier string, status types.PointGrantStatus) addressKey {
+	return collections.Join(identifier, status.String())
+}
+
+// IndexesList implements collections.Indexes.
+func (i PointGrantIndexes) IndexesList() []collections.Index[programKey, types.PointGrant] {
+	return []collections.Index[programKey, types.PointGrant]{i.Beneficiary, i.Epoch}
+}
+
+// getPointGrant returns the grant of a program with the given id.
+func (k Keeper) getPointGrant(ctx context.Context, programID string, id uint64) (types.PointGrant, error) {
+	grant, err := k.PointGrant.Get(ctx, collections.Join(programID, id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PointGrant{}, errorsmod.Wrapf(types.ErrGrantNotFound, "program %q id %d", programID, id)
+		}
+		return types.PointGrant{}, err
+	}
+	return grant, nil
+}
+
+// releaseGrants credits the tranches of the active grants of identifier that
+// are unlocked once epochNumber has ended. The tranches of a beneficiary
+// frozen from receiving points, of an issuer that was suspended or removed,
+// or over the issuer's issue velocity limit are held back until a later
+// epoch.
+func (k Keeper) releaseGrants(ctx context.Context, identifier string, epochNumber int64) error {
+	var keys []programKey
+	rng := collections.NewPrefixedPairRange[addressKey, programKey](grantEpochKey(identifier, types.PointGrantStatusActive))
+	if err := k.PointGrant.Indexes.Epoch.Walk(ctx, rng, func(_ addressKey, key programKey) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		grant, err := k.PointGrant.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		due := grant.DuePeriods(epochNumber)
+		if due <= grant.ReleasedPeriods {
+			continue
+		}
+		if err := k.releaseGrant(ctx, grant, due); err != nil {
+			if errors.Is(err, types.ErrAccountFrozen) || errors.Is(err, types.ErrUnauthorizedIssuer) ||
+				errors.Is(err, types.ErrIssuerSuspended) || errors.Is(err, types.ErrVelocityLimitExceeded) {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseGrant credits the tranches of grant up to due to its beneficiary.
+// It fails before changing any state if the beneficiary may not receive
+// points, the issuer is no longer an active issuer of the program, or the
+// release would exceed the issuer's issue velocity limit.
+func (k Keeper) releaseGrant(ctx context.Context, grant types.PointGrant, due uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	amount := (due - grant.ReleasedPeriods) * grant.AmountPerPeriod
+
+	// 0. 수령인 동결 여부, 발행자 상태 및 수령인별 일일 한도 확인
+	if err := k.assertCreditAllowed(ctx, grant.Beneficiary); err != nil {
+		return err
+	}
+	program, err := k.getProgram(ctx, grant.ProgramId)
+	if err != nil {
+		return err
+	}
+	if !program.IsIssuer(grant.Issuer) {
+		return errorsmod.Wrapf(types.ErrUnauthorizedIssuer, "%s is no longer an issuer of program %q", grant.Issuer, grant.ProgramId)
+	}
+	if _, err := k.getActiveIssuer(ctx, grant.Issuer); err != nil {
+		return err
+	}
+	if err := k.useVelocity(ctx, types.VelocityIssue, grant.Issuer, grant.Beneficiary, amount); err != nil {
+		return err
+	}
+
+	// 1. 포인트 로트 생성 및 잔액 증가
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	balance, err := k.creditLot(ctx, grant.ProgramId, grant.Beneficiary, amount, sdkCtx.BlockTime().Unix(), params.PointExpiresAt(sdkCtx.BlockTime(), nil))
+	if err != nil {
+		return err
+	}
+
+	// 2. 발행량 집계
+	if err := k.updateSupply(ctx, grant.ProgramId, func(supply *types.PointSupply) (err error) {
+		supply.Issued, err = addAmount(supply.Issued, amount)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// 3. 거래 기록 추가
+	id, err := k.TransactionSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.Transaction.Set(ctx, collections.Join(grant.ProgramId, id), types.Transaction{
+		Id:        id,
+		ProgramId: grant.ProgramId,
+		Sender:    grant.Issuer,
+		Recipient: grant.Beneficiary,
+		Amount:    amount,
+		TxType:    types.TransactionTypeGrant,
+		Timestamp: sdkCtx.BlockTime().Unix(),
+		Memo:      grant.Reason,
+	}); err != nil {
+		return err
+	}
+
+	// 4. 지급 회차 갱신, 마지막 회차면 완료 처리
+	grant.ReleasedPeriods = due
+	if grant.ReleasedPeriods == grant.Periods {
+		grant.Status = types.PointGrantStatusCompleted
+	}
+	if err := k.PointGrant.Set(ctx, collections.Join(grant.ProgramId, grant.Id), grant); err != nil {
+		return err
+	}
+
+	// 5. 이벤트 발생
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventPointGrantReleased{
+		GrantId:            grant.Id,
+		ProgramId:          grant.ProgramId,
+		Beneficiary:        grant.Beneficiary,
+		TransactionId:      id,
+		Amount:             amount,
+		ReleasedPeriods:    grant.ReleasedPeriods,
+		BeneficiaryBalance: balance.Balance,
+	})
+}
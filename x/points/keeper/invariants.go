@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// RegisterInvariants registers all points module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply", SupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "sequences", SequenceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "balance-index", BalanceIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tokenized", TokenizedInvariant(k))
+}
+
+// AllInvariants runs all invariants of the points module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			SupplyInvariant(k),
+			SequenceInvariant(k),
+			BalanceIndexInvariant(k),
+			TokenizedInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// SupplyInvariant checks that the balances of every program add up to the
+// points it issued minus the points settled, expired and tokenized.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		totals := make(map[string]uint64)
+		if err := k.PointBalance.Walk(ctx, nil, func(key addressKey, balance types.PointBalance) (bool, error) {
+			totals[key.K1()] += balance.Balance
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "supply", err.Error()), true
+		}
+
+		if err := k.Supply.Walk(ctx, nil, func(programID string, supply types.PointSupply) (bool, error) {
+			circulating, ok := supply.Circulating()
+			if !ok {
+				broken = true
+				msg += fmt.Sprintf("\tprogram %q settled %d, expired %d, tokenized %d and burned %d in fees of %d issued points\n", programID, supply.Settled, supply.Expired, supply.Tokenized, supply.FeesPaid, supply.Issued)
+			} else if totals[programID] != circulating {
+				broken = true
+				msg += fmt.Sprintf("\tprogram %q balances total %d, expected %d\n", programID, totals[programID], circulating)
+			}
+			delete(totals, programID)
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "supply", err.Error()), true
+		}
+		for programID, total := range totals {
+			if total > 0 {
+				broken = true
+				msg += fmt.Sprintf("\tprogram %q balances total %d without a supply\n", programID, total)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "supply", msg), broken
+	}
+}
+
+// SequenceInvariant checks that the transaction and settlement sequences are
+// ahead of every stored id.
+func SequenceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		nextTx, err := k.TransactionSeq.Peek(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "sequences", err.Error()), true
+		}
+		if err := k.Transaction.Walk(ctx, nil, func(key programKey, _ types.Transaction) (bool, error) {
+			if key.K2() >= nextTx {
+				broken = true
+				msg += fmt.Sprintf("\ttransaction %d is not below the transaction sequence %d\n", key.K2(), nextTx)
+			}
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "sequences", err.Error()), true
+		}
+
+		nextSettlement, err := k.SettlementSeq.Peek(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "sequences", err.Error()), true
+		}
+		if err := k.Settlement.Walk(ctx, nil, func(key programKey, _ types.Settlement) (bool, error) {
+			if key.K2() >= nextSettlement {
+				broken = true
+				msg += fmt.Sprintf("\tsettlement %d is not below the settlement sequence %d\n", key.K2(), nextSettlement)
+			}
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "sequences", err.Error()), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "sequences", msg), broken
+	}
+}
+
+// BalanceIndexInvariant checks that no balance is stored with an empty index
+// or under a key that does not match its program and index.
+func BalanceIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		if err := k.PointBalance.Walk(ctx, nil, func(key addressKey, balance types.PointBalance) (bool, error) {
+			switch {
+			case balance.Index == "":
+				broken = true
+				msg += fmt.Sprintf("\tbalance of program %q stored under %q has an empty index\n", key.K1(), key.K2())
+			case balance.Index != key.K2() || balance.ProgramId != key.K1():
+				broken = true
+				msg += fmt.Sprintf("\tbalance %q of program %q is stored under %q of program %q\n", balance.Index, balance.ProgramId, key.K2(), key.K1())
+			}
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "balance-index", err.Error()), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "balance-index", msg), broken
+	}
+}
+
+// TokenizedInvariant checks that the bank supply of every program denom and
+// the tokenized lot pool of the program both equal the points the program
+// recorded as tokenized, so no point exists both as a balance and as a coin
+// and every coin can be redeemed with its original expiry.
+func TokenizedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		if err := k.Supply.Walk(ctx, nil, func(programID string, supply types.PointSupply) (bool, error) {
+			coins := k.bankKeeper.GetSupply(ctx, types.ProgramDenom(programID))
+			if !coins.Amount.Equal(sdkmath.NewIntFromUint64(supply.Tokenized)) {
+				broken = true
+				msg += fmt.Sprintf("\tprogram %q tokenized %d points, bank supply is %s\n", programID, supply.Tokenized, coins)
+			}
+			var pooled uint64
+			if err := k.TokenizedLot.Walk(ctx, ownerLotsRange(programID, ""), func(_ lotKey, lot types.PointLot) (bool, error) {
+				pooled += lot.Amount
+				return false, nil
+			}); err != nil {
+				return true, err
+			}
+			if pooled != supply.Tokenized {
+				broken = true
+				msg += fmt.Sprintf("\tprogram %q tokenized %d points, tokenized lots hold %d\n", programID, supply.Tokenized, pooled)
+			}
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "tokenized", err.Error()), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "tokenized", msg), broken
+	}
+}
@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+// runSupplyFlow moves points through every supply counter and returns the
+// context the expiry sweep ran at.
+func runSupplyFlow(t *testing.T, f *fixture) sdk.Context {
+	t.Helper()
+
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	hour := time.Hour
+
+	issuePoints(t, f, ctx, alice, 100, nil)
+	issuePoints(t, f, ctx, bob, 10, &hour)
+
+	_, err := ms.SpendPoints(ctx, types.NewMsgSpendPoints(alice, testProgramID, registerMerchant(t, f, ctx, bob), 20, "coffee"))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, testProgramID, bob, 30))
+	require.NoError(t, err)
+	_, err = ms.RequestSettlement(ctx, types.NewMsgRequestSettlement(bob, testProgramID, 25))
+	require.NoError(t, err)
+	_, err = ms.RequestSettlement(ctx, types.NewMsgRequestSettlement(alice, testProgramID, 5))
+	require.NoError(t, err)
+	_, err = ms.CancelSettlement(ctx, types.NewMsgCancelSettlement(alice, testProgramID, 1))
+	require.NoError(t, err)
+
+	expired := ctx.WithBlockTime(ctx.BlockTime().Add(hour))
+	require.NoError(t, f.keeper.ExpireDueLots(expired))
+	return expired
+}
+
+func TestSupply(t *testing.T) {
+	f := initFixture(t)
+	ctx := runSupplyFlow(t, f)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	res, err := qs.Supply(ctx, &types.QuerySupplyRequest{ProgramId: testProgramID})
+	require.NoError(t, err)
+	// bob's 10 expiring points were settled first, so only what is left of
+	// them can expire
+	require.Equal(t, types.PointSupply{
+		ProgramId:   testProgramID,
+		Issued:      110,
+		Spent:       20,
+		Transferred: 30,
+		Settled:     25,
+		Expired:     0,
+	}, res.Supply)
+	require.EqualValues(t, 85, res.Circulating)
+
+	res, err = qs.Supply(ctx, &types.QuerySupplyRequest{ProgramId: "other"})
+	require.NoError(t, err)
+	require.Equal(t, types.PointSupply{ProgramId: "other"}, res.Supply)
+
+	_, err = qs.Supply(ctx, &types.QuerySupplyRequest{})
+	require.Error(t, err)
+}
+
+func TestSupplyCountsExpiredPoints(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+	hour := time.Hour
+
+	issuePoints(t, f, ctx, sample.AccAddress(), 40, &hour)
+	expired := ctx.WithBlockTime(ctx.BlockTime().Add(hour))
+	require.NoError(t, f.keeper.ExpireDueLots(expired))
+
+	supply, err := f.keeper.Supply.Get(expired, testProgramID)
+	require.NoError(t, err)
+	require.EqualValues(t, 40, supply.Expired)
+
+	msg, broken := keeper.AllInvariants(f.keeper)(expired)
+	require.False(t, broken, msg)
+}
+
+func TestInvariants(t *testing.T) {
+	testCases := []struct {
+		name      string
+		invariant func(keeper.Keeper) sdk.Invariant
+		corrupt   func(t *testing.T, f *fixture, ctx sdk.Context)
+	}{
+		{
+			name:      "balance without supply",
+			invariant: keeper.SupplyInvariant,
+			corrupt: func(t *testing.T, f *fixture, ctx sdk.Context) {
+				require.NoError(t, f.keeper.PointBalance.Set(ctx, collections.Join("other", "carol"), types.PointBalance{ProgramId: "other", Index: "carol", Address: "carol", Balance: 1}))
+			},
+		},
+		{
+			name:      "supply does not match balances",
+			invariant: keeper.SupplyInvariant,
+			corrupt: func(t *testing.T, f *fixture, ctx sdk.Context) {
+				supply, err := f.keeper.Supply.Get(ctx, testProgramID)
+				require.NoError(t, err)
+				supply.Issued++
+				require.NoError(t, f.keeper.Supply.Set(ctx, testProgramID, supply))
+			},
+		},
+		{
+			name:      "transaction ahead of its sequence",
+			invariant: keeper.SequenceInvariant,
+			corrupt: func(t *testing.T, f *fixture, ctx sdk.Context) {
+				next, err := f.keeper.TransactionSeq.Peek(ctx)
+				require.NoError(t, err)
+				require.NoError(t, f.keeper.Transaction.Set(ctx, collections.Join(testProgramID, next), types.Transaction{Id: next, ProgramId: testProgramID}))
+			},
+		},
+		{
+			name:      "settlement ahead of its sequence",
+			invariant: keeper.SequenceInvariant,
+			corrupt: func(t *testing.T, f *fixture, ctx sdk.Context) {
+				require.NoError(t, f.keeper.SettlementSeq.Set(ctx, 1))
+			},
+		},
+		{
+			name:      "coins minted outside tokenization",
+			invariant: keeper.TokenizedInvariant,
+			corrupt: func(t *testing.T, f *fixture, ctx sdk.Context) {
+				require.NoError(t, f.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.ProgramDenom(testProgramID), 1))))
+			},
+		},
+		{
+			name:      "tokenized lots not matching the tokenized supply",
+			invariant: keeper.TokenizedInvariant,
+			corrupt: func(t *testing.T, f *fixture, ctx sdk.Context) {
+				lot := types.PointLot{ProgramId: testProgramID, Id: 100, Amount: 1}
+				require.NoError(t, f.keeper.TokenizedLot.Set(ctx, collections.Join3(testProgramID, "", collections.Join(lot.IssuedAt, lot.Id)), lot))
+			},
+		},
+		{
+			name:      "balance with an empty index",
+			invariant: keeper.BalanceIndexInvariant,
+			corrupt: func(t *testing.T, f *fixture, ctx sdk.Context) {
+				require.NoError(t, f.keeper.PointBalance.Set(ctx, collections.Join(testProgramID, ""), types.PointBalance{ProgramId: testProgramID}))
+			},
+		},
+		{
+			name:      "balance under another key",
+			invariant: keeper.BalanceIndexInvariant,
+			corrupt: func(t *testing.T, f *fixture, ctx sdk.Context) {
+				require.NoError(t, f.keeper.PointBalance.Set(ctx, collections.Join(testProgramID, "carol"), types.PointBalance{ProgramId: testProgramID, Index: "dave"}))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			ctx := runSupplyFlow(t, f)
+
+			msg, broken := keeper.AllInvariants(f.keeper)(ctx)
+			require.False(t, broken, msg)
+
+			tc.corrupt(t, f, ctx)
+			_, broken = tc.invariant(f.keeper)(ctx)
+			require.True(t, broken)
+			_, broken = keeper.AllInvariants(f.keeper)(ctx)
+			require.True(t, broken)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// issuePoints issues amount points of a program to recipient on behalf of
+// issuer, which the caller has checked to be an issuer of the program. The
+// points expire after expiresIn or, if nil, the point expiry param. The memo
+// of note is the reason of the issuance.
+func (k Keeper) issuePoints(ctx context.Context, programID, issuer, recipient string, amount uint64, note transactionNote, expiresIn *time.Duration) error {
+	// 1. 메타데이터 한도, 수령인 동결 여부, 발행 권한, 에포크 한도 및 수령인별 일일 한도 확인
+	if amount == 0 {
+		return errorsmod.Wrap(types.ErrInvalidCoins, "amount must be positive")
+	}
+	if err := k.validateNote(ctx, note); err != nil {
+		return err
+	}
+	if err := k.assertCreditAllowed(ctx, recipient); err != nil {
+		return err
+	}
+	if err := k.useIssuerAllowance(ctx, issuer, amount); err != nil {
+		return err
+	}
+	if err := k.useVelocity(ctx, types.VelocityIssue, issuer, recipient, amount); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 2. 만료 시각 계산 (지정되지 않으면 params 기본값)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	expiresAt := params.PointExpiresAt(sdkCtx.BlockTime(), expiresIn)
+
+	// 3. 포인트 로트 생성 및 잔액 증가
+	balance, err := k.creditLot(ctx, programID, recipient, amount, sdkCtx.BlockTime().Unix(), expiresAt)
+	if err != nil {
+		return err
+	}
+
+	// 4. 발행량 집계
+	if err := k.updateSupply(ctx, programID, func(supply *types.PointSupply) (err error) {
+		supply.Issued, err = addAmount(supply.Issued, amount)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// 5. 거래 기록 (Transaction) 추가
+	id, err := k.TransactionSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx := types.Transaction{
+		Id:        id,
+		ProgramId: programID,
+		Sender:    issuer,
+		Recipient: recipient,
+		Amount:    amount,
+		TxType:    types.TransactionTypeIssue,
+		Timestamp: sdkCtx.BlockTime().Unix(),
+	}
+	note.applyTo(&tx)
+	if err := k.Transaction.Set(ctx, collections.Join(programID, id), tx); err != nil {
+		return err
+	}
+
+	// 6. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPointsIssued{
+		TransactionId:    id,
+		Issuer:           issuer,
+		Recipient:        recipient,
+		Amount:           amount,
+		Reason:           note.Memo,
+		RecipientBalance: balance.Balance,
+		ProgramId:        programID,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
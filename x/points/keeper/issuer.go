@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// currentIssuerEpoch returns the issuer cap epoch of the current block.
+func (k Keeper) currentIssuerEpoch(ctx context.Context) (int64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.IssuerEpochNumber(sdk.UnwrapSDKContext(ctx).BlockTime()), nil
+}
+
+// rollIssuerEpoch resets the issued counter of an issuer whose counter refers
+// to an older epoch.
+func rollIssuerEpoch(issuer types.Issuer, epoch int64) types.Issuer {
+	if issuer.EpochNumber != epoch {
+		issuer.EpochNumber = epoch
+		issuer.EpochIssued = 0
+	}
+	return issuer
+}
+
+// getActiveIssuer returns the issuer registered under address, failing if it
+// is not registered or suspended.
+func (k Keeper) getActiveIssuer(ctx context.Context, address string) (types.Issuer, error) {
+	issuer, err := k.Issuer.Get(ctx, address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Issuer{}, errorsmod.Wrapf(types.ErrUnauthorizedIssuer, "%s", address)
+		}
+		return types.Issuer{}, err
+	}
+	if issuer.Suspended {
+		return types.Issuer{}, errorsmod.Wrapf(types.ErrIssuerSuspended, "%s", address)
+	}
+	return issuer, nil
+}
+
+// useIssuerAllowance checks that address is an active issuer allowed to issue
+// amount in the current epoch and records the issuance against its cap.
+func (k Keeper) useIssuerAllowance(ctx context.Context, address string, amount uint64) error {
+	issuer, err := k.getActiveIssuer(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	epoch, err := k.currentIssuerEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	issuer = rollIssuerEpoch(issuer, epoch)
+
+	if issuer.EpochCap > 0 && (issuer.EpochIssued > issuer.EpochCap || amount > issuer.EpochCap-issuer.EpochIssued) {
+		return errorsmod.Wrapf(types.ErrIssuerCapExceeded, "issued %d of %d this epoch, requested %d", issuer.EpochIssued, issuer.EpochCap, amount)
+	}
+	if issuer.EpochIssued, err = addAmount(issuer.EpochIssued, amount); err != nil {
+		return err
+	}
+
+	return k.Issuer.Set(ctx, address, issuer)
+}
+
+// returnIssuerAllowance gives amount back to the cap of the issuer at address
+// for the epoch it was used in. Nothing is returned once that epoch is over,
+// or if the issuer was removed.
+func (k Keeper) returnIssuerAllowance(ctx context.Context, address string, amount uint64, epoch int64) error {
+	issuer, err := k.Issuer.Get(ctx, address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if issuer.EpochNumber != epoch {
+		return nil
+	}
+	issuer.EpochIssued -= min(amount, issuer.EpochIssued)
+
+	return k.Issuer.Set(ctx, address, issuer)
+}
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	"scontract/x/points/types"
@@ -15,17 +17,57 @@ type Keeper struct {
 	storeService corestore.KVStoreService
 	cdc          codec.Codec
 	addressCodec address.Codec
+	bankKeeper   types.BankKeeper
+	epochsKeeper types.EpochsKeeper
 	// Address capable of executing a MsgUpdateParams message.
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
 	Schema         collections.Schema
 	Params         collections.Item[types.Params]
-	PointBalance   collections.Map[string, types.PointBalance]
+	Program        collections.Map[string, types.PointProgram]
+	PointBalance   collections.Map[addressKey, types.PointBalance]
 	TransactionSeq collections.Sequence
-	Transaction    collections.Map[uint64, types.Transaction]
+	Transaction    *collections.IndexedMap[programKey, types.Transaction, TransactionIndexes]
 	SettlementSeq  collections.Sequence
-	Settlement     collections.Map[uint64, types.Settlement]
+	Settlement     *collections.IndexedMap[programKey, types.Settlement, SettlementIndexes]
+	Issuer         collections.Map[string, types.Issuer]
+	PointLotSeq    collections.Sequence
+	PointLot       *collections.IndexedMap[lotKey, types.PointLot, PointLotIndexes]
+	MerchantSeq    collections.Sequence
+	Merchant       collections.Map[uint64, types.Merchant]
+	Supply         collections.Map[string, types.PointSupply]
+
+	// TokenizedLot holds the parts of lots that were tokenized, keyed like
+	// PointLot with an empty owner, so that detokenized points get their
+	// issue and expiry times back.
+	TokenizedLot collections.Map[lotKey, types.PointLot]
+
+	VelocityOverride collections.Map[string, types.VelocityOverride]
+	VelocityUsage    collections.Map[velocityKey, types.VelocityUsage]
+	FrozenAccount    collections.Map[string, types.FrozenAccount]
+
+	// TransactionCheckpoint holds the rolled up pruned transactions of each
+	// account.
+	TransactionCheckpoint collections.Map[addressKey, types.TransactionCheckpoint]
+	// RefundableSpend holds the pruned spends that are not fully refunded
+	// yet, without their memo and metadata, so that they can still be
+	// refunded.
+	RefundableSpend collections.Map[programKey, types.Transaction]
+
+	PointGrantSeq  collections.Sequence
+	PointGrant     *collections.IndexedMap[programKey, types.PointGrant, PointGrantIndexes]
+	ProcessedEpoch collections.Map[string, types.ProcessedEpoch]
+
+	SettlementBatchSeq collections.Sequence
+	SettlementBatch    *collections.IndexedMap[programKey, types.SettlementBatch, SettlementBatchIndexes]
+
+	BalanceSnapshotSeq collections.Sequence
+	BalanceSnapshot    collections.Map[uint64, types.BalanceSnapshot]
+	SnapshotBalance    collections.Map[snapshotBalanceKey, types.SnapshotBalance]
+	// ChangedBalance holds the accounts whose balance changed since the last
+	// balance snapshot.
+	ChangedBalance collections.KeySet[addressKey]
 }
 
 func NewKeeper(
@@ -33,7 +75,8 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
+	bankKeeper types.BankKeeper,
+	epochsKeeper types.EpochsKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -46,12 +89,48 @@ func NewKeeper(
 		cdc:          cdc,
 		addressCodec: addressCodec,
 		authority:    authority,
+		bankKeeper:   bankKeeper,
+		epochsKeeper: epochsKeeper,
 
-		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		PointBalance: collections.NewMap(sb, types.PointBalanceKey, "pointBalance", collections.StringKey, codec.CollValue[types.PointBalance](cdc)), Transaction: collections.NewMap(sb, types.TransactionKey, "transaction", collections.Uint64Key, codec.CollValue[types.Transaction](cdc)),
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Program:        collections.NewMap(sb, types.ProgramKey, "program", collections.StringKey, codec.CollValue[types.PointProgram](cdc)),
+		PointBalance:   collections.NewMap(sb, types.PointBalanceKey, "pointBalance", addressKeyCodec, codec.CollValue[types.PointBalance](cdc)),
+		Transaction:    collections.NewIndexedMap(sb, types.TransactionKey, "transaction", programKeyCodec, codec.CollValue[types.Transaction](cdc), newTransactionIndexes(sb)),
 		TransactionSeq: collections.NewSequence(sb, types.TransactionCountKey, "transactionSequence"),
-		Settlement:     collections.NewMap(sb, types.SettlementKey, "settlement", collections.Uint64Key, codec.CollValue[types.Settlement](cdc)),
+		Settlement:     collections.NewIndexedMap(sb, types.SettlementKey, "settlement", programKeyCodec, codec.CollValue[types.Settlement](cdc), newSettlementIndexes(sb)),
 		SettlementSeq:  collections.NewSequence(sb, types.SettlementCountKey, "settlementSequence"),
+		Issuer:         collections.NewMap(sb, types.IssuerKey, "issuer", collections.StringKey, codec.CollValue[types.Issuer](cdc)),
+		PointLotSeq:    collections.NewSequence(sb, types.PointLotCountKey, "pointLotSequence"),
+		PointLot: collections.NewIndexedMap(
+			sb, types.PointLotKey, "pointLot",
+			lotKeyCodec,
+			codec.CollValue[types.PointLot](cdc),
+			newPointLotIndexes(sb),
+		),
+		MerchantSeq: collections.NewSequence(sb, types.MerchantCountKey, "merchantSequence"),
+		Merchant:    collections.NewMap(sb, types.MerchantKey, "merchant", collections.Uint64Key, codec.CollValue[types.Merchant](cdc)),
+		Supply:      collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, codec.CollValue[types.PointSupply](cdc)),
+
+		TokenizedLot: collections.NewMap(sb, types.TokenizedLotKey, "tokenizedLot", lotKeyCodec, codec.CollValue[types.PointLot](cdc)),
+
+		VelocityOverride: collections.NewMap(sb, types.VelocityOverrideKey, "velocityOverride", collections.StringKey, codec.CollValue[types.VelocityOverride](cdc)),
+		VelocityUsage:    collections.NewMap(sb, types.VelocityUsageKey, "velocityUsage", velocityKeyCodec, codec.CollValue[types.VelocityUsage](cdc)),
+		FrozenAccount:    collections.NewMap(sb, types.FrozenAccountKey, "frozenAccount", collections.StringKey, codec.CollValue[types.FrozenAccount](cdc)),
+
+		TransactionCheckpoint: collections.NewMap(sb, types.TransactionCheckpointKey, "transactionCheckpoint", addressKeyCodec, codec.CollValue[types.TransactionCheckpoint](cdc)),
+		RefundableSpend:       collections.NewMap(sb, types.RefundableSpendKey, "refundableSpend", programKeyCodec, codec.CollValue[types.Transaction](cdc)),
+
+		PointGrantSeq:  collections.NewSequence(sb, types.PointGrantCountKey, "pointGrantSequence"),
+		PointGrant:     collections.NewIndexedMap(sb, types.PointGrantKey, "pointGrant", programKeyCodec, codec.CollValue[types.PointGrant](cdc), newPointGrantIndexes(sb)),
+		ProcessedEpoch: collections.NewMap(sb, types.ProcessedEpochKey, "processedEpoch", collections.StringKey, codec.CollValue[types.ProcessedEpoch](cdc)),
+
+		SettlementBatchSeq: collections.NewSequence(sb, types.SettlementBatchCountKey, "settlementBatchSequence"),
+		SettlementBatch:    collections.NewIndexedMap(sb, types.SettlementBatchKey, "settlementBatch", programKeyCodec, codec.CollValue[types.SettlementBatch](cdc), newSettlementBatchIndexes(sb)),
+
+		BalanceSnapshotSeq: collections.NewSequence(sb, types.BalanceSnapshotCountKey, "balanceSnapshotSequence"),
+		BalanceSnapshot:    collections.NewMap(sb, types.BalanceSnapshotKey, "balanceSnapshot", collections.Uint64Key, codec.CollValue[types.BalanceSnapshot](cdc)),
+		SnapshotBalance:    collections.NewMap(sb, types.SnapshotBalanceKey, "snapshotBalance", snapshotBalanceKeyCodec, codec.CollValue[types.SnapshotBalance](cdc)),
+		ChangedBalance:     collections.NewKeySet(sb, types.ChangedBalanceKey, "changedBalance", addressKeyCodec),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -66,3 +145,18 @@ func NewKeeper(
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
+
+// assertAuthority returns an error if the given address is not the module's authority.
+func (k Keeper) assertAuthority(address string) error {
+	authority, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, address)
+	}
+
+	return nil
+}
@@ -3,25 +3,39 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
 
+	"scontract/testutil/sample"
 	"scontract/x/points/keeper"
 	module "scontract/x/points/module"
 	"scontract/x/points/types"
 )
 
+// testProgramID is the program the fixture creates, with the module authority
+// as its owner and issuer.
+const testProgramID = "loyalty"
+
 type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	bankKeeper   *mockBankKeeper
+	epochsKeeper *mockEpochsKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,12 +49,16 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := newMockBankKeeper()
+	epochsKeeper := newMockEpochsKeeper()
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		bankKeeper,
+		epochsKeeper,
 	)
 
 	// Initialize params
@@ -48,9 +66,183 @@ func initFixture(t *testing.T) *fixture {
 		t.Fatalf("failed to set params: %v", err)
 	}
 
+	authorityStr, err := addressCodec.BytesToString(authority)
+	if err != nil {
+		t.Fatalf("failed to encode authority: %v", err)
+	}
+	if err := k.Program.Set(ctx, testProgramID, types.PointProgram{
+		Id:      testProgramID,
+		Name:    "Loyalty",
+		Owner:   authorityStr,
+		Issuers: []string{authorityStr},
+	}); err != nil {
+		t.Fatalf("failed to create program: %v", err)
+	}
+
 	return &fixture{
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		bankKeeper:   bankKeeper,
+		epochsKeeper: epochsKeeper,
+	}
+}
+
+// mockBankKeeper is an in-memory bank keeper that tracks account and module
+// account balances.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+	metadata map[string]banktypes.Metadata
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{
+		balances: make(map[string]sdk.Coins),
+		metadata: make(map[string]banktypes.Metadata),
+	}
+}
+
+func (b *mockBankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	b.balances[addr] = b.balances[addr].Add(amt...)
+	b.supply = b.supply.Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	balance, hasNeg := b.balances[addr].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.balances[addr] = balance
+	b.supply = b.supply.Sub(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.supply.AmountOf(denom))
+}
+
+func (b *mockBankKeeper) HasDenomMetaData(_ context.Context, denom string) bool {
+	_, ok := b.metadata[denom]
+	return ok
+}
+
+func (b *mockBankKeeper) SetDenomMetaData(_ context.Context, metadata banktypes.Metadata) {
+	b.metadata[metadata.Base] = metadata
+}
+
+func (b *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.balances[from.String()] = balance
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
+
+// mockEpochsKeeper is an in-memory epochs keeper holding a started "week"
+// epoch whose number tests move forward.
+type mockEpochsKeeper struct {
+	infos map[string]epochstypes.EpochInfo
+}
+
+func newMockEpochsKeeper() *mockEpochsKeeper {
+	return &mockEpochsKeeper{infos: map[string]epochstypes.EpochInfo{
+		"week": {Identifier: "week", Duration: 7 * 24 * time.Hour, CurrentEpoch: 1, EpochCountingStarted: true},
+	}}
+}
+
+func (e *mockEpochsKeeper) GetEpochInfo(_ sdk.Context, identifier string) (epochstypes.EpochInfo, error) {
+	info, ok := e.infos[identifier]
+	if !ok {
+		return epochstypes.EpochInfo{}, collections.ErrNotFound
+	}
+	return info, nil
+}
+
+func (e *mockEpochsKeeper) AllEpochInfos(_ sdk.Context) ([]epochstypes.EpochInfo, error) {
+	infos := make([]epochstypes.EpochInfo, 0, len(e.infos))
+	for _, info := range e.infos {
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// endEpoch ends the current epoch of identifier and starts the next one.
+func (e *mockEpochsKeeper) endEpoch(identifier string) {
+	info := e.infos[identifier]
+	info.CurrentEpoch++
+	e.infos[identifier] = info
+}
+
+// issuePoints issues amount points to recipient through an unlimited issuer,
+// expiring after expiresIn or, if nil, the default point expiry.
+func issuePoints(t *testing.T, f *fixture, ctx context.Context, recipient string, amount uint64, expiresIn *time.Duration) {
+	t.Helper()
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	issuer := authorityStr
+
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	_, err = ms.AddIssuer(ctx, types.NewMsgAddIssuer(authorityStr, issuer, 0))
+	require.NoError(t, err)
+
+	msg := types.NewMsgIssuePoints(issuer, testProgramID, recipient, amount, "test")
+	msg.ExpiresIn = expiresIn
+	_, err = ms.IssuePoints(ctx, msg)
+	require.NoError(t, err)
+}
+
+// registerMerchant registers and approves a merchant whose spent points are
+// credited to settlementAddress and returns its id.
+func registerMerchant(t *testing.T, f *fixture, ctx context.Context, settlementAddress string) uint64 {
+	t.Helper()
+
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	res, err := ms.RegisterMerchant(ctx, types.NewMsgRegisterMerchant(sample.AccAddress(), "Cafe", settlementAddress))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.ApproveMerchant(ctx, types.NewMsgApproveMerchant(authority, res.Id))
+	require.NoError(t, err)
+	return res.Id
+}
+
+// requireLastEvent asserts that the last typed event of want's type emitted on
+// ctx equals want.
+func requireLastEvent(t *testing.T, ctx context.Context, want proto.Message) {
+	t.Helper()
+
+	events := sdk.UnwrapSDKContext(ctx).EventManager().ABCIEvents()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != proto.MessageName(want) {
+			continue
+		}
+		got, err := sdk.ParseTypedEvent(events[i])
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+		return
 	}
+	t.Fatalf("no %s event emitted", proto.MessageName(want))
 }
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// lotKey is the primary key of a point lot: program, owner, and issue time
+// and id, so that an owner's lots in a program iterate oldest first.
+type lotKey = collections.Triple[string, string, collections.Pair[int64, uint64]]
+
+var lotKeyCodec = collections.TripleKeyCodec(
+	collections.StringKey, collections.StringKey,
+	collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+)
+
+func newLotKey(lot types.PointLot) lotKey {
+	return collections.Join3(lot.ProgramId, lot.Owner, collections.Join(lot.IssuedAt, lot.Id))
+}
+
+// ownerLotsRange ranges over the lots of owner in a program, oldest first.
+func ownerLotsRange(programID, owner string) collections.Ranger[lotKey] {
+	return collections.NewSuperPrefixedTripleRange[string, string, collections.Pair[int64, uint64]](programID, owner)
+}
+
+// PointLotIndexes are the secondary indexes of the PointLot map.
+type PointLotIndexes struct {
+	// Expiry indexes lots by expiry time. Lots that never expire are indexed
+	// under zero.
+	Expiry *indexes.Multi[int64, lotKey, types.PointLot]
+}
+
+func newPointLotIndexes(sb *collections.SchemaBuilder) PointLotIndexes {
+	return PointLotIndexes{
+		Expiry: indexes.NewMulti(
+			sb, types.PointLotByExpiryKey, "pointLotByExpiry",
+			collections.Int64Key, lotKeyCodec,
+			func(_ lotKey, lot types.PointLot) (int64, error) { return lot.ExpiresAt, nil },
+		),
+	}
+}
+
+// IndexesList implements collections.Indexes.
+func (i PointLotIndexes) IndexesList() []collections.Index[lotKey, types.PointLot] {
+	return []collections.Index[lotKey, types.PointLot]{i.Expiry}
+}
+
+// dueLotsRange ranges over the expiry index entries of lots expiring at or
+// before until, skipping lots that never expire.
+type dueLotsRange struct {
+	until int64
+}
+
+func (r dueLotsRange) RangeValues() (start, end *collections.RangeKey[collections.Pair[int64, lotKey]], order collections.Order, err error) {
+	return collections.RangeKeyExact(collections.PairPrefix[int64, lotKey](1)),
+		collections.RangeKeyPrefixEnd(collections.PairPrefix[int64, lotKey](r.until)),
+		collections.OrderAscending,
+		nil
+}
+
+// isExpired reports whether lot has expired at blockTime.
+func isExpired(lot types.PointLot, blockTime int64) bool {
+	return lot.ExpiresAt != 0 && lot.ExpiresAt <= blockTime
+}
+
+// creditLot adds a lot of amount points of a program to owner and returns the
+// updated balance.
+func (k Keeper) creditLot(ctx context.Context, programID, owner string, amount uint64, issuedAt, expiresAt int64) (types.PointBalance, error) {
+	if amount == 0 {
+		return k.getBalance(ctx, programID, owner)
+	}
+
+	id, err := k.PointLotSeq.Next(ctx)
+	if err != nil {
+		return types.PointBalance{}, err
+	}
+	lot := types.PointLot{
+		Id:        id,
+		ProgramId: programID,
+		Owner:     owner,
+		Amount:    amount,
+		IssuedAt:  issuedAt,
+		ExpiresAt: expiresAt,
+	}
+	if err := k.PointLot.Set(ctx, newLotKey(lot), lot); err != nil {
+		return types.PointBalance{}, err
+	}
+
+	return k.addBalance(ctx, programID, owner, amount)
+}
+
+// creditLots gives owner lots in a program with the amounts, issue and expiry
+// times of lots, as taken by debitLots, and returns the updated balance.
+func (k Keeper) creditLots(ctx context.Context, programID, owner string, lots []types.PointLot) (types.PointBalance, error) {
+	balance, err := k.getBalance(ctx, programID, owner)
+	if err != nil {
+		return types.PointBalance{}, err
+	}
+	for _, lot := range lots {
+		balance, err = k.creditLot(ctx, programID, owner, lot.Amount, lot.IssuedAt, lot.ExpiresAt)
+		if err != nil {
+			return types.PointBalance{}, err
+		}
+	}
+	return balance, nil
+}
+
+// debitLots takes amount points from owner's unexpired lots in a program,
+// oldest first. It returns the updated balance and the part taken from each
+// lot.
+func (k Keeper) debitLots(ctx context.Context, programID, owner string, amount uint64) (types.PointBalance, []types.PointLot, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	var (
+		taken     []types.PointLot
+		remaining = amount
+		available uint64
+	)
+	if err := k.PointLot.Walk(ctx, ownerLotsRange(programID, owner), func(_ lotKey, lot types.PointLot) (bool, error) {
+		if isExpired(lot, blockTime) {
+			return false, nil
+		}
+		part := lot
+		part.Amount = min(lot.Amount, remaining)
+		taken = append(taken, part)
+		available += lot.Amount
+		remaining -= part.Amount
+		return remaining == 0, nil
+	}); err != nil {
+		return types.PointBalance{}, nil, err
+	}
+	if remaining > 0 {
+		return types.PointBalance{}, nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "balance is %d but needed %d", available, amount)
+	}
+
+	for _, part := range taken {
+		key := newLotKey(part)
+		lot, err := k.PointLot.Get(ctx, key)
+		if err != nil {
+			return types.PointBalance{}, nil, err
+		}
+		lot.Amount -= part.Amount
+		if lot.Amount == 0 {
+			err = k.PointLot.Remove(ctx, key)
+		} else {
+			err = k.PointLot.Set(ctx, key, lot)
+		}
+		if err != nil {
+			return types.PointBalance{}, nil, err
+		}
+	}
+
+	balance, err := k.subBalance(ctx, programID, owner, amount)
+	if err != nil {
+		return types.PointBalance{}, nil, err
+	}
+	return balance, taken, nil
+}
+
+// ExpireDueLots expires up to types.ExpiryBatchSize lots that are due at the
+// current block time, recording an expire transaction for each. Lots left
+// over are expired in later blocks.
+func (k Keeper) ExpireDueLots(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime().Unix()
+
+	var due []lotKey
+	if err := k.PointLot.Indexes.Expiry.Walk(ctx, dueLotsRange{until: blockTime}, func(_ int64, key lotKey) (bool, error) {
+		due = append(due, key)
+		return len(due) >= types.ExpiryBatchSize, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		lot, err := k.PointLot.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if err := k.PointLot.Remove(ctx, key); err != nil {
+			return err
+		}
+		balance, err := k.subBalance(ctx, lot.ProgramId, lot.Owner, lot.Amount)
+		if err != nil {
+			return err
+		}
+		if err := k.updateSupply(ctx, lot.ProgramId, func(supply *types.PointSupply) (err error) {
+			supply.Expired, err = addAmount(supply.Expired, lot.Amount)
+			return err
+		}); err != nil {
+			return err
+		}
+
+		id, err := k.TransactionSeq.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if err := k.Transaction.Set(ctx, collections.Join(lot.ProgramId, id), types.Transaction{
+			Id:        id,
+			ProgramId: lot.ProgramId,
+			Sender:    lot.Owner,
+			Amount:    lot.Amount,
+			TxType:    types.TransactionTypeExpire,
+			Timestamp: blockTime,
+		}); err != nil {
+			return err
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPointsExpired{
+			TransactionId: id,
+			Owner:         lot.Owner,
+			LotId:         lot.Id,
+			Amount:        lot.Amount,
+			OwnerBalance:  balance.Balance,
+			ProgramId:     lot.ProgramId,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
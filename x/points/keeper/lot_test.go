@@ -0,0 +1,261 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+// ownerLots returns the point lots of owner, oldest first.
+func ownerLots(t *testing.T, f *fixture, ctx sdk.Context, owner string) []types.PointLot {
+	t.Helper()
+
+	var lots []types.PointLot
+	rng := collections.NewSuperPrefixedTripleRange[string, string, collections.Pair[int64, uint64]](testProgramID, owner)
+	require.NoError(t, f.keeper.PointLot.Walk(ctx, rng, func(_ collections.Triple[string, string, collections.Pair[int64, uint64]], lot types.PointLot) (bool, error) {
+		lots = append(lots, lot)
+		return false, nil
+	}))
+	return lots
+}
+
+func TestIssuePointsExpiry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	owner := sample.AccAddress()
+
+	week := 7 * 24 * time.Hour
+	issuePoints(t, f, ctx, owner, 10, nil)
+	issuePoints(t, f, ctx, owner, 20, &week)
+
+	lots := ownerLots(t, f, ctx, owner)
+	require.Len(t, lots, 2)
+	require.Equal(t, blockTime.Add(types.DefaultPointExpiry).Unix(), lots[0].ExpiresAt)
+	require.Equal(t, blockTime.Add(week).Unix(), lots[1].ExpiresAt)
+
+	// a zero expiry in params means issued points never expire
+	params := types.DefaultParams()
+	params.PointExpiry = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	issuePoints(t, f, ctx, owner, 30, nil)
+	require.Zero(t, ownerLots(t, f, ctx, owner)[2].ExpiresAt)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	msg := types.NewMsgIssuePoints(authorityStr, testProgramID, owner, 10, "test")
+	negative := -time.Hour
+	msg.ExpiresIn = &negative
+	_, err = ms.IssuePoints(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+}
+
+func TestSpendPointsOldestLotFirst(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	owner := sample.AccAddress()
+
+	issuePoints(t, f, ctx, owner, 50, nil)
+	issuePoints(t, f, ctx.WithBlockTime(blockTime.Add(time.Hour)), owner, 50, nil)
+
+	_, err := ms.SpendPoints(ctx, types.NewMsgSpendPoints(owner, testProgramID, registerMerchant(t, f, ctx, sample.AccAddress()), 60, "coffee"))
+	require.NoError(t, err)
+
+	lots := ownerLots(t, f, ctx, owner)
+	require.Len(t, lots, 1)
+	require.Equal(t, blockTime.Add(time.Hour).Unix(), lots[0].IssuedAt)
+	require.EqualValues(t, 40, lots[0].Amount)
+}
+
+func TestSpendPointsKeepsExpiry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	spender := sample.AccAddress()
+	merchantAddress := sample.AccAddress()
+
+	day := 24 * time.Hour
+	issuePoints(t, f, ctx, spender, 100, &day)
+
+	later := ctx.WithBlockTime(blockTime.Add(time.Hour))
+	_, err := ms.SpendPoints(later, types.NewMsgSpendPoints(spender, testProgramID, registerMerchant(t, f, later, merchantAddress), 40, "coffee"))
+	require.NoError(t, err)
+
+	// the merchant receives the spent lot with its original expiry
+	lots := ownerLots(t, f, later, merchantAddress)
+	require.Len(t, lots, 1)
+	require.Equal(t, blockTime.Unix(), lots[0].IssuedAt)
+	require.Equal(t, blockTime.Add(day).Unix(), lots[0].ExpiresAt)
+	require.EqualValues(t, 40, lots[0].Amount)
+}
+
+func TestTransferPointsKeepsExpiry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	sender := sample.AccAddress()
+	recipient := sample.AccAddress()
+
+	day := 24 * time.Hour
+	issuePoints(t, f, ctx, sender, 100, &day)
+
+	later := ctx.WithBlockTime(blockTime.Add(time.Hour))
+	_, err := ms.TransferPoints(later, types.NewMsgTransferPoints(sender, testProgramID, recipient, 40))
+	require.NoError(t, err)
+
+	lots := ownerLots(t, f, later, recipient)
+	require.Len(t, lots, 1)
+	require.Equal(t, blockTime.Unix(), lots[0].IssuedAt)
+	require.Equal(t, blockTime.Add(day).Unix(), lots[0].ExpiresAt)
+	require.EqualValues(t, 40, lots[0].Amount)
+
+	// transferring to oneself leaves the balance unchanged
+	_, err = ms.TransferPoints(later, types.NewMsgTransferPoints(sender, testProgramID, sender, 60))
+	require.NoError(t, err)
+	balance, err := f.keeper.PointBalance.Get(later, collections.Join(testProgramID, sender))
+	require.NoError(t, err)
+	require.EqualValues(t, 60, balance.Balance)
+	requireLastEvent(t, later, &types.EventPointsTransferred{
+		TransactionId:    2,
+		Sender:           sender,
+		Recipient:        sender,
+		Amount:           60,
+		SenderBalance:    60,
+		RecipientBalance: 60,
+		ProgramId:        testProgramID,
+	})
+}
+
+func TestExpireDueLots(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	owner := sample.AccAddress()
+
+	hour := time.Hour
+	issuePoints(t, f, ctx, owner, 30, &hour)
+	issuePoints(t, f, ctx, owner, 70, nil)
+
+	// expired points cannot be spent even before they are swept
+	expired := ctx.WithBlockTime(blockTime.Add(hour))
+	_, err := ms.SpendPoints(expired, types.NewMsgSpendPoints(owner, testProgramID, registerMerchant(t, f, ctx, sample.AccAddress()), 71, "coffee"))
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	require.NoError(t, f.keeper.ExpireDueLots(expired))
+
+	balance, err := f.keeper.PointBalance.Get(expired, collections.Join(testProgramID, owner))
+	require.NoError(t, err)
+	require.EqualValues(t, 70, balance.Balance)
+	require.Len(t, ownerLots(t, f, expired, owner), 1)
+
+	tx, err := f.keeper.Transaction.Get(expired, collections.Join(testProgramID, uint64(2)))
+	require.NoError(t, err)
+	require.Equal(t, types.TransactionTypeExpire, tx.TxType)
+	require.EqualValues(t, 30, tx.Amount)
+	requireLastEvent(t, expired, &types.EventPointsExpired{
+		TransactionId: 2,
+		Owner:         owner,
+		LotId:         0,
+		Amount:        30,
+		OwnerBalance:  70,
+		ProgramId:     testProgramID,
+	})
+}
+
+func TestExpireDueLotsBatch(t *testing.T) {
+	f := initFixture(t)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	owner := sample.AccAddress()
+
+	hour := time.Hour
+	for range types.ExpiryBatchSize + 1 {
+		issuePoints(t, f, ctx, owner, 1, &hour)
+	}
+
+	expired := ctx.WithBlockTime(blockTime.Add(hour))
+	require.NoError(t, f.keeper.ExpireDueLots(expired))
+	require.Len(t, ownerLots(t, f, expired, owner), 1)
+
+	require.NoError(t, f.keeper.ExpireDueLots(expired))
+	require.Empty(t, ownerLots(t, f, expired, owner))
+	balance, err := f.keeper.PointBalance.Get(expired, collections.Join(testProgramID, owner))
+	require.NoError(t, err)
+	require.Zero(t, balance.Balance)
+}
+
+func TestRejectSettlementRestoresLots(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	requester := sample.AccAddress()
+
+	day := 24 * time.Hour
+	issuePoints(t, f, ctx, requester, 100, &day)
+	_, err := ms.RequestSettlement(ctx, types.NewMsgRequestSettlement(requester, testProgramID, 100))
+	require.NoError(t, err)
+	require.Empty(t, ownerLots(t, f, ctx, requester))
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	later := ctx.WithBlockTime(blockTime.Add(time.Hour))
+	_, err = ms.RejectSettlement(later, types.NewMsgRejectSettlement(authorityStr, testProgramID, 0, "invalid invoice"))
+	require.NoError(t, err)
+
+	lots := ownerLots(t, f, later, requester)
+	require.Len(t, lots, 1)
+	require.EqualValues(t, 100, lots[0].Amount)
+	require.Equal(t, blockTime.Add(day).Unix(), lots[0].ExpiresAt)
+}
+
+func TestUpcomingExpirationsQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	owner := sample.AccAddress()
+
+	day, week := 24*time.Hour, 7*24*time.Hour
+	issuePoints(t, f, ctx, owner, 10, &week)
+	issuePoints(t, f, ctx.WithBlockTime(blockTime.Add(time.Hour)), owner, 20, &day)
+	params := types.DefaultParams()
+	params.PointExpiry = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	issuePoints(t, f, ctx, owner, 40, nil)
+
+	resp, err := qs.UpcomingExpirations(ctx, &types.QueryUpcomingExpirationsRequest{ProgramId: testProgramID, Address: owner})
+	require.NoError(t, err)
+	require.EqualValues(t, 30, resp.Total)
+	require.Len(t, resp.Lots, 2)
+	require.EqualValues(t, 20, resp.Lots[0].Amount)
+	require.EqualValues(t, 10, resp.Lots[1].Amount)
+
+	resp, err = qs.UpcomingExpirations(ctx, &types.QueryUpcomingExpirationsRequest{ProgramId: testProgramID, Address: owner, Until: blockTime.Add(2 * day).Unix()})
+	require.NoError(t, err)
+	require.EqualValues(t, 20, resp.Total)
+
+	_, err = qs.UpcomingExpirations(ctx, &types.QueryUpcomingExpirationsRequest{ProgramId: testProgramID})
+	require.Error(t, err)
+}
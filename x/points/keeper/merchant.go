@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"scontract/x/points/types"
+)
+
+// getMerchant returns the merchant with the given id.
+func (k Keeper) getMerchant(ctx context.Context, id uint64) (types.Merchant, error) {
+	merchant, err := k.Merchant.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Merchant{}, errorsmod.Wrapf(types.ErrMerchantNotFound, "%d", id)
+		}
+		return types.Merchant{}, err
+	}
+	return merchant, nil
+}
+
+// getActiveMerchant returns the merchant with the given id if it can receive
+// spends.
+func (k Keeper) getActiveMerchant(ctx context.Context, id uint64) (types.Merchant, error) {
+	merchant, err := k.getMerchant(ctx, id)
+	if err != nil {
+		return types.Merchant{}, err
+	}
+	if !merchant.Active {
+		return types.Merchant{}, errorsmod.Wrapf(types.ErrMerchantInactive, "%d", id)
+	}
+	return merchant, nil
+}
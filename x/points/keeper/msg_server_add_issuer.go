@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+)
+
+func (k msgServer) AddIssuer(ctx context.Context, msg *types.MsgAddIssuer) (*types.MsgAddIssuerResponse, error) {
+	if err := k.assertAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+
+	// An existing issuer keeps its status and issued counter, only the cap changes.
+	issuer, err := k.Issuer.Get(ctx, msg.Address)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		issuer = types.Issuer{Address: msg.Address}
+	}
+	issuer.EpochCap = msg.EpochCap
+
+	if err := k.Issuer.Set(ctx, msg.Address, issuer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddIssuerResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ApproveMerchant(ctx context.Context, msg *types.MsgApproveMerchant) (*types.MsgApproveMerchantResponse, error) {
+	if err := k.assertAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// 1. 가맹점 조회
+	merchant, err := k.getMerchant(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 활성화
+	merchant.Active = true
+	if err := k.Merchant.Set(ctx, merchant.Id, merchant); err != nil {
+		return nil, err
+	}
+
+	// 3. 이벤트 발생
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMerchantApproved{
+		MerchantId: merchant.Id,
+		Actor:      msg.Authority,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveMerchantResponse{}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+func (k msgServer) ApproveSettlement(ctx context.Context, msg *types.MsgApproveSettlement) (*types.MsgApproveSettlementResponse, error) {
+	if err := k.assertSettler(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	settlement, err := k.getSettlement(ctx, msg.ProgramId, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 지급이 꺼져 있으면 승인 거부 (승인된 정산을 나중에 지급하는 경로가 없음)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := params.SettlementPayout(settlement.Amount); !ok {
+		return nil, errorsmod.Wrapf(types.ErrPayoutsDisabled, "settlement %d cannot be approved", settlement.Id)
+	}
+
+	settlement, err = k.decideSettlement(ctx, settlement, types.SettlementStatusApproved, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// 승인된 정산은 트레저리에서 즉시 지급
+	if _, err := k.paySettlement(ctx, settlement); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveSettlementResponse{}, nil
+}
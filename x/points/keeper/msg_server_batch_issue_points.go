@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) BatchIssuePoints(ctx context.Context, msg *types.MsgBatchIssuePoints) (*types.MsgBatchIssuePointsResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if len(msg.Entries) == 0 || len(msg.Entries) > types.MaxBatchIssueEntries {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBatch, "batch must hold 1 to %d entries, got %d", types.MaxBatchIssueEntries, len(msg.Entries))
+	}
+	if msg.ExpiresIn != nil && *msg.ExpiresIn <= 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidExpiry, "expires in %s", *msg.ExpiresIn)
+	}
+
+	// 0. 프로그램 발행자 여부 확인
+	program, err := k.getProgram(ctx, msg.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+	if !program.IsIssuer(msg.Creator) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorizedIssuer, "%s is not an issuer of program %q", msg.Creator, msg.ProgramId)
+	}
+
+	// 1. 항목마다 가스를 부과하고 캐시 컨텍스트에서 발행 (하나라도 실패하면 전체 실패)
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	gasMeter := cacheCtx.GasMeter()
+	var total uint64
+	for i, entry := range msg.Entries {
+		gasMeter.ConsumeGas(types.BatchIssueEntryGas, "batch issue entry")
+
+		if _, err := k.addressCodec.StringToBytes(entry.Recipient); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "entry %d: %s", i, err)
+		}
+		if err := k.issuePoints(cacheCtx, msg.ProgramId, msg.Creator, entry.Recipient, entry.Amount, transactionNote{
+			Memo:           entry.Reason,
+			OrderReference: entry.OrderReference,
+			Metadata:       entry.Metadata,
+		}, msg.ExpiresIn); err != nil {
+			return nil, errorsmod.Wrapf(err, "entry %d", i)
+		}
+		total += entry.Amount
+	}
+
+	// 2. 모든 항목이 성공한 경우에만 반영
+	write()
+
+	return &types.MsgBatchIssuePointsResponse{TotalAmount: total}, nil
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestMsgBatchIssuePoints(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.AddIssuer(f.ctx, types.NewMsgAddIssuer(authorityStr, authorityStr, 100))
+	require.NoError(t, err)
+
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+
+	_, err = ms.BatchIssuePoints(f.ctx, types.NewMsgBatchIssuePoints(authorityStr, testProgramID, nil))
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+	_, err = ms.BatchIssuePoints(f.ctx, types.NewMsgBatchIssuePoints(authorityStr, testProgramID, make([]types.BatchIssueEntry, types.MaxBatchIssueEntries+1)))
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+	_, err = ms.BatchIssuePoints(f.ctx, types.NewMsgBatchIssuePoints(sample.AccAddress(), testProgramID, []types.BatchIssueEntry{{Recipient: alice, Amount: 1}}))
+	require.ErrorIs(t, err, types.ErrUnauthorizedIssuer)
+
+	// the second entry exceeds the issuer cap, so nothing is issued
+	_, err = ms.BatchIssuePoints(f.ctx, types.NewMsgBatchIssuePoints(authorityStr, testProgramID, []types.BatchIssueEntry{
+		{Recipient: alice, Amount: 60, Reason: "welcome"},
+		{Recipient: bob, Amount: 50, Reason: "welcome"},
+	}))
+	require.ErrorIs(t, err, types.ErrIssuerCapExceeded)
+	has, err := f.keeper.PointBalance.Has(f.ctx, collections.Join(testProgramID, alice))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = ms.BatchIssuePoints(f.ctx, types.NewMsgBatchIssuePoints(authorityStr, testProgramID, []types.BatchIssueEntry{
+		{Recipient: alice, Amount: 60, Reason: "welcome"},
+		{Recipient: "invalid", Amount: 10},
+	}))
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	_, err = ms.BatchIssuePoints(f.ctx, types.NewMsgBatchIssuePoints(authorityStr, testProgramID, []types.BatchIssueEntry{
+		{Recipient: alice, Amount: 60, Reason: "welcome"},
+		{Recipient: bob},
+	}))
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	gasBefore := sdkCtx.GasMeter().GasConsumed()
+	res, err := ms.BatchIssuePoints(f.ctx, types.NewMsgBatchIssuePoints(authorityStr, testProgramID, []types.BatchIssueEntry{
+		{Recipient: alice, Amount: 60, Reason: "welcome"},
+		{Recipient: bob, Amount: 40, Reason: "welcome"},
+	}))
+	require.NoError(t, err)
+	require.EqualValues(t, 100, res.TotalAmount)
+	require.GreaterOrEqual(t, sdkCtx.GasMeter().GasConsumed()-gasBefore, uint64(2*types.BatchIssueEntryGas))
+
+	for recipient, amount := range map[string]uint64{alice: 60, bob: 40} {
+		balance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, recipient))
+		require.NoError(t, err)
+		require.Equal(t, amount, balance.Balance)
+	}
+	requireLastEvent(t, f.ctx, &types.EventPointsIssued{
+		TransactionId:    1,
+		ProgramId:        testProgramID,
+		Issuer:           authorityStr,
+		Recipient:        bob,
+		Amount:           40,
+		Reason:           "welcome",
+		RecipientBalance: 40,
+	})
+
+	supply, err := f.keeper.Supply.Get(f.ctx, testProgramID)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, supply.Issued)
+
+	msg, broken := keeper.AllInvariants(f.keeper)(sdkCtx)
+	require.False(t, broken, msg)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+func (k msgServer) CancelSettlement(ctx context.Context, msg *types.MsgCancelSettlement) (*types.MsgCancelSettlementResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+
+	settlement, err := k.getSettlement(ctx, msg.ProgramId, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if settlement.Requester != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the requester can cancel a settlement")
+	}
+
+	// 상태 변경 및 차감된 포인트 환불
+	if _, err := k.decideSettlement(ctx, settlement, types.SettlementStatusCancelled, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelSettlementResponse{}, nil
+}
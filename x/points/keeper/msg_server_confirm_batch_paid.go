@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ConfirmBatchPaid(ctx context.Context, msg *types.MsgConfirmBatchPaid) (*types.MsgConfirmBatchPaidResponse, error) {
+	if err := k.assertSettler(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	// 0. 지급 대기 중인 묶음인지 확인
+	batch, err := k.getSettlementBatch(ctx, msg.ProgramId, msg.BatchId)
+	if err != nil {
+		return nil, err
+	}
+	if batch.Status != types.SettlementBatchStatusPending {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSettlementBatch, "batch %d is %s", batch.Id, batch.Status)
+	}
+
+	requesterBalance, err := k.getBalance(ctx, batch.ProgramId, batch.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 묶인 정산을 지급 완료로 변경 (체인 밖에서 지급되어 payout은 없음)
+	for _, id := range batch.SettlementIds {
+		settlement, err := k.getSettlement(ctx, batch.ProgramId, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := types.ValidateSettlementTransition(settlement.Status, types.SettlementStatusPaid); err != nil {
+			return nil, err
+		}
+		from := settlement.Status
+		settlement.Status = types.SettlementStatusPaid
+		if err := k.Settlement.Set(ctx, collections.Join(settlement.ProgramId, settlement.Id), settlement); err != nil {
+			return nil, err
+		}
+		if err := k.emitSettlementStatusChanged(ctx, settlement, from, msg.Creator, requesterBalance.Balance); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. 묶음 지급 기록
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	batch.Status = types.SettlementBatchStatusPaid
+	batch.PaidBy = msg.Creator
+	batch.PaidAt = sdkCtx.BlockTime().Unix()
+	batch.PaymentReference = msg.PaymentReference
+	if err := k.SettlementBatch.Set(ctx, collections.Join(batch.ProgramId, batch.Id), batch); err != nil {
+		return nil, err
+	}
+
+	// 3. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventSettlementBatchPaid{
+		BatchId:          batch.Id,
+		ProgramId:        batch.ProgramId,
+		Requester:        batch.Requester,
+		TotalAmount:      batch.TotalAmount,
+		PaidBy:           msg.Creator,
+		PaymentReference: msg.PaymentReference,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConfirmBatchPaidResponse{}, nil
+}
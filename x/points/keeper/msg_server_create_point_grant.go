@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreatePointGrant(ctx context.Context, msg *types.MsgCreatePointGrant) (*types.MsgCreatePointGrantResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Beneficiary); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 0. 프로그램 발행자 여부 확인
+	program, err := k.getProgram(ctx, msg.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+	if !program.IsIssuer(msg.Creator) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorizedIssuer, "%s is not an issuer of program %q", msg.Creator, msg.ProgramId)
+	}
+
+	// 1. 지급 일정 및 에포크 식별자 확인
+	grant := types.PointGrant{
+		ProgramId:       msg.ProgramId,
+		Issuer:          msg.Creator,
+		Beneficiary:     msg.Beneficiary,
+		EpochIdentifier: msg.EpochIdentifier,
+		Periods:         msg.Periods,
+		AmountPerPeriod: msg.AmountPerPeriod,
+		Revocable:       msg.Revocable,
+		Reason:          msg.Reason,
+		CreatedAt:       sdkCtx.BlockTime().Unix(),
+		Status:          types.PointGrantStatusActive,
+	}
+	if err := grant.Validate(); err != nil {
+		return nil, err
+	}
+	epoch, err := k.epochsKeeper.GetEpochInfo(sdkCtx, msg.EpochIdentifier)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidGrant, "unknown epoch identifier %q", msg.EpochIdentifier)
+	}
+	grant.StartEpoch = epoch.CurrentEpoch
+
+	// 2. 수령인 동결 여부 확인, 발행자 에포크 한도에서 전체 지급액 차감
+	total, _ := grant.Total()
+	if err := k.assertCreditAllowed(ctx, msg.Beneficiary); err != nil {
+		return nil, err
+	}
+	if err := k.useIssuerAllowance(ctx, msg.Creator, total); err != nil {
+		return nil, err
+	}
+
+	// 3. 지급 기록 저장
+	grant.Id, err = k.PointGrantSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.PointGrant.Set(ctx, collections.Join(grant.ProgramId, grant.Id), grant); err != nil {
+		return nil, err
+	}
+
+	// 4. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPointGrantCreated{
+		GrantId:         grant.Id,
+		ProgramId:       grant.ProgramId,
+		Issuer:          grant.Issuer,
+		Beneficiary:     grant.Beneficiary,
+		EpochIdentifier: grant.EpochIdentifier,
+		Total:           total,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePointGrantResponse{GrantId: grant.Id}, nil
+}
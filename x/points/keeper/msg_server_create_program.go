@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreateProgram(ctx context.Context, msg *types.MsgCreateProgram) (*types.MsgCreateProgramResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	for _, issuer := range msg.Issuers {
+		if _, err := k.addressCodec.StringToBytes(issuer); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "issuer %s: %s", issuer, err)
+		}
+	}
+
+	program := types.PointProgram{
+		Id:       msg.Id,
+		Name:     msg.Name,
+		Owner:    msg.Creator,
+		Decimals: msg.Decimals,
+		Issuers:  msg.Issuers,
+	}
+	if err := program.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 1. 중복 확인
+	has, err := k.Program.Has(ctx, program.Id)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errorsmod.Wrapf(types.ErrProgramExists, "%q", program.Id)
+	}
+
+	// 2. 프로그램 저장
+	if err := k.Program.Set(ctx, program.Id, program); err != nil {
+		return nil, err
+	}
+
+	// 3. 이벤트 발생
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventProgramCreated{
+		ProgramId: program.Id,
+		Owner:     program.Owner,
+		Name:      program.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateProgramResponse{}, nil
+}
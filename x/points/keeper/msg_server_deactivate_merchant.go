@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) DeactivateMerchant(ctx context.Context, msg *types.MsgDeactivateMerchant) (*types.MsgDeactivateMerchantResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+
+	// 1. 가맹점 조회 및 권한 확인 (소유자 또는 모듈 권한)
+	merchant, err := k.getMerchant(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if merchant.Owner != msg.Creator && k.assertAuthority(msg.Creator) != nil {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only the owner of merchant %d or the authority may deactivate it", msg.Id)
+	}
+
+	// 2. 비활성화
+	merchant.Active = false
+	if err := k.Merchant.Set(ctx, merchant.Id, merchant); err != nil {
+		return nil, err
+	}
+
+	// 3. 이벤트 발생
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMerchantDeactivated{
+		MerchantId: merchant.Id,
+		Actor:      msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeactivateMerchantResponse{}, nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (k msgServer) DetokenizePoints(ctx context.Context, msg *types.MsgDetokenizePoints) (*types.MsgDetokenizePointsResponse, error) {
+	holder, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if msg.Amount == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidCoins, "amount must be positive")
+	}
+
+	if _, err := k.getProgram(ctx, msg.ProgramId); err != nil {
+		return nil, err
+	}
+	if err := k.assertCreditAllowed(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	// 1. 코인 회수 후 소각 (잔액이 부족하면 에러)
+	coins := programCoins(msg.ProgramId, msg.Amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInsufficientFunds, err.Error())
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	// 2. 토큰화 로트 풀에서 오래된 순으로 꺼내 원래의 발행/만료 시각 그대로 지급
+	lots, err := k.takeTokenizedLots(ctx, msg.ProgramId, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := k.creditLots(ctx, msg.ProgramId, msg.Creator, lots)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 토큰화 집계
+	if err := k.updateSupply(ctx, msg.ProgramId, func(supply *types.PointSupply) error {
+		supply.Tokenized -= msg.Amount
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// 4. 거래 기록
+	id, err := k.TransactionSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	moduleAddress, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	if err != nil {
+		return nil, err
+	}
+
+	tx := types.Transaction{
+		Id:        id,
+		ProgramId: msg.ProgramId,
+		Sender:    moduleAddress,
+		Recipient: msg.Creator,
+		Amount:    msg.Amount,
+		TxType:    types.TransactionTypeDetokenize,
+		Timestamp: sdkCtx.BlockTime().Unix(),
+	}
+	if err := k.Transaction.Set(ctx, collections.Join(msg.ProgramId, id), tx); err != nil {
+		return nil, err
+	}
+
+	// 5. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPointsDetokenized{
+		TransactionId: id,
+		ProgramId:     msg.ProgramId,
+		Holder:        msg.Creator,
+		Amount:        msg.Amount,
+		Denom:         types.ProgramDenom(msg.ProgramId),
+		HolderBalance: balance.Balance,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDetokenizePointsResponse{}, nil
+}
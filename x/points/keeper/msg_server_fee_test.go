@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestMsgFundFeeSponsor(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	funder := sample.AccAddress()
+	f.bankKeeper.balances[funder] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	_, err := ms.FundFeeSponsor(f.ctx, types.NewMsgFundFeeSponsor(funder, sdk.NewCoins()))
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+
+	_, err = ms.FundFeeSponsor(f.ctx, types.NewMsgFundFeeSponsor(funder, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), f.bankKeeper.balances[authtypes.NewModuleAddress(types.FeeSponsorModuleName).String()])
+}
+
+func TestPayFeeInPoints(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.FeePayment = types.FeePayment{Denom: "stake", PointsPerUnit: math.LegacyMustNewDecFromStr("0.3")}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	payer := sample.AccAddress()
+	issuePoints(t, f, f.ctx, payer, 100, nil)
+	f.bankKeeper.balances[authtypes.NewModuleAddress(types.FeeSponsorModuleName).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	// 0.3 points per stake, rounded up
+	points, err := f.keeper.PayFeeInPoints(f.ctx, testProgramID, payer, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 3)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, points)
+	points, err = f.keeper.PayFeeInPoints(f.ctx, testProgramID, payer, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 0)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, points)
+
+	balance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, payer))
+	require.NoError(t, err)
+	require.EqualValues(t, 96, balance.Balance)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 11)), f.bankKeeper.balances[authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()])
+	requireLastEvent(t, f.ctx, &types.EventFeePaidInPoints{
+		TransactionId: 2,
+		ProgramId:     testProgramID,
+		Payer:         payer,
+		Points:        1,
+		Fee:           "1stake",
+		PayerBalance:  96,
+	})
+
+	// frozen accounts cannot pay fees in points
+	compliance, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.FreezeAccount(f.ctx, types.NewMsgFreezeAccount(compliance, payer, "court order", false))
+	require.NoError(t, err)
+	_, err = f.keeper.PayFeeInPoints(f.ctx, testProgramID, payer, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 0)
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) FreezeAccount(ctx context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	if err := k.assertComplianceOfficer(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if msg.ExpiresIn != nil && *msg.ExpiresIn <= 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidExpiry, "expires in %s", *msg.ExpiresIn)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. 동결 기록 (이미 동결된 계정은 사유와 기한을 새로 씀)
+	frozen := types.FrozenAccount{
+		Address:      msg.Address,
+		Reason:       msg.Reason,
+		FrozenBy:     msg.Creator,
+		FrozenAt:     sdkCtx.BlockTime().Unix(),
+		BlockCredits: msg.BlockCredits,
+	}
+	if msg.ExpiresIn != nil {
+		frozen.ExpiresAt = sdkCtx.BlockTime().Add(*msg.ExpiresIn).Unix()
+	}
+	if err := k.FrozenAccount.Set(ctx, frozen.Address, frozen); err != nil {
+		return nil, err
+	}
+
+	// 2. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventAccountFrozen{
+		Address:      frozen.Address,
+		Actor:        msg.Creator,
+		Reason:       frozen.Reason,
+		ExpiresAt:    frozen.ExpiresAt,
+		BlockCredits: frozen.BlockCredits,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeAccountResponse{}, nil
+}
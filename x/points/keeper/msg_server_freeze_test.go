@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestMsgFreezeAccount(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	officer, account := sample.AccAddress(), sample.AccAddress()
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.ComplianceOfficers = []string{officer}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	_, err = ms.FreezeAccount(ctx, types.NewMsgFreezeAccount(sample.AccAddress(), account, "fraud", false))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.FreezeAccount(ctx, types.NewMsgFreezeAccount(officer, "invalid", "fraud", false))
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	msg := types.NewMsgFreezeAccount(officer, account, "fraud", false)
+	zero := time.Duration(0)
+	msg.ExpiresIn = &zero
+	_, err = ms.FreezeAccount(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	week := 7 * 24 * time.Hour
+	msg.ExpiresIn = &week
+	_, err = ms.FreezeAccount(ctx, msg)
+	require.NoError(t, err)
+
+	frozen, err := f.keeper.FrozenAccount.Get(ctx, account)
+	require.NoError(t, err)
+	require.Equal(t, types.FrozenAccount{
+		Address:   account,
+		Reason:    "fraud",
+		FrozenBy:  officer,
+		FrozenAt:  ctx.BlockTime().Unix(),
+		ExpiresAt: ctx.BlockTime().Add(week).Unix(),
+	}, frozen)
+	requireLastEvent(t, ctx, &types.EventAccountFrozen{
+		Address:   account,
+		Actor:     officer,
+		Reason:    "fraud",
+		ExpiresAt: frozen.ExpiresAt,
+	})
+
+	// the authority may freeze too, replacing the hold
+	_, err = ms.FreezeAccount(ctx, types.NewMsgFreezeAccount(authorityStr, account, "court order", true))
+	require.NoError(t, err)
+	frozen, err = f.keeper.FrozenAccount.Get(ctx, account)
+	require.NoError(t, err)
+	require.Equal(t, "court order", frozen.Reason)
+	require.Zero(t, frozen.ExpiresAt)
+	require.True(t, frozen.BlockCredits)
+
+	_, err = ms.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(sample.AccAddress(), account))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(officer, account))
+	require.NoError(t, err)
+	requireLastEvent(t, ctx, &types.EventAccountUnfrozen{Address: account, Actor: officer})
+	_, err = ms.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(officer, account))
+	require.ErrorIs(t, err, types.ErrAccountNotFrozen)
+}
+
+func TestFrozenAccountRefusesDebits(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	account, other := sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, ctx, account, 100, nil)
+	merchantID := registerMerchant(t, f, ctx, other)
+
+	hour := time.Hour
+	freeze := types.NewMsgFreezeAccount(authorityStr, account, "fraud", false)
+	freeze.ExpiresIn = &hour
+	_, err = ms.FreezeAccount(ctx, freeze)
+	require.NoError(t, err)
+
+	_, err = ms.SpendPoints(ctx, types.NewMsgSpendPoints(account, testProgramID, merchantID, 10, "coffee"))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(account, testProgramID, other, 10))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	_, err = ms.RequestSettlement(ctx, types.NewMsgRequestSettlement(account, testProgramID, 10))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	_, err = ms.TokenizePoints(ctx, types.NewMsgTokenizePoints(account, testProgramID, 10))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+
+	// credits are still allowed
+	issuePoints(t, f, ctx, account, 5, nil)
+
+	// the hold lifts by itself once it expires
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(hour))
+	_, err = ms.TransferPoints(later, types.NewMsgTransferPoints(account, testProgramID, other, 10))
+	require.NoError(t, err)
+}
+
+func TestFrozenAccountRefusesCredits(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	account, other := sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, f.ctx, other, 100, nil)
+	merchantID := registerMerchant(t, f, f.ctx, account)
+
+	_, err = ms.FreezeAccount(f.ctx, types.NewMsgFreezeAccount(authorityStr, account, "sanctions", true))
+	require.NoError(t, err)
+
+	_, err = ms.IssuePoints(f.ctx, types.NewMsgIssuePoints(authorityStr, testProgramID, account, 10, "bonus"))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	_, err = ms.TransferPoints(f.ctx, types.NewMsgTransferPoints(other, testProgramID, account, 10))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	_, err = ms.SpendPoints(f.ctx, types.NewMsgSpendPoints(other, testProgramID, merchantID, 10, "coffee"))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	_, err = ms.DetokenizePoints(f.ctx, types.NewMsgDetokenizePoints(account, testProgramID, 10))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+}
+
+func TestFrozenAccountQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+
+	frozen := []types.FrozenAccount{
+		{Address: sample.AccAddress(), Reason: "fraud"},
+		{Address: sample.AccAddress(), Reason: "review", ExpiresAt: 2_000},
+		{Address: sample.AccAddress(), Reason: "lifted", ExpiresAt: 1_000},
+	}
+	for _, elem := range frozen {
+		require.NoError(t, f.keeper.FrozenAccount.Set(ctx, elem.Address, elem))
+	}
+
+	got, err := qs.GetFrozenAccount(ctx, &types.QueryGetFrozenAccountRequest{Address: frozen[2].Address})
+	require.NoError(t, err)
+	require.Equal(t, frozen[2], got.FrozenAccount)
+	_, err = qs.GetFrozenAccount(ctx, &types.QueryGetFrozenAccountRequest{Address: sample.AccAddress()})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	all, err := qs.ListFrozenAccount(ctx, &types.QueryAllFrozenAccountRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, frozen[:2], all.FrozenAccount)
+}
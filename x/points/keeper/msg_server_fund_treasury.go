@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+func (k msgServer) FundTreasury(ctx context.Context, msg *types.MsgFundTreasury) (*types.MsgFundTreasuryResponse, error) {
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.TreasuryModuleName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundTreasuryResponse{}, nil
+}
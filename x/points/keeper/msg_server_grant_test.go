@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestCreatePointGrantRelease(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := f.ctx
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.AddIssuer(ctx, types.NewMsgAddIssuer(authorityStr, authorityStr, 0))
+	require.NoError(t, err)
+	employee := sample.AccAddress()
+
+	msg := types.NewMsgCreatePointGrant(authorityStr, testProgramID, employee, "week", 3, 10, false)
+	msg.Reason = "signing bonus"
+	res, err := ms.CreatePointGrant(ctx, msg)
+	require.NoError(t, err)
+	requireLastEvent(t, ctx, &types.EventPointGrantCreated{GrantId: res.GrantId, ProgramId: testProgramID, Issuer: authorityStr, Beneficiary: employee, EpochIdentifier: "week", Total: 30})
+
+	grant, err := f.keeper.PointGrant.Get(ctx, collections.Join(testProgramID, res.GrantId))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, grant.StartEpoch)
+	require.Equal(t, types.PointGrantStatusActive, grant.Status)
+
+	balanceOf := func() uint64 {
+		balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, employee))
+		if err != nil {
+			return 0
+		}
+		return balance.Balance
+	}
+
+	// the epoch the grant was created in releases nothing
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.Zero(t, balanceOf())
+
+	// each following epoch releases a tranche, once
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.EqualValues(t, 10, balanceOf())
+	requireLastEvent(t, ctx, &types.EventPointGrantReleased{GrantId: res.GrantId, ProgramId: testProgramID, Beneficiary: employee, TransactionId: 0, Amount: 10, ReleasedPeriods: 1, BeneficiaryBalance: 10})
+
+	tx, err := f.keeper.Transaction.Get(ctx, collections.Join(testProgramID, uint64(0)))
+	require.NoError(t, err)
+	require.Equal(t, types.TransactionTypeGrant, tx.TxType)
+	require.Equal(t, "signing bonus", tx.Memo)
+
+	// a beneficiary frozen from receiving points catches up once unfrozen
+	_, err = ms.FreezeAccount(ctx, types.NewMsgFreezeAccount(authorityStr, employee, "review", true))
+	require.NoError(t, err)
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.EqualValues(t, 10, balanceOf())
+
+	_, err = ms.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(authorityStr, employee))
+	require.NoError(t, err)
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.EqualValues(t, 30, balanceOf())
+
+	grant, err = f.keeper.PointGrant.Get(ctx, collections.Join(testProgramID, res.GrantId))
+	require.NoError(t, err)
+	require.EqualValues(t, 3, grant.ReleasedPeriods)
+	require.Equal(t, types.PointGrantStatusCompleted, grant.Status)
+
+	supply, err := qs.Supply(ctx, &types.QuerySupplyRequest{ProgramId: testProgramID})
+	require.NoError(t, err)
+	require.EqualValues(t, 30, supply.Supply.Issued)
+
+	active, err := qs.ListActiveGrants(ctx, &types.QueryActiveGrantsRequest{ProgramId: testProgramID, Beneficiary: employee})
+	require.NoError(t, err)
+	require.Empty(t, active.PointGrant)
+}
+
+func TestGrantReleaseChecksIssuer(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.AddIssuer(ctx, types.NewMsgAddIssuer(authorityStr, authorityStr, 0))
+	require.NoError(t, err)
+	employee := sample.AccAddress()
+
+	_, err = ms.CreatePointGrant(ctx, types.NewMsgCreatePointGrant(authorityStr, testProgramID, employee, "week", 4, 10, false))
+	require.NoError(t, err)
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+
+	balanceOf := func() uint64 {
+		balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, employee))
+		if err != nil {
+			return 0
+		}
+		return balance.Balance
+	}
+
+	// a suspended issuer releases nothing until it is reinstated
+	_, err = ms.SuspendIssuer(ctx, types.NewMsgSuspendIssuer(authorityStr, authorityStr, true))
+	require.NoError(t, err)
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.Zero(t, balanceOf())
+
+	_, err = ms.SuspendIssuer(ctx, types.NewMsgSuspendIssuer(authorityStr, authorityStr, false))
+	require.NoError(t, err)
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.EqualValues(t, 20, balanceOf())
+
+	// releases count against the issuer's daily issue limit per recipient
+	_, err = ms.SetVelocityOverride(ctx, types.NewMsgSetVelocityOverride(authorityStr, authorityStr, types.VelocityLimits{DailyIssuePerRecipient: 25}))
+	require.NoError(t, err)
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.EqualValues(t, 20, balanceOf())
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.EqualValues(t, 40, balanceOf())
+}
+
+func TestCreatePointGrantErrors(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.AddIssuer(f.ctx, types.NewMsgAddIssuer(authorityStr, authorityStr, 25))
+	require.NoError(t, err)
+	employee := sample.AccAddress()
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgCreatePointGrant
+		err  error
+	}{
+		{desc: "not an issuer", msg: types.NewMsgCreatePointGrant(sample.AccAddress(), testProgramID, employee, "week", 2, 10, false), err: types.ErrUnauthorizedIssuer},
+		{desc: "unknown program", msg: types.NewMsgCreatePointGrant(authorityStr, "other", employee, "week", 2, 10, false), err: types.ErrProgramNotFound},
+		{desc: "invalid beneficiary", msg: types.NewMsgCreatePointGrant(authorityStr, testProgramID, "invalid", "week", 2, 10, false), err: types.ErrInvalidAddress},
+		{desc: "unknown epoch", msg: types.NewMsgCreatePointGrant(authorityStr, testProgramID, employee, "month", 2, 10, false), err: types.ErrInvalidGrant},
+		{desc: "no periods", msg: types.NewMsgCreatePointGrant(authorityStr, testProgramID, employee, "week", 0, 10, false), err: types.ErrInvalidGrant},
+		{desc: "over the issuer cap", msg: types.NewMsgCreatePointGrant(authorityStr, testProgramID, employee, "week", 3, 10, false), err: types.ErrIssuerCapExceeded},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := ms.CreatePointGrant(f.ctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestRevokeGrant(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := f.ctx
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.AddIssuer(ctx, types.NewMsgAddIssuer(authorityStr, authorityStr, 0))
+	require.NoError(t, err)
+	employee := sample.AccAddress()
+
+	fixed, err := ms.CreatePointGrant(ctx, types.NewMsgCreatePointGrant(authorityStr, testProgramID, employee, "week", 3, 10, false))
+	require.NoError(t, err)
+	revocable, err := ms.CreatePointGrant(ctx, types.NewMsgCreatePointGrant(authorityStr, testProgramID, employee, "week", 3, 10, true))
+	require.NoError(t, err)
+
+	active, err := qs.ListActiveGrants(ctx, &types.QueryActiveGrantsRequest{ProgramId: testProgramID, Beneficiary: employee})
+	require.NoError(t, err)
+	require.Len(t, active.PointGrant, 2)
+
+	f.epochsKeeper.endEpoch("week")
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+
+	_, err = ms.RevokeGrant(ctx, types.NewMsgRevokeGrant(authorityStr, testProgramID, fixed.GrantId))
+	require.ErrorIs(t, err, types.ErrInvalidGrant)
+	_, err = ms.RevokeGrant(ctx, types.NewMsgRevokeGrant(employee, testProgramID, revocable.GrantId))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.RevokeGrant(ctx, types.NewMsgRevokeGrant(authorityStr, testProgramID, 9))
+	require.ErrorIs(t, err, types.ErrGrantNotFound)
+
+	issuer, err := f.keeper.Issuer.Get(ctx, authorityStr)
+	require.NoError(t, err)
+	require.EqualValues(t, 60, issuer.EpochIssued)
+
+	res, err := ms.RevokeGrant(ctx, types.NewMsgRevokeGrant(authorityStr, testProgramID, revocable.GrantId))
+	require.NoError(t, err)
+	require.EqualValues(t, 20, res.Unreleased)
+
+	// the unreleased tranches are given back to the issuer's epoch cap
+	issuer, err = f.keeper.Issuer.Get(ctx, authorityStr)
+	require.NoError(t, err)
+	require.EqualValues(t, 40, issuer.EpochIssued)
+	requireLastEvent(t, ctx, &types.EventPointGrantRevoked{GrantId: revocable.GrantId, ProgramId: testProgramID, RevokedBy: authorityStr, Unreleased: 20})
+
+	_, err = ms.RevokeGrant(ctx, types.NewMsgRevokeGrant(authorityStr, testProgramID, revocable.GrantId))
+	require.ErrorIs(t, err, types.ErrInvalidGrant)
+
+	// only the grant left active keeps releasing
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, employee))
+	require.NoError(t, err)
+	require.EqualValues(t, 30, balance.Balance)
+
+	active, err = qs.ListActiveGrants(ctx, &types.QueryActiveGrantsRequest{ProgramId: testProgramID, Beneficiary: employee})
+	require.NoError(t, err)
+	require.Len(t, active.PointGrant, 1)
+	require.Equal(t, fixed.GrantId, active.PointGrant[0].Id)
+
+	grant, err := qs.GetPointGrant(ctx, &types.QueryGetPointGrantRequest{ProgramId: testProgramID, Id: revocable.GrantId})
+	require.NoError(t, err)
+	require.Equal(t, types.PointGrantStatusRevoked, grant.PointGrant.Status)
+	require.EqualValues(t, 1, grant.PointGrant.ReleasedPeriods)
+}
@@ -6,50 +6,34 @@ import (
 	"scontract/x/points/types"
 
 	errorsmod "cosmossdk.io/errors"
-	"cosmossdk.io/collections"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (k msgServer) IssuePoints(ctx context.Context, msg *types.MsgIssuePoints) (*types.MsgIssuePointsResponse, error) {
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
 		return nil, errorsmod.Wrap(err, "invalid authority address")
 	}
-
-	// 1. PointBalance 가져오기 (없으면 생성)
-	balance, err := k.PointBalance.Get(ctx, msg.Recipient)
-	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
-		return nil, err
-	}
-	
-	// 2. 잔액 증가
-	newBalance := balance.Balance + msg.Amount
-	
-	// 3. 저장
-	newPointBalance := types.PointBalance{
-		Address: msg.Recipient,
-		Balance: newBalance,
+	if _, err := k.addressCodec.StringToBytes(msg.Recipient); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
 	}
-	if err := k.PointBalance.Set(ctx, msg.Recipient, newPointBalance); err != nil {
-		return nil, err
+	if msg.ExpiresIn != nil && *msg.ExpiresIn <= 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidExpiry, "expires in %s", *msg.ExpiresIn)
 	}
 
-	// 4. 거래 기록 (Transaction) 추가
-	id, err := k.TransactionSeq.Next(ctx)
+	// 0. 프로그램 발행자 여부 확인
+	program, err := k.getProgram(ctx, msg.ProgramId)
 	if err != nil {
 		return nil, err
 	}
-	
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	tx := types.Transaction{
-		Id:        id,
-		Sender:    msg.Creator,
-		Recipient: msg.Recipient,
-		Amount:    msg.Amount,
-		TxType:    "issue",
-		Timestamp: sdkCtx.BlockTime().Unix(),
+	if !program.IsIssuer(msg.Creator) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorizedIssuer, "%s is not an issuer of program %q", msg.Creator, msg.ProgramId)
 	}
-	if err := k.Transaction.Set(ctx, id, tx); err != nil {
+
+	// 1. 발행 (로트 생성, 발행량 집계, 거래 기록, 이벤트)
+	if err := k.issuePoints(ctx, msg.ProgramId, msg.Creator, msg.Recipient, msg.Amount, transactionNote{
+		Memo:           msg.Reason,
+		OrderReference: msg.OrderReference,
+		Metadata:       msg.Metadata,
+	}, msg.ExpiresIn); err != nil {
 		return nil, err
 	}
 
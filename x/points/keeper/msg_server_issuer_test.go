@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestMsgAddIssuer(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	issuer := sample.AccAddress()
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgAddIssuer
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			input:  types.NewMsgAddIssuer(sample.AccAddress(), issuer, 0),
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name:   "invalid issuer address",
+			input:  types.NewMsgAddIssuer(authorityStr, "invalid", 0),
+			expErr: types.ErrInvalidAddress,
+		},
+		{
+			name:  "all good",
+			input: types.NewMsgAddIssuer(authorityStr, issuer, 100),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.AddIssuer(f.ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := f.keeper.Issuer.Get(f.ctx, tc.input.Address)
+			require.NoError(t, err)
+			require.Equal(t, tc.input.EpochCap, got.EpochCap)
+		})
+	}
+}
+
+func TestMsgRemoveAndSuspendIssuer(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	issuer := sample.AccAddress()
+
+	_, err = ms.SuspendIssuer(f.ctx, types.NewMsgSuspendIssuer(authorityStr, issuer, true))
+	require.ErrorIs(t, err, types.ErrIssuerNotFound)
+	_, err = ms.RemoveIssuer(f.ctx, types.NewMsgRemoveIssuer(authorityStr, issuer))
+	require.ErrorIs(t, err, types.ErrIssuerNotFound)
+
+	_, err = ms.AddIssuer(f.ctx, types.NewMsgAddIssuer(authorityStr, issuer, 0))
+	require.NoError(t, err)
+
+	_, err = ms.SuspendIssuer(f.ctx, types.NewMsgSuspendIssuer(sample.AccAddress(), issuer, true))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.SuspendIssuer(f.ctx, types.NewMsgSuspendIssuer(authorityStr, issuer, true))
+	require.NoError(t, err)
+
+	// re-adding an issuer must not lift its suspension
+	_, err = ms.AddIssuer(f.ctx, types.NewMsgAddIssuer(authorityStr, issuer, 10))
+	require.NoError(t, err)
+	got, err := f.keeper.Issuer.Get(f.ctx, issuer)
+	require.NoError(t, err)
+	require.True(t, got.Suspended)
+
+	_, err = ms.RemoveIssuer(f.ctx, types.NewMsgRemoveIssuer(sample.AccAddress(), issuer))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.RemoveIssuer(f.ctx, types.NewMsgRemoveIssuer(authorityStr, issuer))
+	require.NoError(t, err)
+	found, err := f.keeper.Issuer.Has(f.ctx, issuer)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestMsgIssuePointsIssuerChecks(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	issuer := sample.AccAddress()
+	recipient := sample.AccAddress()
+
+	blockTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(issuer, testProgramID, recipient, 10, "welcome"))
+	require.ErrorIs(t, err, types.ErrUnauthorizedIssuer)
+
+	_, err = ms.AddIssuer(ctx, types.NewMsgAddIssuer(authorityStr, issuer, 100))
+	require.NoError(t, err)
+
+	// registered issuers must also be issuers of the program
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(issuer, testProgramID, recipient, 10, "welcome"))
+	require.ErrorIs(t, err, types.ErrUnauthorizedIssuer)
+	program, err := f.keeper.Program.Get(ctx, testProgramID)
+	require.NoError(t, err)
+	program.Issuers = append(program.Issuers, issuer)
+	require.NoError(t, f.keeper.Program.Set(ctx, testProgramID, program))
+
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(issuer, "unknown", recipient, 10, "welcome"))
+	require.ErrorIs(t, err, types.ErrProgramNotFound)
+
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(issuer, testProgramID, "invalid", 10, "welcome"))
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(issuer, testProgramID, recipient, 0, "welcome"))
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(issuer, testProgramID, recipient, 60, "welcome"))
+	require.NoError(t, err)
+	requireLastEvent(t, ctx, &types.EventPointsIssued{
+		TransactionId:    0,
+		Issuer:           issuer,
+		Recipient:        recipient,
+		Amount:           60,
+		Reason:           "welcome",
+		RecipientBalance: 60,
+		ProgramId:        testProgramID,
+	})
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(issuer, testProgramID, recipient, 41, "welcome"))
+	require.ErrorIs(t, err, types.ErrIssuerCapExceeded)
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(issuer, testProgramID, recipient, 40, "welcome"))
+	require.NoError(t, err)
+
+	balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, recipient))
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), balance.Balance)
+
+	// the cap is restored in the next epoch
+	nextEpoch := ctx.WithBlockTime(blockTime.Add(types.DefaultIssuerEpochDuration))
+	_, err = ms.IssuePoints(nextEpoch, types.NewMsgIssuePoints(issuer, testProgramID, recipient, 100, "welcome"))
+	require.NoError(t, err)
+
+	_, err = ms.SuspendIssuer(nextEpoch, types.NewMsgSuspendIssuer(authorityStr, issuer, true))
+	require.NoError(t, err)
+	_, err = ms.IssuePoints(nextEpoch, types.NewMsgIssuePoints(issuer, testProgramID, recipient, 1, "welcome"))
+	require.ErrorIs(t, err, types.ErrIssuerSuspended)
+}
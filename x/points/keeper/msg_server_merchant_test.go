@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestMsgRegisterMerchant(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sample.AccAddress()
+	settlementAddress := sample.AccAddress()
+
+	_, err := ms.RegisterMerchant(f.ctx, types.NewMsgRegisterMerchant("invalid", "Cafe", settlementAddress))
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	_, err = ms.RegisterMerchant(f.ctx, types.NewMsgRegisterMerchant(owner, "Cafe", "invalid"))
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	_, err = ms.RegisterMerchant(f.ctx, types.NewMsgRegisterMerchant(owner, "", settlementAddress))
+	require.ErrorIs(t, err, types.ErrInvalidMerchant)
+
+	res, err := ms.RegisterMerchant(f.ctx, types.NewMsgRegisterMerchant(owner, "Cafe", settlementAddress))
+	require.NoError(t, err)
+
+	merchant, err := f.keeper.Merchant.Get(f.ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.Merchant{
+		Id:                res.Id,
+		Name:              "Cafe",
+		Owner:             owner,
+		SettlementAddress: settlementAddress,
+		Active:            false,
+	}, merchant)
+	requireLastEvent(t, f.ctx, &types.EventMerchantRegistered{
+		MerchantId:        res.Id,
+		Owner:             owner,
+		Name:              "Cafe",
+		SettlementAddress: settlementAddress,
+	})
+
+	next, err := ms.RegisterMerchant(f.ctx, types.NewMsgRegisterMerchant(owner, "Bakery", settlementAddress))
+	require.NoError(t, err)
+	require.Equal(t, res.Id+1, next.Id)
+}
+
+func TestMsgApproveMerchant(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	owner := sample.AccAddress()
+	spender := sample.AccAddress()
+	issuePoints(t, f, f.ctx, spender, 10, nil)
+
+	res, err := ms.RegisterMerchant(f.ctx, types.NewMsgRegisterMerchant(owner, "Cafe", owner))
+	require.NoError(t, err)
+
+	// 승인 전에는 사용처가 될 수 없음
+	_, err = ms.SpendPoints(f.ctx, types.NewMsgSpendPoints(spender, testProgramID, res.Id, 5, "coffee"))
+	require.ErrorIs(t, err, types.ErrMerchantInactive)
+
+	_, err = ms.ApproveMerchant(f.ctx, types.NewMsgApproveMerchant(owner, res.Id))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.ApproveMerchant(f.ctx, types.NewMsgApproveMerchant(authorityStr, res.Id+1))
+	require.ErrorIs(t, err, types.ErrMerchantNotFound)
+
+	_, err = ms.ApproveMerchant(f.ctx, types.NewMsgApproveMerchant(authorityStr, res.Id))
+	require.NoError(t, err)
+	merchant, err := f.keeper.Merchant.Get(f.ctx, res.Id)
+	require.NoError(t, err)
+	require.True(t, merchant.Active)
+	requireLastEvent(t, f.ctx, &types.EventMerchantApproved{MerchantId: res.Id, Actor: authorityStr})
+
+	_, err = ms.SpendPoints(f.ctx, types.NewMsgSpendPoints(spender, testProgramID, res.Id, 5, "coffee"))
+	require.NoError(t, err)
+}
+
+func TestMsgUpdateMerchant(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	owner := sample.AccAddress()
+	res, err := ms.RegisterMerchant(f.ctx, types.NewMsgRegisterMerchant(owner, "Cafe", sample.AccAddress()))
+	require.NoError(t, err)
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.ApproveMerchant(f.ctx, types.NewMsgApproveMerchant(authorityStr, res.Id))
+	require.NoError(t, err)
+	settlementAddress := sample.AccAddress()
+
+	_, err = ms.UpdateMerchant(f.ctx, types.NewMsgUpdateMerchant(owner, res.Id+1, "Cafe", settlementAddress))
+	require.ErrorIs(t, err, types.ErrMerchantNotFound)
+	_, err = ms.UpdateMerchant(f.ctx, types.NewMsgUpdateMerchant(sample.AccAddress(), res.Id, "Cafe", settlementAddress))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.UpdateMerchant(f.ctx, types.NewMsgUpdateMerchant(owner, res.Id, "Cafe", "invalid"))
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	_, err = ms.UpdateMerchant(f.ctx, types.NewMsgUpdateMerchant(owner, res.Id, "Corner Cafe", settlementAddress))
+	require.NoError(t, err)
+
+	merchant, err := f.keeper.Merchant.Get(f.ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, "Corner Cafe", merchant.Name)
+	require.Equal(t, settlementAddress, merchant.SettlementAddress)
+	require.False(t, merchant.Active, "a new settlement address needs approval")
+	requireLastEvent(t, f.ctx, &types.EventMerchantUpdated{
+		MerchantId:        res.Id,
+		Name:              "Corner Cafe",
+		SettlementAddress: settlementAddress,
+	})
+
+	// 이름만 바꾸면 승인 상태가 유지됨
+	_, err = ms.ApproveMerchant(f.ctx, types.NewMsgApproveMerchant(authorityStr, res.Id))
+	require.NoError(t, err)
+	_, err = ms.UpdateMerchant(f.ctx, types.NewMsgUpdateMerchant(owner, res.Id, "Cafe", settlementAddress))
+	require.NoError(t, err)
+	merchant, err = f.keeper.Merchant.Get(f.ctx, res.Id)
+	require.NoError(t, err)
+	require.True(t, merchant.Active)
+}
+
+func TestMsgDeactivateMerchant(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	owner := sample.AccAddress()
+
+	testCases := []struct {
+		name   string
+		actor  string
+		expErr error
+	}{
+		{
+			name:   "not the owner",
+			actor:  sample.AccAddress(),
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name:  "owner",
+			actor: owner,
+		},
+		{
+			name:  "authority",
+			actor: authorityStr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ms.RegisterMerchant(f.ctx, types.NewMsgRegisterMerchant(owner, "Cafe", owner))
+			require.NoError(t, err)
+
+			_, err = ms.DeactivateMerchant(f.ctx, types.NewMsgDeactivateMerchant(tc.actor, res.Id))
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			merchant, err := f.keeper.Merchant.Get(f.ctx, res.Id)
+			require.NoError(t, err)
+			require.False(t, merchant.Active)
+			requireLastEvent(t, f.ctx, &types.EventMerchantDeactivated{MerchantId: res.Id, Actor: tc.actor})
+		})
+	}
+
+	_, err = ms.DeactivateMerchant(f.ctx, types.NewMsgDeactivateMerchant(owner, 100))
+	require.ErrorIs(t, err, types.ErrMerchantNotFound)
+}
+
+func TestMerchantQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	merchants := []types.Merchant{
+		{Id: 0, Name: "Cafe", Owner: sample.AccAddress(), SettlementAddress: sample.AccAddress(), Active: true},
+		{Id: 1, Name: "Bakery", Owner: sample.AccAddress(), SettlementAddress: sample.AccAddress()},
+	}
+	for _, merchant := range merchants {
+		require.NoError(t, f.keeper.Merchant.Set(f.ctx, merchant.Id, merchant))
+	}
+
+	got, err := qs.GetMerchant(f.ctx, &types.QueryGetMerchantRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, merchants[1], got.Merchant)
+
+	_, err = qs.GetMerchant(f.ctx, &types.QueryGetMerchantRequest{Id: 2})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	all, err := qs.ListMerchant(f.ctx, &types.QueryAllMerchantRequest{})
+	require.NoError(t, err)
+	require.Equal(t, merchants, all.Merchant)
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestTransactionNotes(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	customer, friend, merchant := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, f.ctx, customer, 100, nil)
+	merchantID := registerMerchant(t, f, f.ctx, merchant)
+	metadata := []types.TransactionMetadata{{Key: "store", Value: "gangnam"}, {Key: "pos", Value: "3"}}
+
+	spend := types.NewMsgSpendPoints(customer, testProgramID, merchantID, 30, "coffee")
+	spend.OrderReference = "order-1"
+	spend.Metadata = metadata
+	_, err := ms.SpendPoints(f.ctx, spend)
+	require.NoError(t, err)
+	const spendID = 1
+
+	transfer := types.NewMsgTransferPoints(customer, testProgramID, friend, 10)
+	transfer.Memo = "split the bill"
+	transfer.OrderReference = "order-2"
+	_, err = ms.TransferPoints(f.ctx, transfer)
+	require.NoError(t, err)
+
+	// the refund inherits the order reference of the spend
+	refund := types.NewMsgRefundSpend(merchant, testProgramID, spendID, 5)
+	refund.Reason = "cold coffee"
+	res, err := ms.RefundSpend(f.ctx, refund)
+	require.NoError(t, err)
+
+	issued, err := f.keeper.Transaction.Get(f.ctx, collections.Join(testProgramID, uint64(0)))
+	require.NoError(t, err)
+	require.Equal(t, "test", issued.Memo)
+
+	spent, err := f.keeper.Transaction.Get(f.ctx, collections.Join(testProgramID, uint64(spendID)))
+	require.NoError(t, err)
+	require.Equal(t, "coffee", spent.Memo)
+	require.Equal(t, "order-1", spent.OrderReference)
+	require.Equal(t, metadata, spent.Metadata)
+
+	refunded, err := f.keeper.Transaction.Get(f.ctx, collections.Join(testProgramID, res.TransactionId))
+	require.NoError(t, err)
+	require.Equal(t, "cold coffee", refunded.Memo)
+	require.Equal(t, "order-1", refunded.OrderReference)
+
+	list, err := qs.ListTransaction(f.ctx, &types.QueryAllTransactionRequest{ProgramId: testProgramID, OrderReference: "order-1"})
+	require.NoError(t, err)
+	require.Len(t, list.Transaction, 2)
+	require.Equal(t, uint64(spendID), list.Transaction[0].Id)
+	require.Equal(t, res.TransactionId, list.Transaction[1].Id)
+
+	list, err = qs.ListTransaction(f.ctx, &types.QueryAllTransactionRequest{ProgramId: testProgramID, OrderReference: "order-2"})
+	require.NoError(t, err)
+	require.Len(t, list.Transaction, 1)
+	require.Equal(t, "split the bill", list.Transaction[0].Memo)
+
+	list, err = qs.ListTransaction(f.ctx, &types.QueryAllTransactionRequest{ProgramId: "other", OrderReference: "order-1"})
+	require.NoError(t, err)
+	require.Empty(t, list.Transaction)
+
+	// the issuance without an order reference is left out of the index
+	var indexed []uint64
+	require.NoError(t, f.keeper.Transaction.Indexes.OrderReference.Walk(f.ctx, nil, func(_ collections.Pair[string, string], pk collections.Pair[string, uint64]) (bool, error) {
+		indexed = append(indexed, pk.K2())
+		return false, nil
+	}))
+	require.ElementsMatch(t, []uint64{spendID, 2, res.TransactionId}, indexed)
+}
+
+func TestTransactionNoteLimits(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	customer := sample.AccAddress()
+	issuePoints(t, f, f.ctx, customer, 100, nil)
+	merchantID := registerMerchant(t, f, f.ctx, sample.AccAddress())
+	limits := types.DefaultMetadataLimits
+
+	for _, tc := range []struct {
+		name string
+		edit func(msg *types.MsgSpendPoints)
+	}{
+		{
+			name: "memo too long",
+			edit: func(msg *types.MsgSpendPoints) { msg.Description = strings.Repeat("a", int(limits.MaxMemoLength)+1) },
+		},
+		{
+			name: "order reference too long",
+			edit: func(msg *types.MsgSpendPoints) {
+				msg.OrderReference = strings.Repeat("a", int(limits.MaxOrderReferenceLength)+1)
+			},
+		},
+		{
+			name: "too many entries",
+			edit: func(msg *types.MsgSpendPoints) {
+				for i := 0; i <= int(limits.MaxEntries); i++ {
+					msg.Metadata = append(msg.Metadata, types.TransactionMetadata{Key: strings.Repeat("k", i+1)})
+				}
+			},
+		},
+		{
+			name: "duplicated key",
+			edit: func(msg *types.MsgSpendPoints) {
+				msg.Metadata = []types.TransactionMetadata{{Key: "a"}, {Key: "a"}}
+			},
+		},
+		{
+			name: "empty key",
+			edit: func(msg *types.MsgSpendPoints) { msg.Metadata = []types.TransactionMetadata{{Value: "a"}} },
+		},
+		{
+			name: "value too long",
+			edit: func(msg *types.MsgSpendPoints) {
+				msg.Metadata = []types.TransactionMetadata{{Key: "a", Value: strings.Repeat("v", int(limits.MaxValueLength)+1)}}
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSpendPoints(customer, testProgramID, merchantID, 10, "coffee")
+			tc.edit(msg)
+			_, err := ms.SpendPoints(f.ctx, msg)
+			require.ErrorIs(t, err, types.ErrInvalidMetadata)
+		})
+	}
+
+	// issuances are checked too, and zero limits are unlimited
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	issue := types.NewMsgIssuePoints(authorityStr, testProgramID, customer, 10, strings.Repeat("a", int(limits.MaxMemoLength)+1))
+	_, err = ms.IssuePoints(f.ctx, issue)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.MetadataLimits = types.MetadataLimits{}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = ms.IssuePoints(f.ctx, issue)
+	require.NoError(t, err)
+}
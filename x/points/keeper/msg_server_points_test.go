@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestMsgSpendPoints(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	spender := sample.AccAddress()
+	issuePoints(t, f, f.ctx, spender, 100, nil)
+	merchantAddress := sample.AccAddress()
+	merchantID := registerMerchant(t, f, f.ctx, merchantAddress)
+
+	_, err := ms.SpendPoints(f.ctx, types.NewMsgSpendPoints(spender, testProgramID, merchantID, 101, "coffee"))
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	_, err = ms.SpendPoints(f.ctx, types.NewMsgSpendPoints(spender, testProgramID, merchantID+1, 30, "coffee"))
+	require.ErrorIs(t, err, types.ErrMerchantNotFound)
+
+	// spending at a merchant that settles to the spender is rejected
+	selfMerchantID := registerMerchant(t, f, f.ctx, spender)
+	_, err = ms.SpendPoints(f.ctx, types.NewMsgSpendPoints(spender, testProgramID, selfMerchantID, 30, "coffee"))
+	require.ErrorIs(t, err, types.ErrInvalidMerchant)
+
+	_, err = ms.SpendPoints(f.ctx, types.NewMsgSpendPoints(spender, testProgramID, merchantID, 30, "coffee"))
+	require.NoError(t, err)
+
+	balance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, spender))
+	require.NoError(t, err)
+	require.EqualValues(t, 70, balance.Balance)
+	merchantBalance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, merchantAddress))
+	require.NoError(t, err)
+	require.EqualValues(t, 30, merchantBalance.Balance)
+	tx, err := f.keeper.Transaction.Get(f.ctx, collections.Join(testProgramID, uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, merchantAddress, tx.Recipient)
+	requireLastEvent(t, f.ctx, &types.EventPointsSpent{
+		TransactionId:   1,
+		Spender:         spender,
+		Amount:          30,
+		SpenderBalance:  70,
+		ProgramId:       testProgramID,
+		MerchantId:      merchantID,
+		MerchantAddress: merchantAddress,
+	})
+
+	// the merchant can settle the points it received
+	_, err = ms.RequestSettlement(f.ctx, types.NewMsgRequestSettlement(merchantAddress, testProgramID, 30))
+	require.NoError(t, err)
+
+	merchant, err := f.keeper.Merchant.Get(f.ctx, merchantID)
+	require.NoError(t, err)
+	_, err = ms.DeactivateMerchant(f.ctx, types.NewMsgDeactivateMerchant(merchant.Owner, merchantID))
+	require.NoError(t, err)
+	_, err = ms.SpendPoints(f.ctx, types.NewMsgSpendPoints(spender, testProgramID, merchantID, 10, "coffee"))
+	require.ErrorIs(t, err, types.ErrMerchantInactive)
+}
+
+func TestMsgTransferPoints(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	sender := sample.AccAddress()
+	recipient := sample.AccAddress()
+	issuePoints(t, f, f.ctx, sender, 100, nil)
+
+	_, err := ms.TransferPoints(f.ctx, types.NewMsgTransferPoints(sender, testProgramID, recipient, 101))
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	_, err = ms.TransferPoints(f.ctx, types.NewMsgTransferPoints(sender, testProgramID, recipient, 40))
+	require.NoError(t, err)
+
+	balance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, recipient))
+	require.NoError(t, err)
+	require.EqualValues(t, 40, balance.Balance)
+	requireLastEvent(t, f.ctx, &types.EventPointsTransferred{
+		TransactionId:    1,
+		Sender:           sender,
+		Recipient:        recipient,
+		Amount:           40,
+		SenderBalance:    60,
+		RecipientBalance: 40,
+		ProgramId:        testProgramID,
+	})
+}
+
+func TestIssuePointsOverflow(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	alice := sample.AccAddress()
+	issuePoints(t, f, f.ctx, alice, math.MaxUint64, nil)
+
+	// neither the balance nor the issued supply may wrap around
+	_, err = ms.IssuePoints(f.ctx, types.NewMsgIssuePoints(authorityStr, testProgramID, alice, 2, "test"))
+	require.ErrorIs(t, err, types.ErrAmountOverflow)
+	_, err = ms.IssuePoints(f.ctx, types.NewMsgIssuePoints(authorityStr, testProgramID, sample.AccAddress(), 2, "test"))
+	require.ErrorIs(t, err, types.ErrAmountOverflow)
+
+	balance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, alice))
+	require.NoError(t, err)
+	require.EqualValues(t, uint64(math.MaxUint64), balance.Balance)
+	supply, err := f.keeper.Supply.Get(f.ctx, testProgramID)
+	require.NoError(t, err)
+	require.EqualValues(t, uint64(math.MaxUint64), supply.Issued)
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestMsgCreateProgram(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	creator := sample.AccAddress()
+	issuer := sample.AccAddress()
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgCreateProgram
+		expErr error
+	}{
+		{
+			name:   "invalid creator",
+			input:  types.NewMsgCreateProgram("invalid", "cafe", "Cafe", 2, nil),
+			expErr: types.ErrInvalidAddress,
+		},
+		{
+			name:   "invalid issuer address",
+			input:  types.NewMsgCreateProgram(creator, "cafe", "Cafe", 2, []string{"invalid"}),
+			expErr: types.ErrInvalidAddress,
+		},
+		{
+			name:   "invalid program id",
+			input:  types.NewMsgCreateProgram(creator, "Cafe Points", "Cafe", 2, nil),
+			expErr: types.ErrInvalidProgram,
+		},
+		{
+			name:   "too many decimals",
+			input:  types.NewMsgCreateProgram(creator, "cafe", "Cafe", types.MaxProgramDecimals+1, nil),
+			expErr: types.ErrInvalidProgram,
+		},
+		{
+			name:   "duplicated issuer",
+			input:  types.NewMsgCreateProgram(creator, "cafe", "Cafe", 2, []string{issuer, issuer}),
+			expErr: types.ErrInvalidProgram,
+		},
+		{
+			name:   "program exists",
+			input:  types.NewMsgCreateProgram(creator, testProgramID, "Loyalty", 0, nil),
+			expErr: types.ErrProgramExists,
+		},
+		{
+			name:  "all good",
+			input: types.NewMsgCreateProgram(creator, "cafe", "Cafe", 2, []string{issuer}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.CreateProgram(f.ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := f.keeper.Program.Get(f.ctx, tc.input.Id)
+			require.NoError(t, err)
+			require.Equal(t, types.PointProgram{
+				Id:       tc.input.Id,
+				Name:     tc.input.Name,
+				Owner:    creator,
+				Decimals: tc.input.Decimals,
+				Issuers:  tc.input.Issuers,
+			}, got)
+			requireLastEvent(t, f.ctx, &types.EventProgramCreated{
+				ProgramId: tc.input.Id,
+				Owner:     creator,
+				Name:      tc.input.Name,
+			})
+		})
+	}
+}
+
+func TestProgramQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	loyalty, err := f.keeper.Program.Get(f.ctx, testProgramID)
+	require.NoError(t, err)
+	cafe := types.PointProgram{Id: "cafe", Name: "Cafe", Owner: sample.AccAddress(), Decimals: 2}
+	require.NoError(t, f.keeper.Program.Set(f.ctx, cafe.Id, cafe))
+
+	got, err := qs.GetProgram(f.ctx, &types.QueryGetProgramRequest{Id: cafe.Id})
+	require.NoError(t, err)
+	require.Equal(t, cafe, got.Program)
+
+	_, err = qs.GetProgram(f.ctx, &types.QueryGetProgramRequest{Id: "missing"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = qs.GetProgram(f.ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	all, err := qs.ListProgram(f.ctx, &types.QueryAllProgramRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PointProgram{cafe, loyalty}, all.Program)
+}
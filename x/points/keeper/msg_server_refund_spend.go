@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RefundSpend(ctx context.Context, msg *types.MsgRefundSpend) (*types.MsgRefundSpendResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if msg.Amount == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRefund, "amount must be positive")
+	}
+
+	// 1. 원래 사용 거래 확인 (가맹점 소유자 또는 현재 정산 주소만, 남은 금액까지만 환불 가능)
+	spendKey := collections.Join(msg.ProgramId, msg.TransactionId)
+	spend, pruned, err := k.getSpend(ctx, spendKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrTransactionNotFound, "transaction %d of program %q", msg.TransactionId, msg.ProgramId)
+		}
+		return nil, err
+	}
+	if spend.TxType != types.TransactionTypeSpend {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRefund, "transaction %d is a %s, not a spend", spend.Id, spend.TxType)
+	}
+	payer, err := k.refundPayer(ctx, spend, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if spend.Refunded > spend.Amount || msg.Amount > spend.Amount-spend.Refunded {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRefund, "%d of %d already refunded, requested %d", spend.Refunded, spend.Amount, msg.Amount)
+	}
+	if err := k.assertDebitAllowed(ctx, payer); err != nil {
+		return nil, err
+	}
+	if err := k.assertCreditAllowed(ctx, spend.Sender); err != nil {
+		return nil, err
+	}
+
+	// 환불 주문 번호가 없으면 원래 사용 거래의 주문 번호를 사용
+	note := transactionNote{Memo: msg.Reason, OrderReference: msg.OrderReference, Metadata: msg.Metadata}
+	if note.OrderReference == "" {
+		note.OrderReference = spend.OrderReference
+	}
+	if err := k.validateNote(ctx, note); err != nil {
+		return nil, err
+	}
+
+	// 2. 가맹점 정산 주소의 잔액에서 차감 (부족하면 에러)
+	if _, _, err := k.debitLots(ctx, msg.ProgramId, payer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 3. 사용자에게 원래 사용한 로트의 발행/만료 시각으로 적립
+	//    (로트가 기록되지 않은 이전 사용 거래는 params 기본 만료 시각의 새 로트)
+	var lots []types.PointLot
+	for _, lot := range spend.RefundLots(msg.Amount) {
+		lots = append(lots, types.PointLot{Amount: lot.Amount, IssuedAt: lot.IssuedAt, ExpiresAt: lot.ExpiresAt})
+	}
+	if len(lots) == 0 {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lots = append(lots, types.PointLot{Amount: msg.Amount, IssuedAt: sdkCtx.BlockTime().Unix(), ExpiresAt: params.PointExpiresAt(sdkCtx.BlockTime(), nil)})
+	}
+	balance, err := k.creditLots(ctx, msg.ProgramId, spend.Sender, lots)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 원래 거래의 환불 금액 갱신 및 환불량 집계
+	spend.Refunded += msg.Amount
+	if err := k.setSpend(ctx, spendKey, spend, pruned); err != nil {
+		return nil, err
+	}
+	if err := k.updateSupply(ctx, msg.ProgramId, func(supply *types.PointSupply) (err error) {
+		supply.Refunded, err = addAmount(supply.Refunded, msg.Amount)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	// 5. 환불 거래 기록
+	id, err := k.TransactionSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := types.Transaction{
+		Id:         id,
+		ProgramId:  msg.ProgramId,
+		Sender:     payer,
+		Recipient:  spend.Sender,
+		Amount:     msg.Amount,
+		TxType:     types.TransactionTypeRefund,
+		Timestamp:  sdkCtx.BlockTime().Unix(),
+		RefundOf:   spend.Id,
+		MerchantId: spend.MerchantId,
+	}
+	note.applyTo(&tx)
+	if err := k.Transaction.Set(ctx, collections.Join(msg.ProgramId, id), tx); err != nil {
+		return nil, err
+	}
+
+	// 6. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventSpendRefunded{
+		TransactionId:   id,
+		RefundOf:        spend.Id,
+		ProgramId:       msg.ProgramId,
+		MerchantAddress: payer,
+		Spender:         spend.Sender,
+		Amount:          msg.Amount,
+		Refunded:        spend.Refunded,
+		SpenderBalance:  balance.Balance,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundSpendResponse{TransactionId: id}, nil
+}
+
+// refundPayer returns the account a refund of spend by creator is paid from:
+// the current settlement address of the spend's merchant, which its owner or
+// that address may refund from. Spends recorded without their lots predate
+// merchant ids on spends and are refunded by their recipient.
+func (k Keeper) refundPayer(ctx context.Context, spend types.Transaction, creator string) (string, error) {
+	if len(spend.SpentLots) == 0 {
+		if spend.Recipient != creator {
+			return "", errorsmod.Wrapf(types.ErrUnauthorized, "only %s may refund transaction %d", spend.Recipient, spend.Id)
+		}
+		return spend.Recipient, nil
+	}
+
+	merchant, err := k.getMerchant(ctx, spend.MerchantId)
+	if err != nil {
+		return "", err
+	}
+	if merchant.Owner != creator && merchant.SettlementAddress != creator {
+		return "", errorsmod.Wrapf(types.ErrUnauthorized, "only the owner or settlement address of merchant %d may refund transaction %d", merchant.Id, spend.Id)
+	}
+	return merchant.SettlementAddress, nil
+}
+
+// getSpend returns the transaction under key, falling back to the refund
+// record of a pruned spend. pruned reports whether it was pruned.
+func (k Keeper) getSpend(ctx context.Context, key programKey) (spend types.Transaction, pruned bool, err error) {
+	spend, err = k.Transaction.Get(ctx, key)
+	if !errors.Is(err, collections.ErrNotFound) {
+		return spend, false, err
+	}
+	spend, err = k.RefundableSpend.Get(ctx, key)
+	return spend, true, err
+}
+
+// setSpend stores a refunded spend back where getSpend found it, dropping
+// the refund record of a pruned spend once it is fully refunded.
+func (k Keeper) setSpend(ctx context.Context, key programKey, spend types.Transaction, pruned bool) error {
+	switch {
+	case !pruned:
+		return k.Transaction.Set(ctx, key, spend)
+	case spend.IsRefundable():
+		return k.RefundableSpend.Set(ctx, key, spend)
+	default:
+		return k.RefundableSpend.Remove(ctx, key)
+	}
+}
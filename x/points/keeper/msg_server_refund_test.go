@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestMsgRefundSpend(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	customer, merchant := sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, ctx, customer, 100, nil)
+	merchantID := registerMerchant(t, f, ctx, merchant)
+	_, err := ms.SpendPoints(ctx, types.NewMsgSpendPoints(customer, testProgramID, merchantID, 60, "shoes"))
+	require.NoError(t, err)
+	const spendID, issueID = 1, 0
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgRefundSpend
+		expErr error
+	}{
+		{
+			name:   "zero amount",
+			input:  types.NewMsgRefundSpend(merchant, testProgramID, spendID, 0),
+			expErr: types.ErrInvalidRefund,
+		},
+		{
+			name:   "unknown transaction",
+			input:  types.NewMsgRefundSpend(merchant, testProgramID, 99, 10),
+			expErr: types.ErrTransactionNotFound,
+		},
+		{
+			name:   "transaction of another program",
+			input:  types.NewMsgRefundSpend(merchant, "other", spendID, 10),
+			expErr: types.ErrTransactionNotFound,
+		},
+		{
+			name:   "not a spend",
+			input:  types.NewMsgRefundSpend(customer, testProgramID, issueID, 10),
+			expErr: types.ErrInvalidRefund,
+		},
+		{
+			name:   "not the merchant",
+			input:  types.NewMsgRefundSpend(customer, testProgramID, spendID, 10),
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name:   "more than spent",
+			input:  types.NewMsgRefundSpend(merchant, testProgramID, spendID, 61),
+			expErr: types.ErrInvalidRefund,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RefundSpend(ctx, tc.input)
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+
+	res, err := ms.RefundSpend(ctx, types.NewMsgRefundSpend(merchant, testProgramID, spendID, 25))
+	require.NoError(t, err)
+	requireLastEvent(t, ctx, &types.EventSpendRefunded{
+		TransactionId:   res.TransactionId,
+		RefundOf:        spendID,
+		ProgramId:       testProgramID,
+		MerchantAddress: merchant,
+		Spender:         customer,
+		Amount:          25,
+		Refunded:        25,
+		SpenderBalance:  65,
+	})
+
+	// the remainder is all that can still be refunded
+	_, err = ms.RefundSpend(ctx, types.NewMsgRefundSpend(merchant, testProgramID, spendID, 36))
+	require.ErrorIs(t, err, types.ErrInvalidRefund)
+	_, err = ms.RefundSpend(ctx, types.NewMsgRefundSpend(merchant, testProgramID, spendID, 35))
+	require.NoError(t, err)
+
+	spend, err := qs.GetTransaction(ctx, &types.QueryGetTransactionRequest{ProgramId: testProgramID, Id: spendID})
+	require.NoError(t, err)
+	require.EqualValues(t, 60, spend.Transaction.Refunded)
+
+	refund, err := qs.GetTransaction(ctx, &types.QueryGetTransactionRequest{ProgramId: testProgramID, Id: res.TransactionId})
+	require.NoError(t, err)
+	require.Equal(t, types.Transaction{
+		Id:         res.TransactionId,
+		ProgramId:  testProgramID,
+		Sender:     merchant,
+		Recipient:  customer,
+		Amount:     25,
+		TxType:     types.TransactionTypeRefund,
+		Timestamp:  ctx.BlockTime().Unix(),
+		RefundOf:   spendID,
+		MerchantId: merchantID,
+	}, refund.Transaction)
+
+	for address, want := range map[string]uint64{customer: 100, merchant: 0} {
+		balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, address))
+		require.NoError(t, err)
+		require.Equal(t, want, balance.Balance)
+	}
+	supply, err := f.keeper.Supply.Get(ctx, testProgramID)
+	require.NoError(t, err)
+	require.EqualValues(t, 60, supply.Refunded)
+
+	msg, broken := keeper.AllInvariants(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestMsgRefundSpendNeedsMerchantBalance(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	customer, merchant := sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, f.ctx, customer, 100, nil)
+	_, err := ms.SpendPoints(f.ctx, types.NewMsgSpendPoints(customer, testProgramID, registerMerchant(t, f, f.ctx, merchant), 60, "shoes"))
+	require.NoError(t, err)
+	_, err = ms.RequestSettlement(f.ctx, types.NewMsgRequestSettlement(merchant, testProgramID, 50))
+	require.NoError(t, err)
+
+	_, err = ms.RefundSpend(f.ctx, types.NewMsgRefundSpend(merchant, testProgramID, 1, 20))
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+}
+
+func TestMsgRefundSpendByMerchant(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	blockTime := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+
+	customer, oldAddress, newAddress := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	week, month := 7*24*time.Hour, 30*24*time.Hour
+	issuePoints(t, f, ctx, customer, 30, &week)
+	issuePoints(t, f, ctx.WithBlockTime(blockTime.Add(time.Hour)), customer, 30, &month)
+
+	merchantID := registerMerchant(t, f, ctx, oldAddress)
+	_, err := ms.SpendPoints(ctx, types.NewMsgSpendPoints(customer, testProgramID, merchantID, 50, "shoes"))
+	require.NoError(t, err)
+	const spendID = 2
+
+	spend, err := f.keeper.Transaction.Get(ctx, collections.Join(testProgramID, uint64(spendID)))
+	require.NoError(t, err)
+	require.Equal(t, merchantID, spend.MerchantId)
+	require.Equal(t, []types.SpentLot{
+		{Amount: 30, IssuedAt: blockTime.Unix(), ExpiresAt: blockTime.Add(week).Unix()},
+		{Amount: 20, IssuedAt: blockTime.Add(time.Hour).Unix(), ExpiresAt: blockTime.Add(time.Hour + month).Unix()},
+	}, spend.SpentLots)
+
+	// the merchant moves its settlement address and funds it
+	merchant, err := f.keeper.Merchant.Get(ctx, merchantID)
+	require.NoError(t, err)
+	_, err = ms.UpdateMerchant(ctx, types.NewMsgUpdateMerchant(merchant.Owner, merchantID, merchant.Name, newAddress))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(oldAddress, testProgramID, newAddress, 50))
+	require.NoError(t, err)
+
+	// the former settlement address may no longer refund the spend
+	_, err = ms.RefundSpend(ctx, types.NewMsgRefundSpend(oldAddress, testProgramID, spendID, 10))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the owner and the current settlement address refund from the current
+	// settlement address, restoring the spent lots oldest first
+	_, err = ms.RefundSpend(ctx, types.NewMsgRefundSpend(merchant.Owner, testProgramID, spendID, 20))
+	require.NoError(t, err)
+	requireLastEvent(t, ctx, &types.EventSpendRefunded{
+		TransactionId:   4,
+		RefundOf:        spendID,
+		ProgramId:       testProgramID,
+		MerchantAddress: newAddress,
+		Spender:         customer,
+		Amount:          20,
+		Refunded:        20,
+		SpenderBalance:  30,
+	})
+	_, err = ms.RefundSpend(ctx, types.NewMsgRefundSpend(newAddress, testProgramID, spendID, 20))
+	require.NoError(t, err)
+
+	lots := ownerLots(t, f, ctx, customer)
+	require.Len(t, lots, 4)
+	var restored []types.SpentLot
+	for _, lot := range lots {
+		restored = append(restored, types.SpentLot{Amount: lot.Amount, IssuedAt: lot.IssuedAt, ExpiresAt: lot.ExpiresAt})
+	}
+	require.ElementsMatch(t, []types.SpentLot{
+		{Amount: 10, IssuedAt: blockTime.Add(time.Hour).Unix(), ExpiresAt: blockTime.Add(time.Hour + month).Unix()},
+		{Amount: 20, IssuedAt: blockTime.Unix(), ExpiresAt: blockTime.Add(week).Unix()},
+		{Amount: 10, IssuedAt: blockTime.Unix(), ExpiresAt: blockTime.Add(week).Unix()},
+		{Amount: 10, IssuedAt: blockTime.Add(time.Hour).Unix(), ExpiresAt: blockTime.Add(time.Hour + month).Unix()},
+	}, restored)
+
+	balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, newAddress))
+	require.NoError(t, err)
+	require.EqualValues(t, 10, balance.Balance)
+
+	msg, broken := keeper.AllInvariants(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
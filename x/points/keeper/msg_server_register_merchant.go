@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RegisterMerchant(ctx context.Context, msg *types.MsgRegisterMerchant) (*types.MsgRegisterMerchantResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.SettlementAddress); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "settlement address: %s", err)
+	}
+
+	// 1. 가맹점 ID 발급
+	id, err := k.MerchantSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant := types.Merchant{
+		Id:                id,
+		Name:              msg.Name,
+		Owner:             msg.Creator,
+		SettlementAddress: msg.SettlementAddress,
+		Active:            false,
+	}
+	if err := merchant.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 2. 가맹점 저장
+	if err := k.Merchant.Set(ctx, id, merchant); err != nil {
+		return nil, err
+	}
+
+	// 3. 이벤트 발생
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMerchantRegistered{
+		MerchantId:        id,
+		Owner:             merchant.Owner,
+		Name:              merchant.Name,
+		SettlementAddress: merchant.SettlementAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterMerchantResponse{Id: id}, nil
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+)
+
+func (k msgServer) RejectSettlement(ctx context.Context, msg *types.MsgRejectSettlement) (*types.MsgRejectSettlementResponse, error) {
+	if err := k.assertSettler(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	settlement, err := k.getSettlement(ctx, msg.ProgramId, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement.RejectionReason = msg.Reason
+	// 상태 변경 및 차감된 포인트 환불
+	if _, err := k.decideSettlement(ctx, settlement, types.SettlementStatusRejected, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRejectSettlementResponse{}, nil
+}
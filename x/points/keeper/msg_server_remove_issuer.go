@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+func (k msgServer) RemoveIssuer(ctx context.Context, msg *types.MsgRemoveIssuer) (*types.MsgRemoveIssuerResponse, error) {
+	if err := k.assertAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	found, err := k.Issuer.Has(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrIssuerNotFound, "%s", msg.Address)
+	}
+
+	if err := k.Issuer.Remove(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveIssuerResponse{}, nil
+}
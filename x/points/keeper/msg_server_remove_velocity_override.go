@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+func (k msgServer) RemoveVelocityOverride(ctx context.Context, msg *types.MsgRemoveVelocityOverride) (*types.MsgRemoveVelocityOverrideResponse, error) {
+	if err := k.assertAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	found, err := k.VelocityOverride.Has(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrVelocityOverrideNotFound, "%s", msg.Address)
+	}
+
+	if err := k.VelocityOverride.Remove(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveVelocityOverrideResponse{}, nil
+}
@@ -5,8 +5,8 @@ import (
 
 	"scontract/x/points/types"
 
-	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,45 +14,62 @@ func (k msgServer) RequestSettlement(ctx context.Context, msg *types.MsgRequestS
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
 		return nil, errorsmod.Wrap(err, "invalid authority address")
 	}
+	if msg.Amount == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidCoins, "amount must be positive")
+	}
 
-	// 1. 잔액 조회
-	balance, err := k.PointBalance.Get(ctx, msg.Creator)
-	if err != nil {
-		if errorsmod.IsOf(err, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(types.ErrInsufficientFunds, "balance not found")
-		}
+	if _, err := k.getProgram(ctx, msg.ProgramId); err != nil {
+		return nil, err
+	}
+	if err := k.assertDebitAllowed(ctx, msg.Creator); err != nil {
 		return nil, err
 	}
 
-	// 2. 잔액 확인
-	if balance.Balance < msg.Amount {
-		return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "balance is %d but needed %d", balance.Balance, msg.Amount)
+	// 1. 로트에서 차감 (정산 요청 시 포인트 차감, 부족하면 에러)
+	balance, escrowed, err := k.debitLots(ctx, msg.ProgramId, msg.Creator, msg.Amount)
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. 잔액 차감 (정산 요청 시 포인트 차감)
-	balance.Balance -= msg.Amount
-	if err := k.PointBalance.Set(ctx, msg.Creator, balance); err != nil {
+	// 2. 정산량 집계 (정산 요청된 포인트는 유통량에서 빠짐)
+	if err := k.updateSupply(ctx, msg.ProgramId, func(supply *types.PointSupply) (err error) {
+		supply.Settled, err = addAmount(supply.Settled, msg.Amount)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 
-	// 4. 정산 ID 생성
+	// 3. 정산 ID 생성
 	id, err := k.SettlementSeq.Next(ctx)
 	if err != nil {
 		return nil, err
 	}
-	
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// 5. 정산 기록 저장
+	// 4. 정산 기록 저장 (거절/취소 시 복원할 로트 포함)
 	settlement := types.Settlement{
-		Id:        id,
-		Requester: msg.Creator,
-		Amount:    msg.Amount,
-		Status:    "pending",
-		Timestamp: sdkCtx.BlockTime().Unix(),
-	}
-	
-	if err := k.Settlement.Set(ctx, id, settlement); err != nil {
+		Id:           id,
+		ProgramId:    msg.ProgramId,
+		Requester:    msg.Creator,
+		Amount:       msg.Amount,
+		Status:       types.SettlementStatusPending,
+		Timestamp:    sdkCtx.BlockTime().Unix(),
+		EscrowedLots: escrowed,
+	}
+
+	if err := k.Settlement.Set(ctx, collections.Join(msg.ProgramId, id), settlement); err != nil {
+		return nil, err
+	}
+
+	// 5. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventSettlementRequested{
+		SettlementId:     id,
+		Requester:        msg.Creator,
+		Amount:           msg.Amount,
+		RequesterBalance: balance.Balance,
+		ProgramId:        msg.ProgramId,
+	}); err != nil {
 		return nil, err
 	}
 
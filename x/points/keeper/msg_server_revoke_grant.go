@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RevokeGrant(ctx context.Context, msg *types.MsgRevokeGrant) (*types.MsgRevokeGrantResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	// 0. 지급 조회, 지급한 발행자 여부 확인
+	grant, err := k.getPointGrant(ctx, msg.ProgramId, msg.GrantId)
+	if err != nil {
+		return nil, err
+	}
+	if grant.Issuer != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only the issuer of grant %d may revoke it", grant.Id)
+	}
+
+	// 1. 취소 가능 여부 확인
+	if !grant.Revocable {
+		return nil, errorsmod.Wrapf(types.ErrInvalidGrant, "grant %d is not revocable", grant.Id)
+	}
+	if grant.Status != types.PointGrantStatusActive {
+		return nil, errorsmod.Wrapf(types.ErrInvalidGrant, "grant %d is %s", grant.Id, grant.Status)
+	}
+
+	// 2. 남은 회차 취소
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	unreleased := grant.Unreleased()
+	grant.Status = types.PointGrantStatusRevoked
+	grant.RevokedAt = sdkCtx.BlockTime().Unix()
+	if err := k.PointGrant.Set(ctx, collections.Join(grant.ProgramId, grant.Id), grant); err != nil {
+		return nil, err
+	}
+
+	// 3. 지급 생성 시 차감한 발행자 에포크 한도 중 남은 회차만큼 복구 (같은 에포크 안에서만)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.returnIssuerAllowance(ctx, grant.Issuer, unreleased, params.IssuerEpochNumber(time.Unix(grant.CreatedAt, 0))); err != nil {
+		return nil, err
+	}
+
+	// 4. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPointGrantRevoked{
+		GrantId:    grant.Id,
+		ProgramId:  grant.ProgramId,
+		RevokedBy:  msg.Creator,
+		Unreleased: unreleased,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeGrantResponse{Unreleased: unreleased}, nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+func (k msgServer) SetVelocityOverride(ctx context.Context, msg *types.MsgSetVelocityOverride) (*types.MsgSetVelocityOverrideResponse, error) {
+	if err := k.assertAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if err := msg.Limits.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidVelocityLimits, err.Error())
+	}
+
+	// Usage recorded so far keeps counting against the new limits.
+	override := types.VelocityOverride{Address: msg.Address, Limits: msg.Limits}
+	if err := k.VelocityOverride.Set(ctx, msg.Address, override); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetVelocityOverrideResponse{}, nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestSettlementBatches(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := f.ctx
+
+	settler := sample.AccAddress()
+	params := types.DefaultParams()
+	params.Settlers = []string{settler}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	first := requestSettlement(t, f, alice, 30)
+	cancelled := requestSettlement(t, f, alice, 5)
+	second := requestSettlement(t, f, alice, 20)
+	bobs := requestSettlement(t, f, bob, 50)
+	_, err := ms.CancelSettlement(ctx, types.NewMsgCancelSettlement(alice, testProgramID, cancelled))
+	require.NoError(t, err)
+
+	pendingBatches := func() []types.SettlementBatch {
+		res, err := qs.SettlementBatchesByStatus(ctx, &types.QuerySettlementBatchesByStatusRequest{ProgramId: testProgramID, Status: types.SettlementBatchStatusPending})
+		require.NoError(t, err)
+		return res.SettlementBatch
+	}
+
+	// batching is disabled until a settlement batch epoch is set
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.Empty(t, pendingBatches())
+
+	params.SettlementBatchEpoch = "week"
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+
+	batches := pendingBatches()
+	require.Len(t, batches, 2)
+	var batch types.SettlementBatch
+	for _, b := range batches {
+		if b.Requester == alice {
+			batch = b
+		} else {
+			require.Equal(t, []uint64{bobs}, b.SettlementIds)
+			require.EqualValues(t, 50, b.TotalAmount)
+		}
+	}
+	require.Equal(t, []uint64{first, second}, batch.SettlementIds)
+	require.EqualValues(t, 50, batch.TotalAmount)
+	require.Equal(t, types.SettlementBatchRoot([]uint64{first, second}), batch.MerkleRoot)
+	require.Equal(t, "week", batch.EpochIdentifier)
+	require.EqualValues(t, 2, batch.EpochNumber)
+
+	for _, id := range batch.SettlementIds {
+		settlement, err := f.keeper.Settlement.Get(ctx, collections.Join(testProgramID, id))
+		require.NoError(t, err)
+		require.Equal(t, types.SettlementStatusBatched, settlement.Status)
+	}
+	_, err = ms.ApproveSettlement(ctx, types.NewMsgApproveSettlement(settler, testProgramID, first))
+	require.ErrorIs(t, err, types.ErrInvalidSettlementTransition)
+	_, err = ms.CancelSettlement(ctx, types.NewMsgCancelSettlement(alice, testProgramID, second))
+	require.ErrorIs(t, err, types.ErrInvalidSettlementTransition)
+
+	// an epoch without pending settlements creates no batch
+	f.epochsKeeper.endEpoch("week")
+	require.NoError(t, f.keeper.ProcessEpochs(ctx))
+	require.Len(t, pendingBatches(), 2)
+
+	_, err = ms.ConfirmBatchPaid(ctx, types.NewMsgConfirmBatchPaid(alice, testProgramID, batch.Id, "wire-1"))
+	require.ErrorIs(t, err, types.ErrUnauthorizedSettler)
+	_, err = ms.ConfirmBatchPaid(ctx, types.NewMsgConfirmBatchPaid(settler, testProgramID, 9, "wire-1"))
+	require.ErrorIs(t, err, types.ErrSettlementBatchNotFound)
+
+	_, err = ms.ConfirmBatchPaid(ctx, types.NewMsgConfirmBatchPaid(settler, testProgramID, batch.Id, "wire-1"))
+	require.NoError(t, err)
+	requireLastEvent(t, ctx, &types.EventSettlementBatchPaid{BatchId: batch.Id, ProgramId: testProgramID, Requester: alice, TotalAmount: 50, PaidBy: settler, PaymentReference: "wire-1"})
+
+	_, err = ms.ConfirmBatchPaid(ctx, types.NewMsgConfirmBatchPaid(settler, testProgramID, batch.Id, "wire-1"))
+	require.ErrorIs(t, err, types.ErrInvalidSettlementBatch)
+
+	res, err := qs.GetSettlementBatch(ctx, &types.QueryGetSettlementBatchRequest{ProgramId: testProgramID, Id: batch.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.SettlementBatchStatusPaid, res.SettlementBatch.Status)
+	require.Equal(t, "wire-1", res.SettlementBatch.PaymentReference)
+	require.Len(t, res.Settlements, 2)
+	for _, settlement := range res.Settlements {
+		require.Equal(t, types.SettlementStatusPaid, settlement.Status)
+		require.Nil(t, settlement.Payout)
+	}
+	require.Len(t, pendingBatches(), 1)
+}
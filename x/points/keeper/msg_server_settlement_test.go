@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+// requestSettlement funds requester with amount points and files a settlement
+// for all of them, returning its id.
+func requestSettlement(t *testing.T, f *fixture, requester string, amount uint64) uint64 {
+	t.Helper()
+
+	issuePoints(t, f, f.ctx, requester, amount, nil)
+
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	_, err := ms.RequestSettlement(f.ctx, types.NewMsgRequestSettlement(requester, testProgramID, amount))
+	require.NoError(t, err)
+
+	id, err := f.keeper.SettlementSeq.Peek(f.ctx)
+	require.NoError(t, err)
+	requireLastEvent(t, f.ctx, &types.EventSettlementRequested{
+		SettlementId: id - 1,
+		Requester:    requester,
+		Amount:       amount,
+		ProgramId:    testProgramID,
+	})
+	return id - 1
+}
+
+func TestMsgApproveSettlement(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	settler := sample.AccAddress()
+	params := types.DefaultParams()
+	params.Settlers = []string{settler}
+	params.SettlementRate = math.LegacyNewDecWithPrec(15, 1)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	requester := sample.AccAddress()
+	id := requestSettlement(t, f, requester, 100)
+
+	_, err := ms.ApproveSettlement(f.ctx, types.NewMsgApproveSettlement(sample.AccAddress(), testProgramID, id))
+	require.ErrorIs(t, err, types.ErrUnauthorizedSettler)
+
+	_, err = ms.ApproveSettlement(f.ctx, types.NewMsgApproveSettlement(settler, testProgramID, id+1))
+	require.ErrorIs(t, err, types.ErrSettlementNotFound)
+
+	funder := sample.AccAddress()
+	f.bankKeeper.balances[funder] = sdk.NewCoins(sdk.NewInt64Coin(types.DefaultSettlementDenom, 1000))
+	_, err = ms.FundTreasury(f.ctx, types.NewMsgFundTreasury(funder, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultSettlementDenom, 1000))))
+	require.NoError(t, err)
+
+	_, err = ms.ApproveSettlement(f.ctx, types.NewMsgApproveSettlement(settler, testProgramID, id))
+	require.NoError(t, err)
+
+	settlement, err := f.keeper.Settlement.Get(f.ctx, collections.Join(testProgramID, id))
+	require.NoError(t, err)
+	require.Equal(t, types.SettlementStatusPaid, settlement.Status)
+	require.Equal(t, settler, settlement.DecidedBy)
+	require.Equal(t, sdk.NewInt64Coin(types.DefaultSettlementDenom, 150), *settlement.Payout)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultSettlementDenom, 150)), f.bankKeeper.balances[requester])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultSettlementDenom, 850)), f.bankKeeper.balances[authtypes.NewModuleAddress(types.TreasuryModuleName).String()])
+	requireLastEvent(t, f.ctx, &types.EventSettlementStatusChanged{
+		SettlementId: id,
+		Requester:    requester,
+		Amount:       100,
+		FromStatus:   types.SettlementStatusApproved,
+		ToStatus:     types.SettlementStatusPaid,
+		Actor:        settler,
+		Payout:       settlement.Payout,
+		ProgramId:    testProgramID,
+	})
+
+	_, err = ms.RejectSettlement(f.ctx, types.NewMsgRejectSettlement(settler, testProgramID, id, "too late"))
+	require.ErrorIs(t, err, types.ErrInvalidSettlementTransition)
+	_, err = ms.CancelSettlement(f.ctx, types.NewMsgCancelSettlement(requester, testProgramID, id))
+	require.ErrorIs(t, err, types.ErrInvalidSettlementTransition)
+}
+
+func TestMsgApproveSettlementUnfundedTreasury(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	id := requestSettlement(t, f, sample.AccAddress(), 100)
+
+	_, err = ms.ApproveSettlement(f.ctx, types.NewMsgApproveSettlement(authorityStr, testProgramID, id))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
+
+func TestMsgApproveSettlementPayoutsDisabled(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.SettlementDenom = ""
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	id := requestSettlement(t, f, sample.AccAddress(), 100)
+
+	// an approved settlement could never be paid, so it stays pending
+	_, err = ms.ApproveSettlement(f.ctx, types.NewMsgApproveSettlement(authorityStr, testProgramID, id))
+	require.ErrorIs(t, err, types.ErrPayoutsDisabled)
+
+	settlement, err := f.keeper.Settlement.Get(f.ctx, collections.Join(testProgramID, id))
+	require.NoError(t, err)
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+	require.Nil(t, settlement.Payout)
+
+	// once payouts are enabled again it is approved and paid
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, types.TreasuryModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultSettlementDenom, 100))))
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.DefaultParams()))
+	_, err = ms.ApproveSettlement(f.ctx, types.NewMsgApproveSettlement(authorityStr, testProgramID, id))
+	require.NoError(t, err)
+	settlement, err = f.keeper.Settlement.Get(f.ctx, collections.Join(testProgramID, id))
+	require.NoError(t, err)
+	require.Equal(t, types.SettlementStatusPaid, settlement.Status)
+}
+
+func TestMsgFundTreasury(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	funder := sample.AccAddress()
+	f.bankKeeper.balances[funder] = sdk.NewCoins(sdk.NewInt64Coin("sjcoin", 100))
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgFundTreasury
+		expErr error
+	}{
+		{
+			name:   "invalid creator",
+			input:  types.NewMsgFundTreasury("invalid", sdk.NewCoins(sdk.NewInt64Coin("sjcoin", 10))),
+			expErr: types.ErrInvalidAddress,
+		},
+		{
+			name:   "empty amount",
+			input:  types.NewMsgFundTreasury(funder, sdk.NewCoins()),
+			expErr: types.ErrInvalidCoins,
+		},
+		{
+			name:   "insufficient funds",
+			input:  types.NewMsgFundTreasury(funder, sdk.NewCoins(sdk.NewInt64Coin("sjcoin", 1000))),
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name:  "all good",
+			input: types.NewMsgFundTreasury(funder, sdk.NewCoins(sdk.NewInt64Coin("sjcoin", 100))),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.FundTreasury(f.ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.input.Amount, f.bankKeeper.balances[authtypes.NewModuleAddress(types.TreasuryModuleName).String()])
+		})
+	}
+}
+
+func TestMsgRejectSettlement(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	requester := sample.AccAddress()
+	id := requestSettlement(t, f, requester, 100)
+
+	_, err = ms.RejectSettlement(f.ctx, types.NewMsgRejectSettlement(authorityStr, testProgramID, id, "invalid invoice"))
+	require.NoError(t, err)
+
+	settlement, err := f.keeper.Settlement.Get(f.ctx, collections.Join(testProgramID, id))
+	require.NoError(t, err)
+	require.Equal(t, types.SettlementStatusRejected, settlement.Status)
+	require.Equal(t, "invalid invoice", settlement.RejectionReason)
+
+	balance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, requester))
+	require.NoError(t, err)
+	require.EqualValues(t, 100, balance.Balance)
+	requireLastEvent(t, f.ctx, &types.EventSettlementStatusChanged{
+		SettlementId:     id,
+		Requester:        requester,
+		Amount:           100,
+		FromStatus:       types.SettlementStatusPending,
+		ToStatus:         types.SettlementStatusRejected,
+		Actor:            authorityStr,
+		RequesterBalance: 100,
+		ProgramId:        testProgramID,
+	})
+
+	_, err = ms.ApproveSettlement(f.ctx, types.NewMsgApproveSettlement(authorityStr, testProgramID, id))
+	require.ErrorIs(t, err, types.ErrInvalidSettlementTransition)
+}
+
+func TestMsgCancelSettlement(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	requester := sample.AccAddress()
+	id := requestSettlement(t, f, requester, 100)
+
+	_, err := ms.RequestSettlement(f.ctx, types.NewMsgRequestSettlement(requester, testProgramID, 0))
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+
+	_, err = ms.CancelSettlement(f.ctx, types.NewMsgCancelSettlement(sample.AccAddress(), testProgramID, id))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.CancelSettlement(f.ctx, types.NewMsgCancelSettlement(requester, testProgramID, id))
+	require.NoError(t, err)
+
+	settlement, err := f.keeper.Settlement.Get(f.ctx, collections.Join(testProgramID, id))
+	require.NoError(t, err)
+	require.Equal(t, types.SettlementStatusCancelled, settlement.Status)
+
+	balance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, requester))
+	require.NoError(t, err)
+	require.EqualValues(t, 100, balance.Balance)
+
+	_, err = ms.CancelSettlement(f.ctx, types.NewMsgCancelSettlement(requester, testProgramID, id))
+	require.ErrorIs(t, err, types.ErrInvalidSettlementTransition)
+}
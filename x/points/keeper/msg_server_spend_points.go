@@ -5,8 +5,8 @@ import (
 
 	"scontract/x/points/types"
 
-	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,44 +14,94 @@ func (k msgServer) SpendPoints(ctx context.Context, msg *types.MsgSpendPoints) (
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
 		return nil, errorsmod.Wrap(err, "invalid authority address")
 	}
+	if msg.Amount == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidCoins, "amount must be positive")
+	}
+
+	note := transactionNote{Memo: msg.Description, OrderReference: msg.OrderReference, Metadata: msg.Metadata}
+	if err := k.validateNote(ctx, note); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.getProgram(ctx, msg.ProgramId); err != nil {
+		return nil, err
+	}
+	if err := k.assertDebitAllowed(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	// 1. 가맹점 확인 (등록되어 있고 활성 상태여야 하며, 정산 주소가 적립을 받을 수 있어야 함)
+	merchant, err := k.getActiveMerchant(ctx, msg.MerchantId)
+	if err != nil {
+		return nil, err
+	}
+	if merchant.SettlementAddress == msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerchant, "cannot spend at merchant %d, whose settlement address is the spender", merchant.Id)
+	}
+	if err := k.assertCreditAllowed(ctx, merchant.SettlementAddress); err != nil {
+		return nil, err
+	}
+
+	// 2. 사용 한도 확인 (최근 24시간/7일 사용량)
+	if err := k.useVelocity(ctx, types.VelocitySpend, msg.Creator, "", msg.Amount); err != nil {
+		return nil, err
+	}
 
-	// 1. 잔액 조회
-	balance, err := k.PointBalance.Get(ctx, msg.Creator)
+	// 3. 만료되지 않은 로트에서 오래된 순으로 차감 (부족하면 에러)
+	balance, taken, err := k.debitLots(ctx, msg.ProgramId, msg.Creator, msg.Amount)
 	if err != nil {
-		if errorsmod.IsOf(err, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(types.ErrInsufficientFunds, "balance not found")
-		}
 		return nil, err
 	}
 
-	// 2. 잔액 확인 (부족하면 에러)
-	if balance.Balance < msg.Amount {
-		return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "balance is %d but needed %d", balance.Balance, msg.Amount)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 4. 차감한 로트의 만료 시각을 유지한 채 가맹점 정산 주소에 적립
+	if _, err := k.creditLots(ctx, msg.ProgramId, merchant.SettlementAddress, taken); err != nil {
+		return nil, err
 	}
 
-	// 3. 잔액 차감
-	balance.Balance -= msg.Amount
-	if err := k.PointBalance.Set(ctx, msg.Creator, balance); err != nil {
+	// 5. 사용량 집계
+	if err := k.updateSupply(ctx, msg.ProgramId, func(supply *types.PointSupply) (err error) {
+		supply.Spent, err = addAmount(supply.Spent, msg.Amount)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 
-	// 4. 거래 기록 추가
+	// 6. 거래 기록 추가
 	id, err := k.TransactionSeq.Next(ctx)
 	if err != nil {
 		return nil, err
 	}
-	
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	tx := types.Transaction{
-		Id:        id,
-		Sender:    msg.Creator,
-		Recipient: "MERCHANT", // or BURN address
-		Amount:    msg.Amount,
-		TxType:    "spend",
-		Timestamp: sdkCtx.BlockTime().Unix(),
-	}
-	if err := k.Transaction.Set(ctx, id, tx); err != nil {
+		Id:         id,
+		ProgramId:  msg.ProgramId,
+		Sender:     msg.Creator,
+		Recipient:  merchant.SettlementAddress,
+		Amount:     msg.Amount,
+		TxType:     types.TransactionTypeSpend,
+		Timestamp:  sdkCtx.BlockTime().Unix(),
+		MerchantId: merchant.Id,
+	}
+	for _, lot := range taken {
+		tx.SpentLots = append(tx.SpentLots, types.SpentLot{Amount: lot.Amount, IssuedAt: lot.IssuedAt, ExpiresAt: lot.ExpiresAt})
+	}
+	note.applyTo(&tx)
+	if err := k.Transaction.Set(ctx, collections.Join(msg.ProgramId, id), tx); err != nil {
+		return nil, err
+	}
+
+	// 7. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPointsSpent{
+		TransactionId:   id,
+		Spender:         msg.Creator,
+		Amount:          msg.Amount,
+		SpenderBalance:  balance.Balance,
+		ProgramId:       msg.ProgramId,
+		MerchantId:      merchant.Id,
+		MerchantAddress: merchant.SettlementAddress,
+	}); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+)
+
+func (k msgServer) SuspendIssuer(ctx context.Context, msg *types.MsgSuspendIssuer) (*types.MsgSuspendIssuerResponse, error) {
+	if err := k.assertAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	issuer, err := k.Issuer.Get(ctx, msg.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrIssuerNotFound, "%s", msg.Address)
+		}
+		return nil, err
+	}
+	issuer.Suspended = msg.Suspended
+
+	if err := k.Issuer.Set(ctx, msg.Address, issuer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSuspendIssuerResponse{}, nil
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestMsgTokenizePoints(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	denom := types.ProgramDenom(testProgramID)
+
+	holder := sample.AccAddress()
+	issuePoints(t, f, f.ctx, holder, 100, nil)
+
+	_, err := ms.TokenizePoints(f.ctx, types.NewMsgTokenizePoints(holder, testProgramID, 0))
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+	_, err = ms.TokenizePoints(f.ctx, types.NewMsgTokenizePoints(holder, "other", 10))
+	require.ErrorIs(t, err, types.ErrProgramNotFound)
+	_, err = ms.TokenizePoints(f.ctx, types.NewMsgTokenizePoints(holder, testProgramID, 101))
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	res, err := ms.TokenizePoints(f.ctx, types.NewMsgTokenizePoints(holder, testProgramID, 60))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom, 60), res.Minted)
+
+	balance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, holder))
+	require.NoError(t, err)
+	require.EqualValues(t, 40, balance.Balance)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 60)), f.bankKeeper.balances[holder])
+	require.Equal(t, sdkmath.NewInt(60), f.bankKeeper.GetSupply(f.ctx, denom).Amount)
+	requireLastEvent(t, f.ctx, &types.EventPointsTokenized{
+		TransactionId: 1,
+		ProgramId:     testProgramID,
+		Holder:        holder,
+		Amount:        60,
+		Denom:         denom,
+		HolderBalance: 40,
+	})
+
+	metadata, ok := f.bankKeeper.metadata[denom]
+	require.True(t, ok)
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, "LOYALTY", metadata.Symbol)
+
+	supply, err := f.keeper.Supply.Get(f.ctx, testProgramID)
+	require.NoError(t, err)
+	require.EqualValues(t, 60, supply.Tokenized)
+
+	msg, broken := keeper.AllInvariants(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+}
+
+func TestMsgDetokenizePoints(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	denom := types.ProgramDenom(testProgramID)
+
+	holder, other := sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, f.ctx, holder, 100, nil)
+	_, err := ms.TokenizePoints(f.ctx, types.NewMsgTokenizePoints(holder, testProgramID, 60))
+	require.NoError(t, err)
+
+	// coins can move like any bank coin and be redeemed by whoever holds them
+	require.NoError(t, f.bankKeeper.send(sdk.MustAccAddressFromBech32(holder), sdk.MustAccAddressFromBech32(other), sdk.NewCoins(sdk.NewInt64Coin(denom, 25))))
+
+	_, err = ms.DetokenizePoints(f.ctx, types.NewMsgDetokenizePoints(other, testProgramID, 26))
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+	_, err = ms.DetokenizePoints(f.ctx, types.NewMsgDetokenizePoints(other, testProgramID, 0))
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+
+	_, err = ms.DetokenizePoints(f.ctx, types.NewMsgDetokenizePoints(other, testProgramID, 25))
+	require.NoError(t, err)
+
+	balance, err := f.keeper.PointBalance.Get(f.ctx, collections.Join(testProgramID, other))
+	require.NoError(t, err)
+	require.EqualValues(t, 25, balance.Balance)
+	require.True(t, f.bankKeeper.balances[other].IsZero())
+	require.Equal(t, sdkmath.NewInt(35), f.bankKeeper.GetSupply(f.ctx, denom).Amount)
+	requireLastEvent(t, f.ctx, &types.EventPointsDetokenized{
+		TransactionId: 2,
+		ProgramId:     testProgramID,
+		Holder:        other,
+		Amount:        25,
+		Denom:         denom,
+		HolderBalance: 25,
+	})
+
+	supply, err := f.keeper.Supply.Get(f.ctx, testProgramID)
+	require.NoError(t, err)
+	require.EqualValues(t, 35, supply.Tokenized)
+
+	msg, broken := keeper.AllInvariants(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+}
+
+func TestTokenizeRoundTripExpiry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	holder := sample.AccAddress()
+	issuePoints(t, f, ctx, holder, 100, nil)
+	issued := ctx.BlockTime().Add(types.DefaultPointExpiry).Unix()
+
+	_, err := ms.TokenizePoints(ctx, types.NewMsgTokenizePoints(holder, testProgramID, 100))
+	require.NoError(t, err)
+	_, err = ms.DetokenizePoints(ctx, types.NewMsgDetokenizePoints(holder, testProgramID, 100))
+	require.NoError(t, err)
+
+	// the points expire no later than they would have without the round trip
+	genesis, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, genesis.PointLotList, 1)
+	require.Equal(t, issued, genesis.PointLotList[0].ExpiresAt)
+
+	expired := ctx.WithBlockTime(time.Unix(issued, 0))
+	require.NoError(t, f.keeper.ExpireDueLots(expired))
+	balance, err := f.keeper.PointBalance.Get(expired, collections.Join(testProgramID, holder))
+	require.NoError(t, err)
+	require.Zero(t, balance.Balance)
+}
+
+func TestDetokenizeLaterKeepsExpiry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	blockTime := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+
+	holder := sample.AccAddress()
+	week, month := 7*24*time.Hour, 30*24*time.Hour
+	issuePoints(t, f, ctx, holder, 40, &week)
+	issuePoints(t, f, ctx.WithBlockTime(blockTime.Add(time.Hour)), holder, 60, &month)
+
+	_, err := ms.TokenizePoints(ctx, types.NewMsgTokenizePoints(holder, testProgramID, 100))
+	require.NoError(t, err)
+
+	// redeeming days later gives back the oldest tokenized lot with its
+	// original issue and expiry times instead of a fresh expiry
+	later := ctx.WithBlockTime(blockTime.Add(3 * 24 * time.Hour))
+	_, err = ms.DetokenizePoints(later, types.NewMsgDetokenizePoints(holder, testProgramID, 50))
+	require.NoError(t, err)
+
+	lots := ownerLots(t, f, later, holder)
+	require.Len(t, lots, 2)
+	require.Equal(t, types.PointLot{Id: lots[0].Id, ProgramId: testProgramID, Owner: holder, Amount: 40, IssuedAt: blockTime.Unix(), ExpiresAt: blockTime.Add(week).Unix()}, lots[0])
+	require.Equal(t, types.PointLot{Id: lots[1].Id, ProgramId: testProgramID, Owner: holder, Amount: 10, IssuedAt: blockTime.Add(time.Hour).Unix(), ExpiresAt: blockTime.Add(time.Hour + month).Unix()}, lots[1])
+
+	// points redeemed after their expiry are credited expired and swept
+	expired := ctx.WithBlockTime(blockTime.Add(time.Hour + month))
+	_, err = ms.DetokenizePoints(expired, types.NewMsgDetokenizePoints(holder, testProgramID, 50))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.ExpireDueLots(expired))
+	balance, err := f.keeper.PointBalance.Get(expired, collections.Join(testProgramID, holder))
+	require.NoError(t, err)
+	require.Zero(t, balance.Balance)
+
+	msg, broken := keeper.AllInvariants(f.keeper)(expired)
+	require.False(t, broken, msg)
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (k msgServer) TokenizePoints(ctx context.Context, msg *types.MsgTokenizePoints) (*types.MsgTokenizePointsResponse, error) {
+	holder, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if msg.Amount == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidCoins, "amount must be positive")
+	}
+
+	program, err := k.getProgram(ctx, msg.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.assertDebitAllowed(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	// 1. 만료되지 않은 로트에서 오래된 순으로 차감 후 토큰화 로트 풀에 보관 (부족하면 에러)
+	balance, taken, err := k.debitLots(ctx, msg.ProgramId, msg.Creator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.poolTokenizedLots(ctx, msg.ProgramId, taken); err != nil {
+		return nil, err
+	}
+
+	// 2. 프로그램 denom 메타데이터 등록 후 코인 발행 및 지급
+	k.ensureDenomMetadata(ctx, program)
+	coins := programCoins(msg.ProgramId, msg.Amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, coins); err != nil {
+		return nil, err
+	}
+
+	// 3. 토큰화 집계
+	if err := k.updateSupply(ctx, msg.ProgramId, func(supply *types.PointSupply) (err error) {
+		supply.Tokenized, err = addAmount(supply.Tokenized, msg.Amount)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	// 4. 거래 기록
+	id, err := k.TransactionSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	moduleAddress, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	if err != nil {
+		return nil, err
+	}
+
+	tx := types.Transaction{
+		Id:        id,
+		ProgramId: msg.ProgramId,
+		Sender:    msg.Creator,
+		Recipient: moduleAddress,
+		Amount:    msg.Amount,
+		TxType:    types.TransactionTypeTokenize,
+		Timestamp: sdkCtx.BlockTime().Unix(),
+	}
+	if err := k.Transaction.Set(ctx, collections.Join(msg.ProgramId, id), tx); err != nil {
+		return nil, err
+	}
+
+	// 5. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPointsTokenized{
+		TransactionId: id,
+		ProgramId:     msg.ProgramId,
+		Holder:        msg.Creator,
+		Amount:        msg.Amount,
+		Denom:         types.ProgramDenom(msg.ProgramId),
+		HolderBalance: balance.Balance,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTokenizePointsResponse{Minted: coins[0]}, nil
+}
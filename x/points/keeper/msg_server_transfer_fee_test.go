@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestTransferFee(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := f.ctx
+
+	alice, bob, shop := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	params := types.DefaultParams()
+	params.TransferFee = types.TransferFee{Rate: math.LegacyNewDecWithPrec(1, 2), Flat: 1, Min: 2, Max: 10, Exempt: []string{shop}}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	issuePoints(t, f, ctx, alice, 2000, nil)
+
+	balanceOf := func(address string) uint64 {
+		balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, address))
+		if err != nil {
+			return 0
+		}
+		return balance.Balance
+	}
+	treasury := authtypes.NewModuleAddress(types.TreasuryModuleName).String()
+
+	tests := []struct {
+		desc      string
+		recipient string
+		amount    uint64
+		fee       uint64
+	}{
+		{desc: "rate rounded up plus flat", recipient: bob, amount: 150, fee: 3},
+		{desc: "min", recipient: bob, amount: 10, fee: 2},
+		{desc: "max", recipient: bob, amount: 1000, fee: 10},
+		{desc: "exempt recipient", recipient: shop, amount: 100, fee: 0},
+	}
+	var collected uint64
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			sent, received := balanceOf(alice), balanceOf(tc.recipient)
+			_, err := ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, testProgramID, tc.recipient, tc.amount))
+			require.NoError(t, err)
+			collected += tc.fee
+
+			require.Equal(t, sent-tc.amount-tc.fee, balanceOf(alice))
+			require.Equal(t, received+tc.amount, balanceOf(tc.recipient))
+			require.Equal(t, collected, balanceOf(treasury))
+
+			id, err := f.keeper.TransactionSeq.Peek(ctx)
+			require.NoError(t, err)
+			tx, err := f.keeper.Transaction.Get(ctx, collections.Join(testProgramID, id-1))
+			require.NoError(t, err)
+			require.Equal(t, tc.fee, tx.Fee)
+			requireLastEvent(t, ctx, &types.EventPointsTransferred{
+				TransactionId:    id - 1,
+				Sender:           alice,
+				Recipient:        tc.recipient,
+				Amount:           tc.amount,
+				SenderBalance:    balanceOf(alice),
+				RecipientBalance: balanceOf(tc.recipient),
+				ProgramId:        testProgramID,
+				Fee:              tc.fee,
+			})
+		})
+	}
+
+	// the fee is charged on top of the amount
+	_, err := ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, testProgramID, bob, balanceOf(alice)))
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	res, err := qs.TreasuryBalance(ctx, &types.QueryTreasuryBalanceRequest{ProgramId: testProgramID})
+	require.NoError(t, err)
+	require.Equal(t, treasury, res.Address)
+	require.EqualValues(t, 15, res.Balance)
+}
+
+func TestWithdrawTreasuryPoints(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := f.ctx
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	params := types.DefaultParams()
+	params.TransferFee = types.TransferFee{Rate: math.LegacyZeroDec(), Flat: 5}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	issuePoints(t, f, ctx, alice, 100, nil)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, testProgramID, bob, 10))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, testProgramID, bob, 10))
+	require.NoError(t, err)
+
+	_, err = ms.WithdrawTreasuryPoints(ctx, types.NewMsgWithdrawTreasuryPoints(alice, testProgramID, bob, 5))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.WithdrawTreasuryPoints(ctx, types.NewMsgWithdrawTreasuryPoints(authorityStr, testProgramID, bob, 11))
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	res, err := ms.WithdrawTreasuryPoints(ctx, types.NewMsgWithdrawTreasuryPoints(authorityStr, testProgramID, bob, 8))
+	require.NoError(t, err)
+	requireLastEvent(t, ctx, &types.EventTreasuryPointsWithdrawn{TransactionId: res.TransactionId, ProgramId: testProgramID, Recipient: bob, Amount: 8, TreasuryBalance: 2})
+
+	balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, bob))
+	require.NoError(t, err)
+	require.EqualValues(t, 28, balance.Balance)
+
+	tx, err := f.keeper.Transaction.Get(ctx, collections.Join(testProgramID, res.TransactionId))
+	require.NoError(t, err)
+	require.Equal(t, authtypes.NewModuleAddress(types.TreasuryModuleName).String(), tx.Sender)
+	require.Equal(t, types.TransactionTypeTransfer, tx.TxType)
+}
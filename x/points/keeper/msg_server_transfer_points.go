@@ -5,8 +5,8 @@ import (
 
 	"scontract/x/points/types"
 
-	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,39 +14,72 @@ func (k msgServer) TransferPoints(ctx context.Context, msg *types.MsgTransferPoi
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
 		return nil, errorsmod.Wrap(err, "invalid authority address")
 	}
+	if msg.Amount == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidCoins, "amount must be positive")
+	}
+
+	note := transactionNote{Memo: msg.Memo, OrderReference: msg.OrderReference, Metadata: msg.Metadata}
+	if err := k.validateNote(ctx, note); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.getProgram(ctx, msg.ProgramId); err != nil {
+		return nil, err
+	}
+	if err := k.assertDebitAllowed(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.assertCreditAllowed(ctx, msg.Recipient); err != nil {
+		return nil, err
+	}
 
-	// 1. 보내는 사람 잔액 조회
-	senderBalance, err := k.PointBalance.Get(ctx, msg.Creator)
+	// 1. 전송 수수료 계산 (면제 주소가 보내거나 받으면 무료)
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		if errorsmod.IsOf(err, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(types.ErrInsufficientFunds, "sender balance not found")
-		}
 		return nil, err
 	}
+	fee, err := params.TransferFee.Fee(msg.Amount, msg.Creator, msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidTransferFee, err.Error())
+	}
 
-	// 2. 잔액 확인
-	if senderBalance.Balance < msg.Amount {
-		return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "insufficient funds")
+	// 2. 전송 한도 확인 (최근 24시간/7일 전송량, 수수료 포함)
+	total, err := addAmount(msg.Amount, fee)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.useVelocity(ctx, types.VelocityTransfer, msg.Creator, "", total); err != nil {
+		return nil, err
 	}
 
-	// 3. 받는 사람 잔액 조회 (없으면 0)
-	recipientBalance, err := k.PointBalance.Get(ctx, msg.Recipient)
+	// 3. 보내는 사람의 로트에서 전송액과 수수료 차감 (부족하면 에러)
+	senderBalance, taken, err := k.debitLots(ctx, msg.ProgramId, msg.Creator, msg.Amount)
 	if err != nil {
-		if !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if fee > 0 {
+		if senderBalance, _, err = k.debitLots(ctx, msg.ProgramId, msg.Creator, fee); err != nil {
 			return nil, err
 		}
-		recipientBalance = types.PointBalance{Address: msg.Recipient, Balance: 0}
 	}
 
-	// 4. 잔액 이동
-	senderBalance.Balance -= msg.Amount
-	recipientBalance.Balance += msg.Amount
-
-	// 5. 저장
-	if err := k.PointBalance.Set(ctx, msg.Creator, senderBalance); err != nil {
+	// 4. 받는 사람에게 같은 발행/만료 시각의 로트로 지급, 수수료는 트레저리에 적립
+	recipientBalance, err := k.creditLots(ctx, msg.ProgramId, msg.Recipient, taken)
+	if err != nil {
 		return nil, err
 	}
-	if err := k.PointBalance.Set(ctx, msg.Recipient, recipientBalance); err != nil {
+	if msg.Recipient == msg.Creator {
+		senderBalance = recipientBalance
+	}
+	if err := k.creditTreasury(ctx, msg.ProgramId, fee); err != nil {
+		return nil, err
+	}
+
+	// 5. 전송량 집계
+	if err := k.updateSupply(ctx, msg.ProgramId, func(supply *types.PointSupply) (err error) {
+		supply.Transferred, err = addAmount(supply.Transferred, msg.Amount)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 
@@ -55,18 +88,35 @@ func (k msgServer) TransferPoints(ctx context.Context, msg *types.MsgTransferPoi
 	if err != nil {
 		return nil, err
 	}
-	
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	tx := types.Transaction{
 		Id:        id,
+		ProgramId: msg.ProgramId,
 		Sender:    msg.Creator,
 		Recipient: msg.Recipient,
 		Amount:    msg.Amount,
-		TxType:    "transfer",
+		TxType:    types.TransactionTypeTransfer,
 		Timestamp: sdkCtx.BlockTime().Unix(),
+		Fee:       fee,
+	}
+	note.applyTo(&tx)
+	if err := k.Transaction.Set(ctx, collections.Join(msg.ProgramId, id), tx); err != nil {
+		return nil, err
 	}
-	if err := k.Transaction.Set(ctx, id, tx); err != nil {
+
+	// 7. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPointsTransferred{
+		TransactionId:    id,
+		Sender:           msg.Creator,
+		Recipient:        msg.Recipient,
+		Amount:           msg.Amount,
+		SenderBalance:    senderBalance.Balance,
+		RecipientBalance: recipientBalance.Balance,
+		ProgramId:        msg.ProgramId,
+		Fee:              fee,
+	}); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UnfreezeAccount(ctx context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	if err := k.assertComplianceOfficer(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	// 1. 동결 기록 삭제 (기한이 지난 기록도 삭제할 수 있음)
+	found, err := k.FrozenAccount.Has(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrAccountNotFrozen, "%s", msg.Address)
+	}
+	if err := k.FrozenAccount.Remove(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	// 2. 이벤트 발생
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAccountUnfrozen{
+		Address: msg.Address,
+		Actor:   msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
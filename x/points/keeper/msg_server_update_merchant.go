@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UpdateMerchant(ctx context.Context, msg *types.MsgUpdateMerchant) (*types.MsgUpdateMerchantResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.SettlementAddress); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "settlement address: %s", err)
+	}
+
+	// 1. 가맹점 조회 및 소유자 확인
+	merchant, err := k.getMerchant(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if merchant.Owner != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only the owner of merchant %d may update it", msg.Id)
+	}
+
+	// 2. 가맹점 정보 변경 (정산 주소가 바뀌면 다시 승인 필요)
+	if merchant.SettlementAddress != msg.SettlementAddress {
+		merchant.Active = false
+	}
+	merchant.Name = msg.Name
+	merchant.SettlementAddress = msg.SettlementAddress
+	if err := merchant.Validate(); err != nil {
+		return nil, err
+	}
+	if err := k.Merchant.Set(ctx, merchant.Id, merchant); err != nil {
+		return nil, err
+	}
+
+	// 3. 이벤트 발생
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMerchantUpdated{
+		MerchantId:        merchant.Id,
+		Name:              merchant.Name,
+		SettlementAddress: merchant.SettlementAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateMerchantResponse{}, nil
+}
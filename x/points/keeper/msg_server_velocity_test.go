@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+// setVelocityLimits replaces the velocity limits params.
+func setVelocityLimits(t *testing.T, f *fixture, ctx sdk.Context, limits types.VelocityLimits) {
+	t.Helper()
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.VelocityLimits = limits
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+}
+
+func TestSpendVelocityLimits(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+	setVelocityLimits(t, f, ctx, types.VelocityLimits{DailySpend: 50, WeeklySpend: 80})
+
+	spender := sample.AccAddress()
+	issuePoints(t, f, ctx, spender, 1000, nil)
+	merchantID := registerMerchant(t, f, ctx, sample.AccAddress())
+	spend := func(ctx sdk.Context, amount uint64) error {
+		_, err := ms.SpendPoints(ctx, types.NewMsgSpendPoints(spender, testProgramID, merchantID, amount, "coffee"))
+		return err
+	}
+
+	require.NoError(t, spend(ctx, 30))
+	require.ErrorIs(t, spend(ctx.WithBlockTime(ctx.BlockTime().Add(23*time.Hour)), 21), types.ErrVelocityLimitExceeded)
+	require.NoError(t, spend(ctx.WithBlockTime(ctx.BlockTime().Add(23*time.Hour)), 20))
+
+	// the first spend left the daily window, but the weekly one still counts both
+	day2 := ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	require.ErrorIs(t, spend(day2, 31), types.ErrVelocityLimitExceeded)
+	require.NoError(t, spend(day2, 30))
+	require.ErrorIs(t, spend(day2.WithBlockTime(day2.BlockTime().Add(48*time.Hour)), 1), types.ErrVelocityLimitExceeded)
+
+	// a week after the first spend its bucket is pruned
+	week := ctx.WithBlockTime(ctx.BlockTime().Add(7 * 24 * time.Hour))
+	require.NoError(t, spend(week, 30))
+	usage, err := f.keeper.ExportGenesis(week)
+	require.NoError(t, err)
+	for _, elem := range usage.VelocityUsageList {
+		if elem.Kind == types.VelocitySpend {
+			require.Greater(t, elem.Bucket, types.VelocityBucket(ctx.BlockTime().Unix()))
+		}
+	}
+}
+
+func TestTransferVelocityOverride(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+	setVelocityLimits(t, f, ctx, types.VelocityLimits{DailyTransfer: 10})
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	vip, other := sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, ctx, vip, 100, nil)
+	issuePoints(t, f, ctx, other, 100, nil)
+
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(other, testProgramID, vip, 11))
+	require.ErrorIs(t, err, types.ErrVelocityLimitExceeded)
+
+	_, err = ms.SetVelocityOverride(ctx, types.NewMsgSetVelocityOverride(authorityStr, vip, types.VelocityLimits{DailyTransfer: 40}))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(vip, testProgramID, other, 40))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(vip, testProgramID, other, 1))
+	require.ErrorIs(t, err, types.ErrVelocityLimitExceeded)
+
+	// back on the params, the transfers already made count against them
+	_, err = ms.RemoveVelocityOverride(ctx, types.NewMsgRemoveVelocityOverride(authorityStr, vip))
+	require.NoError(t, err)
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(23 * time.Hour))
+	_, err = ms.TransferPoints(later, types.NewMsgTransferPoints(vip, testProgramID, other, 1))
+	require.ErrorIs(t, err, types.ErrVelocityLimitExceeded)
+}
+
+func TestTransferVelocityCountsFee(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+	setVelocityLimits(t, f, ctx, types.VelocityLimits{DailyTransfer: 20})
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.TransferFee = types.TransferFee{Flat: 2}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, ctx, alice, 100, nil)
+
+	// 10 points and their fee of 2 leave 8 of the limit
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, testProgramID, bob, 10))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, testProgramID, bob, 7))
+	require.ErrorIs(t, err, types.ErrVelocityLimitExceeded)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, testProgramID, bob, 6))
+	require.NoError(t, err)
+}
+
+func TestIssueVelocityLimits(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+	setVelocityLimits(t, f, ctx, types.VelocityLimits{DailyIssuePerRecipient: 100})
+
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, ctx, alice, 100, nil)
+	issuePoints(t, f, ctx, bob, 100, nil)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(authorityStr, testProgramID, alice, 1, "bonus"))
+	require.ErrorIs(t, err, types.ErrVelocityLimitExceeded)
+
+	issuePoints(t, f, ctx.WithBlockTime(ctx.BlockTime().Add(24*time.Hour)), alice, 100, nil)
+}
+
+func TestMsgVelocityOverride(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	address := sample.AccAddress()
+	limits := types.VelocityLimits{DailySpend: 10, WeeklySpend: 50}
+
+	_, err = ms.SetVelocityOverride(f.ctx, types.NewMsgSetVelocityOverride(address, address, limits))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.SetVelocityOverride(f.ctx, types.NewMsgSetVelocityOverride(authorityStr, "invalid", limits))
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	_, err = ms.SetVelocityOverride(f.ctx, types.NewMsgSetVelocityOverride(authorityStr, address, types.VelocityLimits{DailySpend: 51, WeeklySpend: 50}))
+	require.ErrorIs(t, err, types.ErrInvalidVelocityLimits)
+
+	_, err = ms.SetVelocityOverride(f.ctx, types.NewMsgSetVelocityOverride(authorityStr, address, limits))
+	require.NoError(t, err)
+	override, err := f.keeper.VelocityOverride.Get(f.ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, types.VelocityOverride{Address: address, Limits: limits}, override)
+
+	_, err = ms.RemoveVelocityOverride(f.ctx, types.NewMsgRemoveVelocityOverride(address, address))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.RemoveVelocityOverride(f.ctx, types.NewMsgRemoveVelocityOverride(authorityStr, address))
+	require.NoError(t, err)
+	_, err = ms.RemoveVelocityOverride(f.ctx, types.NewMsgRemoveVelocityOverride(authorityStr, address))
+	require.ErrorIs(t, err, types.ErrVelocityOverrideNotFound)
+}
+
+func TestVelocityHeadroomQuery(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+	limits := types.VelocityLimits{DailySpend: 50, DailyTransfer: 20, DailyIssuePerRecipient: 200}
+	setVelocityLimits(t, f, ctx, limits)
+
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, ctx, alice, 150, nil)
+	_, err := ms.SpendPoints(ctx, types.NewMsgSpendPoints(alice, testProgramID, registerMerchant(t, f, ctx, bob), 50, "coffee"))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, testProgramID, bob, 5))
+	require.NoError(t, err)
+
+	res, err := qs.VelocityHeadroom(ctx, &types.QueryVelocityHeadroomRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, limits, res.Limits)
+	require.False(t, res.Overridden)
+	require.Equal(t, types.VelocityHeadroom{Limit: 50, Used: 50}, res.DailySpend)
+	require.Equal(t, types.VelocityHeadroom{Used: 50, Unlimited: true}, res.WeeklySpend)
+	require.Equal(t, types.VelocityHeadroom{Limit: 20, Used: 5, Remaining: 15}, res.DailyTransfer)
+	require.Nil(t, res.DailyIssue)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	res, err = qs.VelocityHeadroom(ctx, &types.QueryVelocityHeadroomRequest{Address: authorityStr, Recipient: alice})
+	require.NoError(t, err)
+	require.Equal(t, &types.VelocityHeadroom{Limit: 200, Used: 150, Remaining: 50}, res.DailyIssue)
+
+	// a day later the windows are empty again
+	res, err = qs.VelocityHeadroom(ctx.WithBlockTime(ctx.BlockTime().Add(24*time.Hour)), &types.QueryVelocityHeadroomRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, types.VelocityHeadroom{Limit: 50, Remaining: 50}, res.DailySpend)
+
+	_, err = qs.VelocityHeadroom(ctx, &types.QueryVelocityHeadroomRequest{Address: "invalid"})
+	require.Error(t, err)
+}
+
+func TestZeroAmountVelocity(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	user := sample.AccAddress()
+	issuePoints(t, f, ctx, user, 100, nil)
+	merchantID := registerMerchant(t, f, ctx, sample.AccAddress())
+
+	_, err := ms.SpendPoints(ctx, types.NewMsgSpendPoints(user, testProgramID, merchantID, 0, "coffee"))
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(user, testProgramID, sample.AccAddress(), 0))
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.IssuePoints(ctx, types.NewMsgIssuePoints(authorityStr, testProgramID, sample.AccAddress(), 0, "welcome"))
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+
+	// nothing moved is recorded, so the exported state stays valid
+	genesis, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, genesis.VelocityUsageList, 1)
+	require.NoError(t, genesis.Validate())
+}
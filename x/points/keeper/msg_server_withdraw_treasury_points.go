@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) WithdrawTreasuryPoints(ctx context.Context, msg *types.MsgWithdrawTreasuryPoints) (*types.MsgWithdrawTreasuryPointsResponse, error) {
+	if err := k.assertAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Recipient); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if msg.Amount == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+
+	if _, err := k.getProgram(ctx, msg.ProgramId); err != nil {
+		return nil, err
+	}
+	if err := k.assertCreditAllowed(ctx, msg.Recipient); err != nil {
+		return nil, err
+	}
+	treasury, err := k.treasuryAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 트레저리 로트에서 차감 후 받는 사람에게 지급 (부족하면 에러)
+	treasuryBalance, taken, err := k.debitLots(ctx, msg.ProgramId, treasury, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := k.creditLots(ctx, msg.ProgramId, msg.Recipient, taken); err != nil {
+		return nil, err
+	}
+
+	// 2. 전송량 집계
+	if err := k.updateSupply(ctx, msg.ProgramId, func(supply *types.PointSupply) (err error) {
+		supply.Transferred, err = addAmount(supply.Transferred, msg.Amount)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	// 3. 트레저리에서 보낸 전송으로 거래 기록
+	id, err := k.TransactionSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.Transaction.Set(ctx, collections.Join(msg.ProgramId, id), types.Transaction{
+		Id:        id,
+		ProgramId: msg.ProgramId,
+		Sender:    treasury,
+		Recipient: msg.Recipient,
+		Amount:    msg.Amount,
+		TxType:    types.TransactionTypeTransfer,
+		Timestamp: sdkCtx.BlockTime().Unix(),
+	}); err != nil {
+		return nil, err
+	}
+
+	// 4. 이벤트 발생
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventTreasuryPointsWithdrawn{
+		TransactionId:   id,
+		ProgramId:       msg.ProgramId,
+		Recipient:       msg.Recipient,
+		Amount:          msg.Amount,
+		TreasuryBalance: treasuryBalance.Balance,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawTreasuryPointsResponse{TransactionId: id}, nil
+}
@@ -1,28 +1,32 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
 
-	errorsmod "cosmossdk.io/errors"
-
 	"scontract/x/points/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	authority, err := k.addressCodec.StringToBytes(req.Authority)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "invalid authority address")
-	}
-
-	if !bytes.Equal(k.GetAuthority(), authority) {
-		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
-		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	if err := k.assertAuthority(req.Authority); err != nil {
+		return nil, err
 	}
 
 	if err := req.Params.Validate(); err != nil {
 		return nil, err
 	}
+	if epoch := req.Params.SettlementBatchEpoch; epoch != "" {
+		if _, err := k.epochsKeeper.GetEpochInfo(sdk.UnwrapSDKContext(ctx), epoch); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSettlementBatch, "unknown settlement batch epoch identifier %q", epoch)
+		}
+	}
+	if epoch := req.Params.BalanceSnapshotEpoch; epoch != "" {
+		if _, err := k.epochsKeeper.GetEpochInfo(sdk.UnwrapSDKContext(ctx), epoch); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidBalanceSnapshot, "unknown balance snapshot epoch identifier %q", epoch)
+		}
+	}
 
 	if err := k.Params.Set(ctx, req.Params); err != nil {
 		return nil, err
@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"scontract/x/points/keeper"
@@ -19,6 +21,21 @@ func TestMsgUpdateParams(t *testing.T) {
 	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
 	require.NoError(t, err)
 
+	zeroRateParams := types.DefaultParams()
+	zeroRateParams.SettlementRate = math.LegacyZeroDec()
+
+	zeroFeeRateParams := types.DefaultParams()
+	zeroFeeRateParams.FeePayment = types.FeePayment{Denom: "stake", PointsPerUnit: math.LegacyZeroDec()}
+
+	negativeRetentionParams := types.DefaultParams()
+	negativeRetentionParams.TransactionRetention.MaxAge = -time.Hour
+
+	unknownBatchEpochParams := types.DefaultParams()
+	unknownBatchEpochParams.SettlementBatchEpoch = "month"
+
+	unknownSnapshotEpochParams := types.DefaultParams()
+	unknownSnapshotEpochParams.BalanceSnapshotEpoch = "month"
+
 	// default params
 	testCases := []struct {
 		name      string
@@ -43,6 +60,51 @@ func TestMsgUpdateParams(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "zero settlement rate",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    zeroRateParams,
+			},
+			expErr:    true,
+			expErrMsg: "settlement rate must be positive",
+		},
+		{
+			name: "zero fee payment rate",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    zeroFeeRateParams,
+			},
+			expErr:    true,
+			expErrMsg: "fee payment rate must be positive",
+		},
+		{
+			name: "negative transaction retention",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    negativeRetentionParams,
+			},
+			expErr:    true,
+			expErrMsg: "transaction retention age cannot be negative",
+		},
+		{
+			name: "unknown settlement batch epoch",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    unknownBatchEpochParams,
+			},
+			expErr:    true,
+			expErrMsg: "unknown settlement batch epoch identifier",
+		},
+		{
+			name: "unknown balance snapshot epoch",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    unknownSnapshotEpochParams,
+			},
+			expErr:    true,
+			expErrMsg: "unknown balance snapshot epoch identifier",
+		},
 		{
 			name: "all good",
 			input: &types.MsgUpdateParams{
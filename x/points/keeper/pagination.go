@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// walkFunc visits values in id order, descending when reverse is set,
+// starting at start if given, until fn returns true or an error.
+type walkFunc[V any] func(reverse bool, start *uint64, fn func(value V) (stop bool, err error)) error
+
+// paginateIDs pages through the values visited by walk that pass include,
+// following the semantics of query.PageRequest. Pagination keys hold the big
+// endian id of the first value of the next page.
+func paginateIDs[V any](
+	pageReq *query.PageRequest,
+	walk walkFunc[V],
+	id func(V) uint64,
+	include func(V) bool,
+) ([]V, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	var start *uint64
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		startID := sdk.BigEndianToUint64(pageReq.Key)
+		start = &startID
+	}
+	countTotal := pageReq.CountTotal && start == nil
+
+	var (
+		results []V
+		nextKey []byte
+		matched uint64
+	)
+	err := walk(pageReq.Reverse, start, func(value V) (bool, error) {
+		if include != nil && !include(value) {
+			return false, nil
+		}
+
+		matched++
+		switch {
+		case matched <= pageReq.Offset:
+		case uint64(len(results)) < limit:
+			results = append(results, value)
+		case nextKey == nil:
+			nextKey = sdk.Uint64ToBigEndian(id(value))
+		}
+		return nextKey != nil && !countTotal, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = matched
+	}
+	return results, pageRes, nil
+}
+
+// walkIndexes returns a walkFunc over the values refKey maps to in any of
+// idxs, loading each value with get. The reference key is scoped to a single
+// program, whose id is its first part. A value several indexes map refKey to
+// is visited once.
+func walkIndexes[V any](
+	ctx context.Context,
+	idxs []*indexes.Multi[addressKey, programKey, V],
+	refKey addressKey,
+	get func(context.Context, programKey) (V, error),
+) walkFunc[V] {
+	programID := refKey.K1()
+	return func(reverse bool, start *uint64, fn func(value V) (bool, error)) error {
+		iters := make([]indexes.MultiIterator[addressKey, programKey], 0, len(idxs))
+		defer func() {
+			for _, iter := range iters {
+				iter.Close()
+			}
+		}()
+		for _, idx := range idxs {
+			rng := collections.NewPrefixedPairRange[addressKey, programKey](refKey)
+			if start != nil {
+				if reverse {
+					rng = rng.EndInclusive(collections.Join(programID, *start))
+				} else {
+					rng = rng.StartInclusive(collections.Join(programID, *start))
+				}
+			}
+			if reverse {
+				rng = rng.Descending()
+			}
+			iter, err := idx.Iterate(ctx, rng)
+			if err != nil {
+				return err
+			}
+			iters = append(iters, iter)
+		}
+
+		for {
+			// merge the index iterators, visiting the lowest (highest when
+			// reversed) id first
+			var (
+				next  uint64
+				found bool
+			)
+			for _, iter := range iters {
+				if !iter.Valid() {
+					continue
+				}
+				pk, err := iter.PrimaryKey()
+				if err != nil {
+					return err
+				}
+				id := pk.K2()
+				if !found || (reverse && id > next) || (!reverse && id < next) {
+					next, found = id, true
+				}
+			}
+			if !found {
+				return nil
+			}
+			for _, iter := range iters {
+				if !iter.Valid() {
+					continue
+				}
+				pk, err := iter.PrimaryKey()
+				if err != nil {
+					return err
+				}
+				if pk.K2() == next {
+					iter.Next()
+				}
+			}
+
+			value, err := get(ctx, collections.Join(programID, next))
+			if err != nil {
+				return err
+			}
+			if stop, err := fn(value); stop || err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"scontract/x/points/types"
+)
+
+// programKey is the primary key of a program's transactions and settlements:
+// the program id and the id of the record. Record ids are unique across
+// programs.
+type programKey = collections.Pair[string, uint64]
+
+var programKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
+
+// addressKey keys an address, or any other string, within a program.
+type addressKey = collections.Pair[string, string]
+
+var addressKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+
+// getProgram returns the program with the given id.
+func (k Keeper) getProgram(ctx context.Context, id string) (types.PointProgram, error) {
+	program, err := k.Program.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PointProgram{}, errorsmod.Wrapf(types.ErrProgramNotFound, "%q", id)
+		}
+		return types.PointProgram{}, err
+	}
+	return program, nil
+}
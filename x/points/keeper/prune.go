@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// PruneTransactions removes the transactions past the transaction retention
+// param, a batch per block. Each transaction is rolled up into the
+// checkpoints of its sender and recipient before it is removed, so that
+// statements keep their totals. Spends that are not fully refunded are kept
+// as refund records until they are.
+func (k Keeper) PruneTransactions(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	retention := params.TransactionRetention
+	if !retention.IsEnabled() {
+		return nil
+	}
+	nextID, err := k.TransactionSeq.Peek(ctx)
+	if err != nil {
+		return err
+	}
+
+	budget := types.PruneBatchSize
+	return k.Program.Walk(ctx, nil, func(programID string, _ types.PointProgram) (bool, error) {
+		pruned, err := k.pruneProgramTransactions(ctx, programID, retention, nextID, budget)
+		if err != nil {
+			return true, err
+		}
+		budget -= pruned
+		return budget <= 0, nil
+	})
+}
+
+// pruneProgramTransactions prunes up to limit of the oldest transactions of
+// a program, stopping at the first one still retained. It returns the number
+// of transactions pruned.
+func (k Keeper) pruneProgramTransactions(ctx context.Context, programID string, retention types.TransactionRetention, nextID uint64, limit int) (int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var due []types.Transaction
+	rng := collections.NewPrefixedPairRange[string, uint64](programID)
+	if err := k.Transaction.Walk(ctx, rng, func(_ programKey, tx types.Transaction) (bool, error) {
+		if !retention.Prunable(tx, sdkCtx.BlockTime(), nextID) {
+			return true, nil
+		}
+		due = append(due, tx)
+		return len(due) >= limit, nil
+	}); err != nil {
+		return 0, err
+	}
+	if len(due) == 0 {
+		return 0, nil
+	}
+
+	for _, tx := range due {
+		if err := k.checkpointTransaction(ctx, tx); err != nil {
+			return 0, err
+		}
+		key := collections.Join(tx.ProgramId, tx.Id)
+		if err := k.Transaction.Remove(ctx, key); err != nil {
+			return 0, err
+		}
+		if tx.IsRefundable() {
+			if err := k.RefundableSpend.Set(ctx, key, tx.RefundRecord()); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventTransactionsPruned{
+		ProgramId:         programID,
+		Count:             uint64(len(due)),
+		LastTransactionId: due[len(due)-1].Id,
+	}); err != nil {
+		return 0, err
+	}
+
+	return len(due), nil
+}
+
+// checkpointTransaction rolls tx up into the checkpoints of its parties.
+func (k Keeper) checkpointTransaction(ctx context.Context, tx types.Transaction) error {
+	for _, address := range tx.Parties() {
+		checkpoint, err := k.getTransactionCheckpoint(ctx, tx.ProgramId, address)
+		if err != nil {
+			return err
+		}
+		checkpoint.Add(tx, address)
+		if err := k.TransactionCheckpoint.Set(ctx, collections.Join(tx.ProgramId, address), checkpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTransactionCheckpoint returns the checkpoint of address in a program,
+// empty if none of its transactions were pruned yet.
+func (k Keeper) getTransactionCheckpoint(ctx context.Context, programID, address string) (types.TransactionCheckpoint, error) {
+	checkpoint, err := k.TransactionCheckpoint.Get(ctx, collections.Join(programID, address))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TransactionCheckpoint{ProgramId: programID, Address: address}, nil
+	}
+	return checkpoint, err
+}
+
+// ExportCompactGenesis is ExportGenesis without the transactions past the
+// transaction retention param: they are rolled up into the exported
+// checkpoints even if the EndBlocker has not pruned them yet.
+func (k Keeper) ExportCompactGenesis(ctx context.Context) (*types.GenesisState, error) {
+	genesis, err := k.ExportGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.CompactTransactions(sdk.UnwrapSDKContext(ctx).BlockTime())
+	return genesis, nil
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListBalanceSnapshot(ctx context.Context, req *types.QueryAllBalanceSnapshotRequest) (*types.QueryAllBalanceSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	snapshots, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.BalanceSnapshot,
+		req.Pagination,
+		func(_ uint64, value types.BalanceSnapshot) (types.BalanceSnapshot, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllBalanceSnapshotResponse{BalanceSnapshot: snapshots, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetBalanceSnapshot(ctx context.Context, req *types.QueryGetBalanceSnapshotRequest) (*types.QueryGetBalanceSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	snapshot, err := q.k.BalanceSnapshot.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetBalanceSnapshotResponse{BalanceSnapshot: snapshot}, nil
+}
+
+func (q queryServer) BalanceAt(ctx context.Context, req *types.QueryBalanceAtRequest) (*types.QueryBalanceAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+	if req.Timestamp < 0 {
+		return nil, status.Error(codes.InvalidArgument, "timestamp cannot be negative")
+	}
+
+	if req.Timestamp == 0 {
+		if _, err := q.k.BalanceSnapshot.Get(ctx, req.SnapshotId); err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil, sdkerrors.ErrKeyNotFound
+			}
+
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+
+		balance, err := q.k.snapshotBalanceAt(ctx, req.ProgramId, req.Address, req.SnapshotId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryBalanceAtResponse{Balance: balance, FromSnapshot: true, SnapshotId: req.SnapshotId}, nil
+	}
+
+	balance, snapshot, err := q.k.balanceAt(ctx, req.ProgramId, req.Address, req.Timestamp)
+	if err != nil {
+		if errors.Is(err, types.ErrHistoryPruned) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryBalanceAtResponse{Balance: balance}
+	if snapshot != nil {
+		res.FromSnapshot = true
+		res.SnapshotId = snapshot.Id
+	}
+	return res, nil
+}
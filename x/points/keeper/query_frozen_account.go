@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListFrozenAccount(ctx context.Context, req *types.QueryAllFrozenAccountRequest) (*types.QueryAllFrozenAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	frozenAccounts, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.FrozenAccount,
+		req.Pagination,
+		func(_ string, value types.FrozenAccount) (bool, error) {
+			return value.IsActive(blockTime), nil
+		},
+		func(_ string, value types.FrozenAccount) (types.FrozenAccount, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFrozenAccountResponse{FrozenAccount: frozenAccounts, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetFrozenAccount(ctx context.Context, req *types.QueryGetFrozenAccountRequest) (*types.QueryGetFrozenAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.FrozenAccount.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetFrozenAccountResponse{FrozenAccount: val}, nil
+}
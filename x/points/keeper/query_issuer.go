@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListIssuer(ctx context.Context, req *types.QueryAllIssuerRequest) (*types.QueryAllIssuerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	issuers, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Issuer,
+		req.Pagination,
+		func(_ string, value types.Issuer) (types.Issuer, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllIssuerResponse{Issuer: issuers, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetIssuer(ctx context.Context, req *types.QueryGetIssuerRequest) (*types.QueryGetIssuerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Issuer.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetIssuerResponse{Issuer: val}, nil
+}
+
+func (q queryServer) IssuerAllowance(ctx context.Context, req *types.QueryIssuerAllowanceRequest) (*types.QueryIssuerAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	issuer, err := q.k.Issuer.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	epoch, err := q.k.currentIssuerEpoch(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	issuer = rollIssuerEpoch(issuer, epoch)
+
+	res := &types.QueryIssuerAllowanceResponse{
+		EpochNumber: epoch,
+		EpochCap:    issuer.EpochCap,
+		Issued:      issuer.EpochIssued,
+		Unlimited:   issuer.EpochCap == 0,
+	}
+	if issuer.EpochCap > issuer.EpochIssued {
+		res.Remaining = issuer.EpochCap - issuer.EpochIssued
+	}
+
+	return res, nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func createNIssuer(keeper keeper.Keeper, ctx context.Context, n int) []types.Issuer {
+	items := make([]types.Issuer, n)
+	for i := range items {
+		items[i].Address = strconv.Itoa(i)
+		items[i].EpochCap = uint64(i)
+		_ = keeper.Issuer.Set(ctx, items[i].Address, items[i])
+	}
+	return items
+}
+
+func TestIssuerQuerySingle(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	msgs := createNIssuer(f.keeper, f.ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetIssuerRequest
+		response *types.QueryGetIssuerResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetIssuerRequest{Address: msgs[0].Address},
+			response: &types.QueryGetIssuerResponse{Issuer: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetIssuerRequest{Address: msgs[1].Address},
+			response: &types.QueryGetIssuerResponse{Issuer: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetIssuerRequest{Address: strconv.Itoa(100000)},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.GetIssuer(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.EqualExportedValues(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestIssuerQueryPaginated(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	msgs := createNIssuer(f.keeper, f.ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllIssuerRequest {
+		return &types.QueryAllIssuerRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.ListIssuer(f.ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Issuer), step)
+			require.Subset(t, msgs, resp.Issuer)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.ListIssuer(f.ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Issuer), step)
+			require.Subset(t, msgs, resp.Issuer)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := qs.ListIssuer(f.ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.EqualExportedValues(t, msgs, resp.Issuer)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.ListIssuer(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestIssuerAllowanceQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	epoch := types.DefaultParams().IssuerEpochNumber(blockTime)
+
+	require.NoError(t, f.keeper.Issuer.Set(ctx, "capped", types.Issuer{Address: "capped", EpochCap: 100, EpochNumber: epoch, EpochIssued: 30}))
+	require.NoError(t, f.keeper.Issuer.Set(ctx, "stale", types.Issuer{Address: "stale", EpochCap: 100, EpochNumber: epoch - 1, EpochIssued: 100}))
+	require.NoError(t, f.keeper.Issuer.Set(ctx, "uncapped", types.Issuer{Address: "uncapped", EpochNumber: epoch, EpochIssued: 30}))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryIssuerAllowanceRequest
+		response *types.QueryIssuerAllowanceResponse
+		err      error
+	}{
+		{
+			desc:     "Capped",
+			request:  &types.QueryIssuerAllowanceRequest{Address: "capped"},
+			response: &types.QueryIssuerAllowanceResponse{EpochNumber: epoch, EpochCap: 100, Issued: 30, Remaining: 70},
+		},
+		{
+			desc:     "PreviousEpoch",
+			request:  &types.QueryIssuerAllowanceRequest{Address: "stale"},
+			response: &types.QueryIssuerAllowanceResponse{EpochNumber: epoch, EpochCap: 100, Remaining: 100},
+		},
+		{
+			desc:     "Uncapped",
+			request:  &types.QueryIssuerAllowanceRequest{Address: "uncapped"},
+			response: &types.QueryIssuerAllowanceResponse{EpochNumber: epoch, Issued: 30, Unlimited: true},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryIssuerAllowanceRequest{Address: "missing"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.IssuerAllowance(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -16,14 +16,18 @@ func (q queryServer) ListPointBalance(ctx context.Context, req *types.QueryAllPo
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
 
 	pointBalances, pageRes, err := query.CollectionPaginate(
 		ctx,
 		q.k.PointBalance,
 		req.Pagination,
-		func(_ string, value types.PointBalance) (types.PointBalance, error) {
+		func(_ addressKey, value types.PointBalance) (types.PointBalance, error) {
 			return value, nil
 		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.ProgramId),
 	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -37,7 +41,7 @@ func (q queryServer) GetPointBalance(ctx context.Context, req *types.QueryGetPoi
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, err := q.k.PointBalance.Get(ctx, req.Index)
+	val, err := q.k.PointBalance.Get(ctx, collections.Join(req.ProgramId, req.Index))
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "not found")
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -18,9 +19,10 @@ func createNPointBalance(keeper keeper.Keeper, ctx context.Context, n int) []typ
 	items := make([]types.PointBalance, n)
 	for i := range items {
 		items[i].Index = strconv.Itoa(i)
+		items[i].ProgramId = testProgramID
 		items[i].Address = strconv.Itoa(i)
 		items[i].Balance = uint64(i)
-		_ = keeper.PointBalance.Set(ctx, items[i].Index, items[i])
+		_ = keeper.PointBalance.Set(ctx, collections.Join(testProgramID, items[i].Index), items[i])
 	}
 	return items
 }
@@ -38,21 +40,24 @@ func TestPointBalanceQuerySingle(t *testing.T) {
 		{
 			desc: "First",
 			request: &types.QueryGetPointBalanceRequest{
-				Index: msgs[0].Index,
+				ProgramId: testProgramID,
+				Index:     msgs[0].Index,
 			},
 			response: &types.QueryGetPointBalanceResponse{PointBalance: msgs[0]},
 		},
 		{
 			desc: "Second",
 			request: &types.QueryGetPointBalanceRequest{
-				Index: msgs[1].Index,
+				ProgramId: testProgramID,
+				Index:     msgs[1].Index,
 			},
 			response: &types.QueryGetPointBalanceResponse{PointBalance: msgs[1]},
 		},
 		{
 			desc: "KeyNotFound",
 			request: &types.QueryGetPointBalanceRequest{
-				Index: strconv.Itoa(100000),
+				ProgramId: testProgramID,
+				Index:     strconv.Itoa(100000),
 			},
 			err: status.Error(codes.NotFound, "not found"),
 		},
@@ -81,6 +86,7 @@ func TestPointBalanceQueryPaginated(t *testing.T) {
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllPointBalanceRequest {
 		return &types.QueryAllPointBalanceRequest{
+			ProgramId: testProgramID,
 			Pagination: &query.PageRequest{
 				Key:        next,
 				Offset:     offset,
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetPointGrant(ctx context.Context, req *types.QueryGetPointGrantRequest) (*types.QueryGetPointGrantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	grant, err := q.k.PointGrant.Get(ctx, collections.Join(req.ProgramId, req.Id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetPointGrantResponse{PointGrant: grant}, nil
+}
+
+func (q queryServer) ListActiveGrants(ctx context.Context, req *types.QueryActiveGrantsRequest) (*types.QueryActiveGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+	if req.Beneficiary == "" {
+		return nil, status.Error(codes.InvalidArgument, "beneficiary cannot be empty")
+	}
+
+	grants, pageRes, err := paginateIDs(
+		req.Pagination,
+		walkIndexes(ctx, []*indexes.Multi[addressKey, programKey, types.PointGrant]{q.k.PointGrant.Indexes.Beneficiary}, collections.Join(req.ProgramId, req.Beneficiary), q.k.PointGrant.Get),
+		func(grant types.PointGrant) uint64 { return grant.Id },
+		func(grant types.PointGrant) bool { return grant.Status == types.PointGrantStatusActive },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryActiveGrantsResponse{PointGrant: grants, Pagination: pageRes}, nil
+}
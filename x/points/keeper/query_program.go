@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListProgram(ctx context.Context, req *types.QueryAllProgramRequest) (*types.QueryAllProgramResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	programs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Program,
+		req.Pagination,
+		func(_ string, value types.PointProgram) (types.PointProgram, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllProgramResponse{Program: programs, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetProgram(ctx context.Context, req *types.QueryGetProgramRequest) (*types.QueryGetProgramResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Program.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetProgramResponse{Program: val}, nil
+}
@@ -17,14 +17,18 @@ func (q queryServer) ListSettlement(ctx context.Context, req *types.QueryAllSett
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
 
 	settlements, pageRes, err := query.CollectionPaginate(
 		ctx,
 		q.k.Settlement,
 		req.Pagination,
-		func(_ uint64, value types.Settlement) (types.Settlement, error) {
+		func(_ programKey, value types.Settlement) (types.Settlement, error) {
 			return value, nil
 		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.ProgramId),
 	)
 
 	if err != nil {
@@ -39,7 +43,7 @@ func (q queryServer) GetSettlement(ctx context.Context, req *types.QueryGetSettl
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	settlement, err := q.k.Settlement.Get(ctx, req.Id)
+	settlement, err := q.k.Settlement.Get(ctx, collections.Join(req.ProgramId, req.Id))
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, sdkerrors.ErrKeyNotFound
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetSettlementBatch(ctx context.Context, req *types.QueryGetSettlementBatchRequest) (*types.QueryGetSettlementBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	batch, err := q.k.SettlementBatch.Get(ctx, collections.Join(req.ProgramId, req.Id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	settlements := make([]types.Settlement, 0, len(batch.SettlementIds))
+	for _, id := range batch.SettlementIds {
+		settlement, err := q.k.Settlement.Get(ctx, collections.Join(batch.ProgramId, id))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		settlements = append(settlements, settlement)
+	}
+
+	return &types.QueryGetSettlementBatchResponse{SettlementBatch: batch, Settlements: settlements}, nil
+}
+
+func (q queryServer) SettlementBatchesByStatus(ctx context.Context, req *types.QuerySettlementBatchesByStatusRequest) (*types.QuerySettlementBatchesByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+	if !req.Status.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid settlement batch status %s", req.Status)
+	}
+
+	batches, pageRes, err := paginateIDs(
+		req.Pagination,
+		walkIndexes(ctx, []*indexes.Multi[addressKey, programKey, types.SettlementBatch]{q.k.SettlementBatch.Indexes.Status}, settlementBatchStatusKey(req.ProgramId, req.Status), q.k.SettlementBatch.Get),
+		func(batch types.SettlementBatch) uint64 { return batch.Id },
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySettlementBatchesByStatusResponse{SettlementBatch: batches, Pagination: pageRes}, nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func createIndexedSettlements(t *testing.T, f *fixture) {
+	t.Helper()
+
+	settlements := []types.Settlement{
+		{Id: 0, Requester: "alice", Amount: 100, Status: types.SettlementStatusPaid},
+		{Id: 1, Requester: "alice", Amount: 30, Status: types.SettlementStatusPending},
+		{Id: 2, Requester: "bob", Amount: 50, Status: types.SettlementStatusPending},
+		{Id: 3, Requester: "alice", Amount: 20, Status: types.SettlementStatusPending},
+		{Id: 4, Requester: "bob", Amount: 10, Status: types.SettlementStatusRejected},
+		// settlements of other programs are never returned
+		{Id: 5, ProgramId: "other", Requester: "alice", Amount: 70, Status: types.SettlementStatusPending},
+	}
+	for _, settlement := range settlements {
+		if settlement.ProgramId == "" {
+			settlement.ProgramId = testProgramID
+		}
+		require.NoError(t, f.keeper.Settlement.Set(f.ctx, collections.Join(settlement.ProgramId, settlement.Id), settlement))
+	}
+}
+
+func settlementIDs(settlements []types.Settlement) []uint64 {
+	ids := make([]uint64, 0, len(settlements))
+	for _, settlement := range settlements {
+		ids = append(ids, settlement.Id)
+	}
+	return ids
+}
+
+func TestSettlementsByRequester(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	createIndexedSettlements(t, f)
+
+	resp, err := qs.SettlementsByRequester(f.ctx, &types.QuerySettlementsByRequesterRequest{ProgramId: testProgramID, Requester: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 3}, settlementIDs(resp.Settlement))
+
+	resp, err = qs.SettlementsByRequester(f.ctx, &types.QuerySettlementsByRequesterRequest{
+		ProgramId:  testProgramID,
+		Requester:  "alice",
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 1}, settlementIDs(resp.Settlement))
+
+	resp, err = qs.SettlementsByRequester(f.ctx, &types.QuerySettlementsByRequesterRequest{
+		ProgramId:  testProgramID,
+		Requester:  "alice",
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0}, settlementIDs(resp.Settlement))
+	require.Nil(t, resp.Pagination.NextKey)
+
+	_, err = qs.SettlementsByRequester(f.ctx, &types.QuerySettlementsByRequesterRequest{ProgramId: testProgramID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSettlementsByStatus(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	createIndexedSettlements(t, f)
+
+	resp, err := qs.SettlementsByStatus(f.ctx, &types.QuerySettlementsByStatusRequest{
+		ProgramId:  testProgramID,
+		Status:     types.SettlementStatusPending,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, settlementIDs(resp.Settlement))
+	require.EqualValues(t, 3, resp.Pagination.Total)
+
+	// the status index follows status changes
+	settlement, err := f.keeper.Settlement.Get(f.ctx, collections.Join(testProgramID, uint64(2)))
+	require.NoError(t, err)
+	settlement.Status = types.SettlementStatusCancelled
+	require.NoError(t, f.keeper.Settlement.Set(f.ctx, collections.Join(testProgramID, settlement.Id), settlement))
+
+	resp, err = qs.SettlementsByStatus(f.ctx, &types.QuerySettlementsByStatusRequest{ProgramId: testProgramID, Status: types.SettlementStatusPending})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3}, settlementIDs(resp.Settlement))
+
+	_, err = qs.SettlementsByStatus(f.ctx, &types.QuerySettlementsByStatusRequest{ProgramId: testProgramID, Status: types.SettlementStatusUnspecified})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSettlementSummary(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	createIndexedSettlements(t, f)
+
+	resp, err := qs.SettlementSummary(f.ctx, &types.QuerySettlementSummaryRequest{ProgramId: testProgramID})
+	require.NoError(t, err)
+	require.Equal(t, &types.QuerySettlementSummaryResponse{PendingCount: 3, PendingAmount: 100}, resp)
+
+	resp, err = qs.SettlementSummary(f.ctx, &types.QuerySettlementSummaryRequest{ProgramId: testProgramID, Requester: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QuerySettlementSummaryResponse{PendingCount: 2, PendingAmount: 50}, resp)
+}
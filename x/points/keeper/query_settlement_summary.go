@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) SettlementSummary(ctx context.Context, req *types.QuerySettlementSummaryRequest) (*types.QuerySettlementSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+
+	// 요청자가 지정되면 요청자 인덱스를, 아니면 상태 인덱스를 순회
+	idx, refKey := q.k.Settlement.Indexes.Status, settlementStatusKey(req.ProgramId, types.SettlementStatusPending)
+	if req.Requester != "" {
+		idx, refKey = q.k.Settlement.Indexes.Requester, collections.Join(req.ProgramId, req.Requester)
+	}
+
+	var resp types.QuerySettlementSummaryResponse
+	walk := walkIndexes(ctx, []*indexes.Multi[addressKey, programKey, types.Settlement]{idx}, refKey, q.k.Settlement.Get)
+	if err := walk(false, nil, func(settlement types.Settlement) (bool, error) {
+		if settlement.Status == types.SettlementStatusPending {
+			resp.PendingCount++
+			resp.PendingAmount += settlement.Amount
+		}
+		return false, nil
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &resp, nil
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/collections"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
@@ -20,11 +21,12 @@ func createNSettlement(keeper keeper.Keeper, ctx context.Context, n int) []types
 	for i := range items {
 		iu := uint64(i)
 		items[i].Id = iu
+		items[i].ProgramId = testProgramID
 		items[i].Requester = strconv.Itoa(i)
 		items[i].Amount = uint64(i)
-		items[i].Status = strconv.Itoa(i)
+		items[i].Status = types.SettlementStatus(i%5 + 1)
 		items[i].Timestamp = int64(i)
-		_ = keeper.Settlement.Set(ctx, iu, items[i])
+		_ = keeper.Settlement.Set(ctx, collections.Join(testProgramID, iu), items[i])
 		_ = keeper.SettlementSeq.Set(ctx, iu)
 	}
 	return items
@@ -42,17 +44,17 @@ func TestSettlementQuerySingle(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetSettlementRequest{Id: msgs[0].Id},
+			request:  &types.QueryGetSettlementRequest{ProgramId: testProgramID, Id: msgs[0].Id},
 			response: &types.QueryGetSettlementResponse{Settlement: msgs[0]},
 		},
 		{
 			desc:     "Second",
-			request:  &types.QueryGetSettlementRequest{Id: msgs[1].Id},
+			request:  &types.QueryGetSettlementRequest{ProgramId: testProgramID, Id: msgs[1].Id},
 			response: &types.QueryGetSettlementResponse{Settlement: msgs[1]},
 		},
 		{
 			desc:    "KeyNotFound",
-			request: &types.QueryGetSettlementRequest{Id: uint64(len(msgs))},
+			request: &types.QueryGetSettlementRequest{ProgramId: testProgramID, Id: uint64(len(msgs))},
 			err:     sdkerrors.ErrKeyNotFound,
 		},
 		{
@@ -80,6 +82,7 @@ func TestSettlementQueryPaginated(t *testing.T) {
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllSettlementRequest {
 		return &types.QueryAllSettlementRequest{
+			ProgramId: testProgramID,
 			Pagination: &query.PageRequest{
 				Key:        next,
 				Offset:     offset,
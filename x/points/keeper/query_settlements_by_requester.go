@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) SettlementsByRequester(ctx context.Context, req *types.QuerySettlementsByRequesterRequest) (*types.QuerySettlementsByRequesterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+	if req.Requester == "" {
+		return nil, status.Error(codes.InvalidArgument, "requester cannot be empty")
+	}
+
+	settlements, pageRes, err := paginateIDs(
+		req.Pagination,
+		walkIndexes(ctx, []*indexes.Multi[addressKey, programKey, types.Settlement]{q.k.Settlement.Indexes.Requester}, collections.Join(req.ProgramId, req.Requester), q.k.Settlement.Get),
+		func(settlement types.Settlement) uint64 { return settlement.Id },
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySettlementsByRequesterResponse{Settlement: settlements, Pagination: pageRes}, nil
+}
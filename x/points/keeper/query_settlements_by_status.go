@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections/indexes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) SettlementsByStatus(ctx context.Context, req *types.QuerySettlementsByStatusRequest) (*types.QuerySettlementsByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+	if !req.Status.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid settlement status %s", req.Status)
+	}
+
+	settlements, pageRes, err := paginateIDs(
+		req.Pagination,
+		walkIndexes(ctx, []*indexes.Multi[addressKey, programKey, types.Settlement]{q.k.Settlement.Indexes.Status}, settlementStatusKey(req.ProgramId, req.Status), q.k.Settlement.Get),
+		func(settlement types.Settlement) uint64 { return settlement.Id },
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySettlementsByStatusResponse{Settlement: settlements, Pagination: pageRes}, nil
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"scontract/x/points/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Statement(ctx context.Context, req *types.QueryStatementRequest) (*types.QueryStatementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+	if req.From < 0 {
+		return nil, status.Error(codes.InvalidArgument, "from cannot be negative")
+	}
+	if req.To != 0 && req.To <= req.From {
+		return nil, status.Error(codes.InvalidArgument, "to must be after from")
+	}
+	if req.Pagination != nil && req.Pagination.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "statement lines cannot be listed in reverse")
+	}
+
+	// the opening balance counts every change made before from
+	opening, snapshot, err := q.k.balanceAt(ctx, req.ProgramId, req.Address, req.From-1)
+	if err != nil {
+		if errors.Is(err, types.ErrHistoryPruned) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// transactions before the snapshot all precede from
+	var fromID uint64
+	if snapshot != nil {
+		fromID = snapshot.NextTransactionId
+	}
+
+	// settlements are not transactions, so their movements are merged into
+	// the running balance by time
+	movements, err := q.k.settlementMovements(ctx, req.ProgramId, req.Address, req.From, req.To)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryStatementResponse{OpeningBalance: opening}
+	balance := opening
+	// applyMovements applies the pending settlement movements up to timestamp:
+	// refunds made at or before it and requests made before it.
+	applyMovements := func(timestamp int64, all bool) error {
+		for len(movements) > 0 {
+			m := movements[0]
+			if !all && (m.timestamp > timestamp || (m.timestamp == timestamp && !m.refund)) {
+				break
+			}
+			if m.refund {
+				balance += m.amount
+				res.SettlementRefunded += m.amount
+			} else {
+				if m.amount > balance {
+					return errorsmod.Wrapf(sdkerrors.ErrLogic, "statement balance of %s is negative", req.Address)
+				}
+				balance -= m.amount
+				res.SettlementRequested += m.amount
+			}
+			movements = movements[1:]
+		}
+		return nil
+	}
+
+	// every transaction of the range is walked, oldest first, so that the
+	// running balances and totals also count the ones outside of the page
+	totals := make(map[types.TransactionType]*types.StatementTotal)
+	walkTransactions := q.k.walkAddressTransactions(ctx, req.ProgramId, req.Address, types.TransactionDirectionUnspecified)
+	walk := func(_ bool, start *uint64, fn func(types.StatementLine) (bool, error)) error {
+		paging := true
+		return walkTransactions(false, &fromID, func(tx types.Transaction) (bool, error) {
+			if tx.Timestamp < req.From {
+				return false, nil
+			}
+			if req.To != 0 && tx.Timestamp >= req.To {
+				return true, nil
+			}
+			if err := applyMovements(tx.Timestamp, false); err != nil {
+				return true, err
+			}
+
+			line := types.StatementLine{Transaction: tx}
+			line.Credit, line.Debit = tx.BalanceChange(req.Address)
+			if line.Debit > balance+line.Credit {
+				return true, errorsmod.Wrapf(sdkerrors.ErrLogic, "statement balance of %s is negative at transaction %d", req.Address, tx.Id)
+			}
+			balance = balance + line.Credit - line.Debit
+			line.Balance = balance
+
+			total, ok := totals[tx.TxType]
+			if !ok {
+				total = &types.StatementTotal{TxType: tx.TxType}
+				totals[tx.TxType] = total
+			}
+			total.Count++
+			total.Credit += line.Credit
+			total.Debit += line.Debit
+
+			if paging && (start == nil || tx.Id >= *start) {
+				stop, err := fn(line)
+				if err != nil {
+					return true, err
+				}
+				paging = !stop
+			}
+			return false, nil
+		})
+	}
+
+	lines, pageRes, err := paginateIDs(
+		req.Pagination,
+		walk,
+		func(line types.StatementLine) uint64 { return line.Transaction.Id },
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyMovements(0, true); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Lines = lines
+	res.ClosingBalance = balance
+	res.Pagination = pageRes
+	for _, total := range totals {
+		res.Totals = append(res.Totals, *total)
+	}
+	sort.Slice(res.Totals, func(i, j int) bool { return res.Totals[i].TxType < res.Totals[j].TxType })
+
+	return res, nil
+}
+
+// settlementMovement is a change a settlement made to its requester's
+// balance: the request taking points out, or the refund of a rejected or
+// cancelled settlement putting them back.
+type settlementMovement struct {
+	timestamp int64
+	amount    uint64
+	refund    bool
+}
+
+// settlementMovements returns the movements of the settlements address
+// requested in a program made at block times in [from, to), in time order
+// with the refunds of a block first. A zero to is unbounded.
+func (k Keeper) settlementMovements(ctx context.Context, programID, address string, from, to int64) ([]settlementMovement, error) {
+	inRange := func(timestamp int64) bool {
+		return timestamp >= from && (to == 0 || timestamp < to)
+	}
+
+	var movements []settlementMovement
+	idxs := []*indexes.Multi[addressKey, programKey, types.Settlement]{k.Settlement.Indexes.Requester}
+	walk := walkIndexes(ctx, idxs, collections.Join(programID, address), k.Settlement.Get)
+	if err := walk(false, nil, func(settlement types.Settlement) (bool, error) {
+		if inRange(settlement.Timestamp) {
+			movements = append(movements, settlementMovement{timestamp: settlement.Timestamp, amount: settlement.Amount})
+		}
+		switch settlement.Status {
+		case types.SettlementStatusRejected, types.SettlementStatusCancelled:
+			if inRange(settlement.DecidedAt) {
+				movements = append(movements, settlementMovement{timestamp: settlement.DecidedAt, amount: settlement.Amount, refund: true})
+			}
+		}
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(movements, func(i, j int) bool {
+		if movements[i].timestamp != movements[j].timestamp {
+			return movements[i].timestamp < movements[j].timestamp
+		}
+		return movements[i].refund && !movements[j].refund
+	})
+	return movements, nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestStatement(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	blockTime := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	at := func(hours int) sdk.Context {
+		return ctx.WithBlockTime(blockTime.Add(time.Duration(hours) * time.Hour))
+	}
+	unix := func(hours int) int64 { return at(hours).BlockTime().Unix() }
+
+	issuer, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	merchantID := registerMerchant(t, f, ctx, sample.AccAddress())
+
+	params := types.DefaultParams()
+	params.TransferFee = types.TransferFee{Flat: 2}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	// transactions 0 and 1
+	issuePoints(t, f, at(1), issuer, 40, nil)
+	issuePoints(t, f, at(1), alice, 100, nil)
+	// transactions 2 and 3, of another program, are never listed
+	_, err = ms.CreateProgram(at(1), types.NewMsgCreateProgram(alice, "cafe", "Cafe", 0, []string{issuer}))
+	require.NoError(t, err)
+	_, err = ms.IssuePoints(at(1), types.NewMsgIssuePoints(issuer, "cafe", alice, 10, "welcome"))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(at(1), types.NewMsgTransferPoints(alice, "cafe", bob, 7))
+	require.NoError(t, err)
+	// transaction 4
+	_, err = ms.TransferPoints(at(2), types.NewMsgTransferPoints(alice, testProgramID, bob, 30))
+	require.NoError(t, err)
+	_, err = ms.RequestSettlement(at(3), types.NewMsgRequestSettlement(alice, testProgramID, 20))
+	require.NoError(t, err)
+	// transaction 5
+	_, err = ms.SpendPoints(at(4), types.NewMsgSpendPoints(alice, testProgramID, merchantID, 20, "coffee"))
+	require.NoError(t, err)
+	_, err = ms.CancelSettlement(at(5), types.NewMsgCancelSettlement(alice, testProgramID, 0))
+	require.NoError(t, err)
+	// transactions 6 and 7
+	_, err = ms.TransferPoints(at(6), types.NewMsgTransferPoints(bob, testProgramID, alice, 10))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(at(7), types.NewMsgTransferPoints(alice, testProgramID, alice, 5))
+	require.NoError(t, err)
+	_, err = ms.RequestSettlement(at(8), types.NewMsgRequestSettlement(alice, testProgramID, 8))
+	require.NoError(t, err)
+
+	type line struct {
+		id, credit, debit, balance uint64
+	}
+	lines := func(resp *types.QueryStatementResponse) []line {
+		out := make([]line, 0, len(resp.Lines))
+		for _, l := range resp.Lines {
+			out = append(out, line{l.Transaction.Id, l.Credit, l.Debit, l.Balance})
+		}
+		return out
+	}
+	allTotals := []types.StatementTotal{
+		{TxType: types.TransactionTypeSpend, Count: 1, Debit: 20},
+		{TxType: types.TransactionTypeTransfer, Count: 3, Credit: 15, Debit: 39},
+	}
+
+	t.Run("range", func(t *testing.T) {
+		resp, err := qs.Statement(ctx, &types.QueryStatementRequest{ProgramId: testProgramID, Address: alice, From: unix(2)})
+		require.NoError(t, err)
+		require.EqualValues(t, 100, resp.OpeningBalance)
+		// the settlement requested before the spend is counted before it, and
+		// its refund before the transfer received after it
+		require.Equal(t, []line{{4, 0, 32, 68}, {5, 0, 20, 28}, {6, 10, 0, 58}, {7, 5, 7, 56}}, lines(resp))
+		require.Equal(t, allTotals, resp.Totals)
+		require.EqualValues(t, 28, resp.SettlementRequested)
+		require.EqualValues(t, 20, resp.SettlementRefunded)
+		require.EqualValues(t, 48, resp.ClosingBalance)
+	})
+
+	t.Run("bounded range", func(t *testing.T) {
+		resp, err := qs.Statement(ctx, &types.QueryStatementRequest{ProgramId: testProgramID, Address: alice, From: unix(2), To: unix(6)})
+		require.NoError(t, err)
+		require.Equal(t, []line{{4, 0, 32, 68}, {5, 0, 20, 28}}, lines(resp))
+		require.EqualValues(t, 20, resp.SettlementRefunded)
+		require.EqualValues(t, 48, resp.ClosingBalance)
+
+		next, err := qs.Statement(ctx, &types.QueryStatementRequest{ProgramId: testProgramID, Address: alice, From: unix(6)})
+		require.NoError(t, err)
+		require.Equal(t, resp.ClosingBalance, next.OpeningBalance)
+	})
+
+	t.Run("from the start", func(t *testing.T) {
+		resp, err := qs.Statement(ctx, &types.QueryStatementRequest{ProgramId: testProgramID, Address: alice})
+		require.NoError(t, err)
+		require.Zero(t, resp.OpeningBalance)
+		require.Equal(t, line{1, 100, 0, 100}, lines(resp)[0])
+		require.Len(t, resp.Lines, 5)
+		require.EqualValues(t, 48, resp.ClosingBalance)
+	})
+
+	t.Run("issuer", func(t *testing.T) {
+		// issuing to others lists the issuance without moving the issuer's
+		// own balance
+		resp, err := qs.Statement(ctx, &types.QueryStatementRequest{ProgramId: testProgramID, Address: issuer})
+		require.NoError(t, err)
+		require.Equal(t, []line{{0, 40, 0, 40}, {1, 0, 0, 40}}, lines(resp))
+		require.Equal(t, []types.StatementTotal{{TxType: types.TransactionTypeIssue, Count: 2, Credit: 40}}, resp.Totals)
+		require.EqualValues(t, 40, resp.ClosingBalance)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		var got []line
+		var key []byte
+		for {
+			resp, err := qs.Statement(ctx, &types.QueryStatementRequest{ProgramId: testProgramID, Address: alice, From: unix(2), Pagination: &query.PageRequest{Key: key, Limit: 3}})
+			require.NoError(t, err)
+			require.Equal(t, allTotals, resp.Totals)
+			require.EqualValues(t, 48, resp.ClosingBalance)
+			got = append(got, lines(resp)...)
+			if resp.Pagination.NextKey == nil {
+				break
+			}
+			key = resp.Pagination.NextKey
+		}
+		require.Equal(t, []line{{4, 0, 32, 68}, {5, 0, 20, 28}, {6, 10, 0, 58}, {7, 5, 7, 56}}, got)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, req := range []*types.QueryStatementRequest{
+			{ProgramId: testProgramID},
+			{ProgramId: testProgramID, Address: alice, From: unix(2), To: unix(2)},
+			{ProgramId: testProgramID, Address: alice, Pagination: &query.PageRequest{Reverse: true}},
+		} {
+			_, err := qs.Statement(ctx, req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("pruned history", func(t *testing.T) {
+		params.TransactionRetention = types.TransactionRetention{MaxCount: 2}
+		require.NoError(t, f.keeper.Params.Set(ctx, params))
+		require.NoError(t, f.keeper.PruneTransactions(ctx))
+
+		_, err := qs.Statement(ctx, &types.QueryStatementRequest{ProgramId: testProgramID, Address: bob, From: unix(2)})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
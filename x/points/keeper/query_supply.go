@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Supply(ctx context.Context, req *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+
+	supply, err := q.k.getSupply(ctx, req.ProgramId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	circulating, _ := supply.Circulating()
+
+	return &types.QuerySupplyResponse{Supply: supply, Circulating: circulating}, nil
+}
@@ -17,14 +17,32 @@ func (q queryServer) ListTransaction(ctx context.Context, req *types.QueryAllTra
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+
+	if req.OrderReference != "" {
+		transactions, pageRes, err := paginateIDs(
+			req.Pagination,
+			q.k.walkOrderTransactions(ctx, req.ProgramId, req.OrderReference),
+			func(tx types.Transaction) uint64 { return tx.Id },
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryAllTransactionResponse{Transaction: transactions, Pagination: pageRes}, nil
+	}
 
 	transactions, pageRes, err := query.CollectionPaginate(
 		ctx,
 		q.k.Transaction,
 		req.Pagination,
-		func(_ uint64, value types.Transaction) (types.Transaction, error) {
+		func(_ programKey, value types.Transaction) (types.Transaction, error) {
 			return value, nil
 		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.ProgramId),
 	)
 
 	if err != nil {
@@ -39,7 +57,7 @@ func (q queryServer) GetTransaction(ctx context.Context, req *types.QueryGetTran
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	transaction, err := q.k.Transaction.Get(ctx, req.Id)
+	transaction, err := q.k.Transaction.Get(ctx, collections.Join(req.ProgramId, req.Id))
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, sdkerrors.ErrKeyNotFound
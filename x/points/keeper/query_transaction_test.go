@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/collections"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
@@ -20,12 +21,13 @@ func createNTransaction(keeper keeper.Keeper, ctx context.Context, n int) []type
 	for i := range items {
 		iu := uint64(i)
 		items[i].Id = iu
+		items[i].ProgramId = testProgramID
 		items[i].Sender = strconv.Itoa(i)
 		items[i].Recipient = strconv.Itoa(i)
 		items[i].Amount = uint64(i)
-		items[i].TxType = strconv.Itoa(i)
+		items[i].TxType = types.TransactionType(i%7 + 1)
 		items[i].Timestamp = int64(i)
-		_ = keeper.Transaction.Set(ctx, iu, items[i])
+		_ = keeper.Transaction.Set(ctx, collections.Join(testProgramID, iu), items[i])
 		_ = keeper.TransactionSeq.Set(ctx, iu)
 	}
 	return items
@@ -43,17 +45,17 @@ func TestTransactionQuerySingle(t *testing.T) {
 	}{
 		{
 			desc:     "First",
-			request:  &types.QueryGetTransactionRequest{Id: msgs[0].Id},
+			request:  &types.QueryGetTransactionRequest{ProgramId: testProgramID, Id: msgs[0].Id},
 			response: &types.QueryGetTransactionResponse{Transaction: msgs[0]},
 		},
 		{
 			desc:     "Second",
-			request:  &types.QueryGetTransactionRequest{Id: msgs[1].Id},
+			request:  &types.QueryGetTransactionRequest{ProgramId: testProgramID, Id: msgs[1].Id},
 			response: &types.QueryGetTransactionResponse{Transaction: msgs[1]},
 		},
 		{
 			desc:    "KeyNotFound",
-			request: &types.QueryGetTransactionRequest{Id: uint64(len(msgs))},
+			request: &types.QueryGetTransactionRequest{ProgramId: testProgramID, Id: uint64(len(msgs))},
 			err:     sdkerrors.ErrKeyNotFound,
 		},
 		{
@@ -81,6 +83,7 @@ func TestTransactionQueryPaginated(t *testing.T) {
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllTransactionRequest {
 		return &types.QueryAllTransactionRequest{
+			ProgramId: testProgramID,
 			Pagination: &query.PageRequest{
 				Key:        next,
 				Offset:     offset,
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListTransactionsByAddress(ctx context.Context, req *types.QueryTransactionsByAddressRequest) (*types.QueryTransactionsByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+	if req.EndTime != 0 && req.EndTime < req.StartTime {
+		return nil, status.Error(codes.InvalidArgument, "end time is before start time")
+	}
+
+	transactions, pageRes, err := paginateIDs(
+		req.Pagination,
+		q.k.walkAddressTransactions(ctx, req.ProgramId, req.Address, req.Direction),
+		func(tx types.Transaction) uint64 { return tx.Id },
+		func(tx types.Transaction) bool { return transactionMatches(tx, req) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, err := q.k.getTransactionCheckpoint(ctx, req.ProgramId, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryTransactionsByAddressResponse{Transaction: transactions, Pagination: pageRes, Checkpoint: checkpoint}, nil
+}
+
+// transactionMatches reports whether tx passes the type and time filters of req.
+func transactionMatches(tx types.Transaction, req *types.QueryTransactionsByAddressRequest) bool {
+	if req.TxType != types.TransactionTypeUnspecified && tx.TxType != req.TxType {
+		return false
+	}
+	if req.StartTime != 0 && tx.Timestamp < req.StartTime {
+		return false
+	}
+	if req.EndTime != 0 && tx.Timestamp >= req.EndTime {
+		return false
+	}
+	return true
+}
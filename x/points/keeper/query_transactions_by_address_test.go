@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestListTransactionsByAddress(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	txs := []types.Transaction{
+		{Id: 0, Sender: "issuer", Recipient: "alice", Amount: 100, TxType: types.TransactionTypeIssue, Timestamp: 10},
+		{Id: 1, Sender: "alice", Recipient: "bob", Amount: 30, TxType: types.TransactionTypeTransfer, Timestamp: 20},
+		{Id: 2, Sender: "bob", Recipient: "carol", Amount: 10, TxType: types.TransactionTypeTransfer, Timestamp: 30},
+		{Id: 3, Sender: "alice", Recipient: "MERCHANT", Amount: 20, TxType: types.TransactionTypeSpend, Timestamp: 40},
+		{Id: 4, Sender: "alice", Recipient: "alice", Amount: 5, TxType: types.TransactionTypeTransfer, Timestamp: 50},
+		// transactions of other programs are never returned
+		{Id: 5, ProgramId: "other", Sender: "alice", Recipient: "bob", Amount: 7, TxType: types.TransactionTypeTransfer, Timestamp: 60},
+	}
+	for _, tx := range txs {
+		if tx.ProgramId == "" {
+			tx.ProgramId = testProgramID
+		}
+		require.NoError(t, f.keeper.Transaction.Set(f.ctx, collections.Join(tx.ProgramId, tx.Id), tx))
+	}
+
+	ids := func(resp *types.QueryTransactionsByAddressResponse) []uint64 {
+		out := make([]uint64, 0, len(resp.Transaction))
+		for _, tx := range resp.Transaction {
+			out = append(out, tx.Id)
+		}
+		return out
+	}
+
+	tests := []struct {
+		desc    string
+		request *types.QueryTransactionsByAddressRequest
+		ids     []uint64
+	}{
+		{
+			desc:    "all directions",
+			request: &types.QueryTransactionsByAddressRequest{ProgramId: testProgramID, Address: "alice"},
+			ids:     []uint64{0, 1, 3, 4},
+		},
+		{
+			desc:    "sent",
+			request: &types.QueryTransactionsByAddressRequest{ProgramId: testProgramID, Address: "alice", Direction: types.TransactionDirectionSent},
+			ids:     []uint64{1, 3, 4},
+		},
+		{
+			desc:    "received",
+			request: &types.QueryTransactionsByAddressRequest{ProgramId: testProgramID, Address: "alice", Direction: types.TransactionDirectionReceived},
+			ids:     []uint64{0, 4},
+		},
+		{
+			desc:    "tx type",
+			request: &types.QueryTransactionsByAddressRequest{ProgramId: testProgramID, Address: "alice", TxType: types.TransactionTypeTransfer},
+			ids:     []uint64{1, 4},
+		},
+		{
+			desc:    "time range",
+			request: &types.QueryTransactionsByAddressRequest{ProgramId: testProgramID, Address: "alice", StartTime: 20, EndTime: 50},
+			ids:     []uint64{1, 3},
+		},
+		{
+			desc:    "reverse",
+			request: &types.QueryTransactionsByAddressRequest{ProgramId: testProgramID, Address: "alice", Pagination: &query.PageRequest{Reverse: true}},
+			ids:     []uint64{4, 3, 1, 0},
+		},
+		{
+			desc:    "unknown address",
+			request: &types.QueryTransactionsByAddressRequest{ProgramId: testProgramID, Address: "dave"},
+			ids:     []uint64{},
+		},
+		{
+			desc:    "other program",
+			request: &types.QueryTransactionsByAddressRequest{ProgramId: "other", Address: "alice"},
+			ids:     []uint64{5},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := qs.ListTransactionsByAddress(f.ctx, tc.request)
+			require.NoError(t, err)
+			require.Equal(t, tc.ids, ids(resp))
+		})
+	}
+
+	t.Run("paginated", func(t *testing.T) {
+		for _, reverse := range []bool{false, true} {
+			var (
+				got  []uint64
+				next []byte
+			)
+			for {
+				resp, err := qs.ListTransactionsByAddress(f.ctx, &types.QueryTransactionsByAddressRequest{
+					ProgramId:  testProgramID,
+					Address:    "alice",
+					Pagination: &query.PageRequest{Key: next, Limit: 3, Reverse: reverse},
+				})
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(resp.Transaction), 3)
+				got = append(got, ids(resp)...)
+				next = resp.Pagination.NextKey
+				if next == nil {
+					break
+				}
+			}
+			if reverse {
+				require.Equal(t, []uint64{4, 3, 1, 0}, got)
+			} else {
+				require.Equal(t, []uint64{0, 1, 3, 4}, got)
+			}
+		}
+
+		resp, err := qs.ListTransactionsByAddress(f.ctx, &types.QueryTransactionsByAddressRequest{
+			ProgramId:  testProgramID,
+			Address:    "alice",
+			Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 3}, ids(resp))
+		require.EqualValues(t, 4, resp.Pagination.Total)
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.ListTransactionsByAddress(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
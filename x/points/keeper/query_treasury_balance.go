@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) TreasuryBalance(ctx context.Context, req *types.QueryTreasuryBalanceRequest) (*types.QueryTreasuryBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+
+	treasury, err := q.k.treasuryAddress()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	balance, err := q.k.getBalance(ctx, req.ProgramId, treasury)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryTreasuryBalanceResponse{Address: treasury, Balance: balance.Balance}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"scontract/x/points/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) UpcomingExpirations(ctx context.Context, req *types.QueryUpcomingExpirationsRequest) (*types.QueryUpcomingExpirationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProgramId == "" {
+		return nil, status.Error(codes.InvalidArgument, "program id cannot be empty")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	// 만료 예정인 로트만 수집 (만료되지 않는 로트와 이미 만료된 로트는 제외)
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	var resp types.QueryUpcomingExpirationsResponse
+	if err := q.k.PointLot.Walk(ctx, ownerLotsRange(req.ProgramId, req.Address), func(_ lotKey, lot types.PointLot) (bool, error) {
+		if lot.ExpiresAt == 0 || isExpired(lot, blockTime) {
+			return false, nil
+		}
+		if req.Until != 0 && lot.ExpiresAt > req.Until {
+			return false, nil
+		}
+		resp.Lots = append(resp.Lots, lot)
+		resp.Total += lot.Amount
+		return false, nil
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// 가장 먼저 만료되는 순으로 정렬
+	sort.SliceStable(resp.Lots, func(i, j int) bool { return resp.Lots[i].ExpiresAt < resp.Lots[j].ExpiresAt })
+
+	return &resp, nil
+}
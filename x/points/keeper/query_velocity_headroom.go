@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"scontract/x/points/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) VelocityHeadroom(ctx context.Context, req *types.QueryVelocityHeadroomRequest) (*types.QueryVelocityHeadroomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := q.k.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	limits, overridden, err := q.k.velocityLimits(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	bucket := types.VelocityBucket(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+
+	res := &types.QueryVelocityHeadroomResponse{Limits: limits, Overridden: overridden}
+
+	dailySpent, weeklySpent, err := q.k.velocityUsed(ctx, types.VelocitySpend, req.Address, "", bucket)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	res.DailySpend = newVelocityHeadroom(limits.DailySpend, dailySpent)
+	res.WeeklySpend = newVelocityHeadroom(limits.WeeklySpend, weeklySpent)
+
+	dailyTransferred, weeklyTransferred, err := q.k.velocityUsed(ctx, types.VelocityTransfer, req.Address, "", bucket)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	res.DailyTransfer = newVelocityHeadroom(limits.DailyTransfer, dailyTransferred)
+	res.WeeklyTransfer = newVelocityHeadroom(limits.WeeklyTransfer, weeklyTransferred)
+
+	if req.Recipient != "" {
+		dailyIssued, _, err := q.k.velocityUsed(ctx, types.VelocityIssue, req.Address, req.Recipient, bucket)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		issue := newVelocityHeadroom(limits.DailyIssuePerRecipient, dailyIssued)
+		res.DailyIssue = &issue
+	}
+
+	return res, nil
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// SettlementIndexes are the secondary indexes of the Settlement map.
+type SettlementIndexes struct {
+	// Requester indexes settlements by program and requester address.
+	Requester *indexes.Multi[addressKey, programKey, types.Settlement]
+	// Status indexes settlements by program and status.
+	Status *indexes.Multi[addressKey, programKey, types.Settlement]
+}
+
+func newSettlementIndexes(sb *collections.SchemaBuilder) SettlementIndexes {
+	return SettlementIndexes{
+		Requester: indexes.NewMulti(
+			sb, types.SettlementByRequesterKey, "settlementByRequester",
+			addressKeyCodec, programKeyCodec,
+			func(_ programKey, settlement types.Settlement) (addressKey, error) {
+				return collections.Join(settlement.ProgramId, settlement.Requester), nil
+			},
+		),
+		Status: indexes.NewMulti(
+			sb, types.SettlementByStatusKey, "settlementByStatus",
+			addressKeyCodec, programKeyCodec,
+			func(_ programKey, settlement types.Settlement) (addressKey, error) {
+				return settlementStatusKey(settlement.ProgramId, settlement.Status), nil
+			},
+		),
+	}
+}
+
+// settlementStatusKey is the Status index key of the settlements of a program
+// in status. Statuses are keyed by their enum name.
+func settlementStatusKey(programID string, status types.SettlementStatus) addressKey {
+	return collections.Join(programID, status.String())
+}
+
+// IndexesList implements collections.Indexes.
+func (i SettlementIndexes) IndexesList() []collections.Index[programKey, types.Settlement] {
+	return []collections.Index[programKey, types.Settlement]{i.Requester, i.Status}
+}
+
+// getSettlement returns the settlement of a program with the given id.
+func (k Keeper) getSettlement(ctx context.Context, programID string, id uint64) (types.Settlement, error) {
+	settlement, err := k.Settlement.Get(ctx, collections.Join(programID, id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Settlement{}, errorsmod.Wrapf(types.ErrSettlementNotFound, "program %q id %d", programID, id)
+		}
+		return types.Settlement{}, err
+	}
+	return settlement, nil
+}
+
+// assertSettler returns an error unless address is the module authority or a
+// settler listed in the params.
+func (k Keeper) assertSettler(ctx context.Context, address string) error {
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if bytes.Equal(addr, k.GetAuthority()) {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.IsSettler(address) {
+		return errorsmod.Wrapf(types.ErrUnauthorizedSettler, "%s", address)
+	}
+	return nil
+}
+
+// decideSettlement moves a settlement to status, recording who made the
+// decision and when. The points of rejected and cancelled settlements are
+// refunded to the requester as the lots they were taken from, so refunds do
+// not extend their expiry.
+func (k Keeper) decideSettlement(ctx context.Context, settlement types.Settlement, status types.SettlementStatus, decidedBy string) (types.Settlement, error) {
+	from := settlement.Status
+	if err := types.ValidateSettlementTransition(from, status); err != nil {
+		return settlement, err
+	}
+
+	settlement.Status = status
+	settlement.DecidedBy = decidedBy
+	settlement.DecidedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if err := k.Settlement.Set(ctx, collections.Join(settlement.ProgramId, settlement.Id), settlement); err != nil {
+		return settlement, err
+	}
+
+	var requesterBalance types.PointBalance
+	var err error
+	switch status {
+	case types.SettlementStatusRejected, types.SettlementStatusCancelled:
+		requesterBalance, err = k.refundSettlement(ctx, settlement)
+	default:
+		requesterBalance, err = k.getBalance(ctx, settlement.ProgramId, settlement.Requester)
+	}
+	if err != nil {
+		return settlement, err
+	}
+
+	return settlement, k.emitSettlementStatusChanged(ctx, settlement, from, decidedBy, requesterBalance.Balance)
+}
+
+// refundSettlement returns the escrowed lots of settlement to its requester
+// and puts the points back into circulation. Settlements requested before lots were tracked have no escrowed lots and are
+// refunded as a single lot that never expires.
+func (k Keeper) refundSettlement(ctx context.Context, settlement types.Settlement) (types.PointBalance, error) {
+	if err := k.updateSupply(ctx, settlement.ProgramId, func(supply *types.PointSupply) error {
+		supply.Settled -= settlement.Amount
+		return nil
+	}); err != nil {
+		return types.PointBalance{}, err
+	}
+	if len(settlement.EscrowedLots) == 0 {
+		issuedAt := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+		return k.creditLot(ctx, settlement.ProgramId, settlement.Requester, settlement.Amount, issuedAt, 0)
+	}
+	return k.creditLots(ctx, settlement.ProgramId, settlement.Requester, settlement.EscrowedLots)
+}
+
+// paySettlement pays an approved settlement out of the treasury at the
+// current settlement rate. It fails while payouts are disabled, as nothing
+// would ever pay the settlement later.
+func (k Keeper) paySettlement(ctx context.Context, settlement types.Settlement) (types.Settlement, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return settlement, err
+	}
+
+	payout, ok := params.SettlementPayout(settlement.Amount)
+	if !ok {
+		return settlement, errorsmod.Wrapf(types.ErrPayoutsDisabled, "settlement %d cannot be paid", settlement.Id)
+	}
+	if err := types.ValidateSettlementTransition(settlement.Status, types.SettlementStatusPaid); err != nil {
+		return settlement, err
+	}
+
+	requester, err := k.addressCodec.StringToBytes(settlement.Requester)
+	if err != nil {
+		return settlement, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if payout.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.TreasuryModuleName, requester, sdk.NewCoins(payout)); err != nil {
+			return settlement, errorsmod.Wrap(err, "failed to pay out settlement from treasury")
+		}
+	}
+
+	from := settlement.Status
+	settlement.Status = types.SettlementStatusPaid
+	settlement.Payout = &payout
+	if err := k.Settlement.Set(ctx, collections.Join(settlement.ProgramId, settlement.Id), settlement); err != nil {
+		return settlement, err
+	}
+
+	requesterBalance, err := k.getBalance(ctx, settlement.ProgramId, settlement.Requester)
+	if err != nil {
+		return settlement, err
+	}
+
+	return settlement, k.emitSettlementStatusChanged(ctx, settlement, from, settlement.DecidedBy, requesterBalance.Balance)
+}
+
+// emitSettlementStatusChanged emits an EventSettlementStatusChanged for a
+// settlement that just moved out of status from.
+func (k Keeper) emitSettlementStatusChanged(ctx context.Context, settlement types.Settlement, from types.SettlementStatus, actor string, requesterBalance uint64) error {
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventSettlementStatusChanged{
+		SettlementId:     settlement.Id,
+		Requester:        settlement.Requester,
+		Amount:           settlement.Amount,
+		FromStatus:       from,
+		ToStatus:         settlement.Status,
+		Actor:            actor,
+		RequesterBalance: requesterBalance,
+		Payout:           settlement.Payout,
+		ProgramId:        settlement.ProgramId,
+	})
+}
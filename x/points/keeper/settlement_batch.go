@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// SettlementBatchIndexes are the secondary indexes of the SettlementBatch map.
+type SettlementBatchIndexes struct {
+	// Status indexes batches by program and status.
+	Status *indexes.Multi[addressKey, programKey, types.SettlementBatch]
+}
+
+func newSettlementBatchIndexes(sb *collections.SchemaBuilder) SettlementBatchIndexes {
+	return SettlementBatchIndexes{
+		Status: indexes.NewMulti(
+			sb, types.SettlementBatchByStatusKey, "settlementBatchByStatus",
+			addressKeyCodec, programKeyCodec,
+			func(_ programKey, batch types.SettlementBatch) (addressKey, error) {
+				return settlementBatchStatusKey(batch.ProgramId, batch.Status), nil
+			},
+		),
+	}
+}
+
+// settlementBatchStatusKey is the Status index key of the batches of a
+// program in status. Statuses are keyed by their enum name.
+func settlementBatchStatusKey(programID string, status types.SettlementBatchStatus) addressKey {
+	return collections.Join(programID, status.String())
+}
+
+// IndexesList implements collections.Indexes.
+func (i SettlementBatchIndexes) IndexesList() []collections.Index[programKey, types.SettlementBatch] {
+	return []collections.Index[programKey, types.SettlementBatch]{i.Status}
+}
+
+// getSettlementBatch returns the settlement batch of a program with the given id.
+func (k Keeper) getSettlementBatch(ctx context.Context, programID string, id uint64) (types.SettlementBatch, error) {
+	batch, err := k.SettlementBatch.Get(ctx, collections.Join(programID, id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.SettlementBatch{}, errorsmod.Wrapf(types.ErrSettlementBatchNotFound, "program %q id %d", programID, id)
+		}
+		return types.SettlementBatch{}, err
+	}
+	return batch, nil
+}
+
+// batchSettlements groups the pending settlements of every program into one
+// batch per requester, at the end of epochNumber of identifier.
+func (k Keeper) batchSettlements(ctx context.Context, identifier string, epochNumber int64) error {
+	var programIDs []string
+	if err := k.Program.Walk(ctx, nil, func(id string, _ types.PointProgram) (bool, error) {
+		programIDs = append(programIDs, id)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, programID := range programIDs {
+		// settlements are walked in ascending id order, so that each batch
+		// lists its settlement ids in order
+		pending := make(map[string][]types.Settlement)
+		rng := collections.NewPrefixedPairRange[addressKey, programKey](settlementStatusKey(programID, types.SettlementStatusPending))
+		var keys []programKey
+		if err := k.Settlement.Indexes.Status.Walk(ctx, rng, func(_ addressKey, key programKey) (bool, error) {
+			keys = append(keys, key)
+			return false, nil
+		}); err != nil {
+			return err
+		}
+		for _, key := range keys {
+			settlement, err := k.Settlement.Get(ctx, key)
+			if err != nil {
+				return err
+			}
+			pending[settlement.Requester] = append(pending[settlement.Requester], settlement)
+		}
+
+		requesters := make([]string, 0, len(pending))
+		for requester := range pending {
+			requesters = append(requesters, requester)
+		}
+		sort.Strings(requesters)
+		for _, requester := range requesters {
+			if err := k.createSettlementBatch(ctx, programID, requester, pending[requester], identifier, epochNumber); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// createSettlementBatch moves the pending settlements of a requester into a
+// new batch.
+func (k Keeper) createSettlementBatch(ctx context.Context, programID, requester string, settlements []types.Settlement, identifier string, epochNumber int64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	id, err := k.SettlementBatchSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	requesterBalance, err := k.getBalance(ctx, programID, requester)
+	if err != nil {
+		return err
+	}
+
+	// 1. 대기 중인 정산을 묶음 상태로 변경
+	batch := types.SettlementBatch{
+		Id:              id,
+		ProgramId:       programID,
+		Requester:       requester,
+		EpochIdentifier: identifier,
+		EpochNumber:     epochNumber,
+		CreatedAt:       sdkCtx.BlockTime().Unix(),
+		Status:          types.SettlementBatchStatusPending,
+	}
+	for _, settlement := range settlements {
+		if err := types.ValidateSettlementTransition(settlement.Status, types.SettlementStatusBatched); err != nil {
+			return err
+		}
+		from := settlement.Status
+		settlement.Status = types.SettlementStatusBatched
+		if err := k.Settlement.Set(ctx, collections.Join(programID, settlement.Id), settlement); err != nil {
+			return err
+		}
+		if err := k.emitSettlementStatusChanged(ctx, settlement, from, "", requesterBalance.Balance); err != nil {
+			return err
+		}
+
+		batch.SettlementIds = append(batch.SettlementIds, settlement.Id)
+		batch.TotalAmount += settlement.Amount
+	}
+
+	// 2. 정산 ID의 머클 루트와 함께 묶음 저장
+	batch.MerkleRoot = types.SettlementBatchRoot(batch.SettlementIds)
+	if err := k.SettlementBatch.Set(ctx, collections.Join(programID, id), batch); err != nil {
+		return err
+	}
+
+	// 3. 이벤트 발생
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventSettlementBatchCreated{
+		BatchId:         id,
+		ProgramId:       programID,
+		Requester:       requester,
+		EpochIdentifier: identifier,
+		EpochNumber:     epochNumber,
+		SettlementCount: uint64(len(batch.SettlementIds)),
+		TotalAmount:     batch.TotalAmount,
+		MerkleRoot:      batch.MerkleRoot,
+	})
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/bits"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"scontract/x/points/types"
+)
+
+// getSupply returns the supply counters of a program, all zero if nothing
+// was recorded yet.
+func (k Keeper) getSupply(ctx context.Context, programID string) (types.PointSupply, error) {
+	supply, err := k.Supply.Get(ctx, programID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.PointSupply{ProgramId: programID}, nil
+	}
+	return supply, err
+}
+
+// updateSupply applies update to the supply counters of a program. Nothing
+// is stored if update fails.
+func (k Keeper) updateSupply(ctx context.Context, programID string, update func(*types.PointSupply) error) error {
+	supply, err := k.getSupply(ctx, programID)
+	if err != nil {
+		return err
+	}
+	if err := update(&supply); err != nil {
+		return err
+	}
+	return k.Supply.Set(ctx, programID, supply)
+}
+
+// addAmount returns a+b, or ErrAmountOverflow if the sum does not fit in a
+// uint64. Balances and supply counters are increased through it.
+func addAmount(a, b uint64) (uint64, error) {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return 0, errorsmod.Wrapf(types.ErrAmountOverflow, "%d + %d", a, b)
+	}
+	return sum, nil
+}
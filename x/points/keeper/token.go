@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// programCoins returns amount points of a program as bank coins.
+func programCoins(programID string, amount uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(types.ProgramDenom(programID), sdkmath.NewIntFromUint64(amount)))
+}
+
+// ensureDenomMetadata registers the bank metadata of a program's denom the
+// first time its points are tokenized.
+func (k Keeper) ensureDenomMetadata(ctx context.Context, program types.PointProgram) {
+	if k.bankKeeper.HasDenomMetaData(ctx, types.ProgramDenom(program.Id)) {
+		return
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, types.ProgramDenomMetadata(program))
+}
+
+// poolTokenizedLots adds the lots taken from a holder to tokenize them to the
+// tokenized lot pool of a program. Parts of the same lot are merged.
+func (k Keeper) poolTokenizedLots(ctx context.Context, programID string, lots []types.PointLot) error {
+	for _, part := range lots {
+		part.ProgramId = programID
+		part.Owner = ""
+		key := newLotKey(part)
+		pooled, err := k.TokenizedLot.Get(ctx, key)
+		if err == nil {
+			if part.Amount, err = addAmount(pooled.Amount, part.Amount); err != nil {
+				return err
+			}
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err := k.TokenizedLot.Set(ctx, key, part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// takeTokenizedLots takes amount points from the tokenized lot pool of a
+// program, oldest first, and returns the part taken from each lot. Lots that
+// expired while tokenized are taken too; crediting them lets the expiry sweep
+// expire them.
+func (k Keeper) takeTokenizedLots(ctx context.Context, programID string, amount uint64) ([]types.PointLot, error) {
+	var (
+		taken     []types.PointLot
+		remaining = amount
+	)
+	if err := k.TokenizedLot.Walk(ctx, ownerLotsRange(programID, ""), func(_ lotKey, lot types.PointLot) (bool, error) {
+		part := lot
+		part.Amount = min(lot.Amount, remaining)
+		taken = append(taken, part)
+		remaining -= part.Amount
+		return remaining == 0, nil
+	}); err != nil {
+		return nil, err
+	}
+	if remaining > 0 {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "program %q has %d tokenized points but needed %d", programID, amount-remaining, amount)
+	}
+
+	for _, part := range taken {
+		key := newLotKey(part)
+		lot, err := k.TokenizedLot.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		lot.Amount -= part.Amount
+		if lot.Amount == 0 {
+			err = k.TokenizedLot.Remove(ctx, key)
+		} else {
+			err = k.TokenizedLot.Set(ctx, key, lot)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return taken, nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+
+	"scontract/x/points/types"
+)
+
+// TransactionIndexes are the secondary indexes of the Transaction map.
+type TransactionIndexes struct {
+	// Sender indexes transactions by program and sender address.
+	Sender *indexes.Multi[addressKey, programKey, types.Transaction]
+	// Recipient indexes transactions by program and recipient address.
+	Recipient *indexes.Multi[addressKey, programKey, types.Transaction]
+	// OrderReference indexes transactions by program and order reference.
+	// Transactions without an order reference are not indexed.
+	OrderReference orderReferenceIndex
+}
+
+// orderReferenceIndex is a Multi index of transactions by order reference
+// that leaves out the transactions without one.
+type orderReferenceIndex struct {
+	*indexes.Multi[addressKey, programKey, types.Transaction]
+}
+
+// Reference implements collections.Index.
+func (i orderReferenceIndex) Reference(ctx context.Context, pk programKey, newValue types.Transaction, lazyOldValue func() (types.Transaction, error)) error {
+	if newValue.OrderReference != "" {
+		return i.Multi.Reference(ctx, pk, newValue, lazyOldValue)
+	}
+	// only the entry of the old value, if any, is left to remove
+	err := i.Multi.Unreference(ctx, pk, lazyOldValue)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func newTransactionIndexes(sb *collections.SchemaBuilder) TransactionIndexes {
+	return TransactionIndexes{
+		Sender: indexes.NewMulti(
+			sb, types.TransactionBySenderKey, "transactionBySender",
+			addressKeyCodec, programKeyCodec,
+			func(_ programKey, tx types.Transaction) (addressKey, error) {
+				return collections.Join(tx.ProgramId, tx.Sender), nil
+			},
+		),
+		Recipient: indexes.NewMulti(
+			sb, types.TransactionByRecipientKey, "transactionByRecipient",
+			addressKeyCodec, programKeyCodec,
+			func(_ programKey, tx types.Transaction) (addressKey, error) {
+				return collections.Join(tx.ProgramId, tx.Recipient), nil
+			},
+		),
+		OrderReference: orderReferenceIndex{indexes.NewMulti(
+			sb, types.TransactionByOrderRefKey, "transactionByOrderReference",
+			addressKeyCodec, programKeyCodec,
+			func(_ programKey, tx types.Transaction) (addressKey, error) {
+				return collections.Join(tx.ProgramId, tx.OrderReference), nil
+			},
+		)},
+	}
+}
+
+// IndexesList implements collections.Indexes.
+func (i TransactionIndexes) IndexesList() []collections.Index[programKey, types.Transaction] {
+	return []collections.Index[programKey, types.Transaction]{i.Sender, i.Recipient, i.OrderReference}
+}
+
+// walkAddressTransactions returns a walkFunc over the transactions of a
+// program address took part in on the side selected by direction.
+func (k Keeper) walkAddressTransactions(ctx context.Context, programID, address string, direction types.TransactionDirection) walkFunc[types.Transaction] {
+	var idxs []*indexes.Multi[addressKey, programKey, types.Transaction]
+	switch direction {
+	case types.TransactionDirectionSent:
+		idxs = append(idxs, k.Transaction.Indexes.Sender)
+	case types.TransactionDirectionReceived:
+		idxs = append(idxs, k.Transaction.Indexes.Recipient)
+	default:
+		idxs = append(idxs, k.Transaction.Indexes.Sender, k.Transaction.Indexes.Recipient)
+	}
+	return walkIndexes(ctx, idxs, collections.Join(programID, address), k.Transaction.Get)
+}
+
+// walkOrderTransactions returns a walkFunc over the transactions of a
+// program recorded with orderReference.
+func (k Keeper) walkOrderTransactions(ctx context.Context, programID, orderReference string) walkFunc[types.Transaction] {
+	idxs := []*indexes.Multi[addressKey, programKey, types.Transaction]{k.Transaction.Indexes.OrderReference.Multi}
+	return walkIndexes(ctx, idxs, collections.Join(programID, orderReference), k.Transaction.Get)
+}
+
+// transactionNote is the data a user attaches to a transaction.
+type transactionNote struct {
+	Memo           string
+	OrderReference string
+	Metadata       []types.TransactionMetadata
+}
+
+// validateNote checks note against the metadata limits param.
+func (k Keeper) validateNote(ctx context.Context, note transactionNote) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := params.MetadataLimits.Validate(note.Memo, note.OrderReference, note.Metadata); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+	return nil
+}
+
+// applyTo records the note on tx.
+func (n transactionNote) applyTo(tx *types.Transaction) {
+	tx.Memo = n.Memo
+	tx.OrderReference = n.OrderReference
+	tx.Metadata = n.Metadata
+}
@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+func TestPruneTransactions(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	owner, friend := sample.AccAddress(), sample.AccAddress()
+	issuer, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	issuePoints(t, f, ctx, owner, 10, nil)
+	_, err = ms.TransferPoints(ctx.WithBlockTime(blockTime.Add(time.Hour)), types.NewMsgTransferPoints(owner, testProgramID, friend, 4))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(blockTime.Add(48 * time.Hour))
+	issuePoints(t, f, ctx, owner, 5, nil)
+
+	// the default retention keeps every transaction
+	require.NoError(t, f.keeper.PruneTransactions(ctx))
+	for id := uint64(0); id < 3; id++ {
+		has, err := f.keeper.Transaction.Has(ctx, collections.Join(testProgramID, id))
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+
+	params := types.DefaultParams()
+	params.TransactionRetention.MaxAge = 24 * time.Hour
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, f.keeper.PruneTransactions(ctx))
+	requireLastEvent(t, ctx, &types.EventTransactionsPruned{ProgramId: testProgramID, Count: 2, LastTransactionId: 1})
+
+	for id, kept := range []bool{false, false, true} {
+		has, err := f.keeper.Transaction.Has(ctx, collections.Join(testProgramID, uint64(id)))
+		require.NoError(t, err)
+		require.Equal(t, kept, has)
+	}
+
+	checkpoint, err := f.keeper.TransactionCheckpoint.Get(ctx, collections.Join(testProgramID, owner))
+	require.NoError(t, err)
+	require.Equal(t, types.TransactionCheckpoint{
+		ProgramId:         testProgramID,
+		Address:           owner,
+		TransactionCount:  2,
+		Sent:              4,
+		Received:          10,
+		LastTransactionId: 1,
+		LastTimestamp:     blockTime.Add(time.Hour).Unix(),
+	}, checkpoint)
+	checkpoint, err = f.keeper.TransactionCheckpoint.Get(ctx, collections.Join(testProgramID, issuer))
+	require.NoError(t, err)
+	require.EqualValues(t, 10, checkpoint.Sent)
+
+	// statements carry the checkpoint next to the retained transactions
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	res, err := qs.ListTransactionsByAddress(ctx, &types.QueryTransactionsByAddressRequest{ProgramId: testProgramID, Address: friend})
+	require.NoError(t, err)
+	require.Empty(t, res.Transaction)
+	require.EqualValues(t, 4, res.Checkpoint.Received)
+	res, err = qs.ListTransactionsByAddress(ctx, &types.QueryTransactionsByAddressRequest{ProgramId: testProgramID, Address: sample.AccAddress()})
+	require.NoError(t, err)
+	require.Zero(t, res.Checkpoint.TransactionCount)
+
+	// a pruned transaction can no longer be looked up
+	_, err = qs.GetTransaction(ctx, &types.QueryGetTransactionRequest{ProgramId: testProgramID, Id: 0})
+	require.Error(t, err)
+
+	// by count, only the newest transactions are kept
+	params.TransactionRetention = types.TransactionRetention{MaxCount: 1}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, f.keeper.PruneTransactions(ctx))
+	has, err := f.keeper.Transaction.Has(ctx, collections.Join(testProgramID, uint64(2)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	issuePoints(t, f, ctx, owner, 1, nil)
+	require.NoError(t, f.keeper.PruneTransactions(ctx))
+	has, err = f.keeper.Transaction.Has(ctx, collections.Join(testProgramID, uint64(2)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	checkpoint, err = f.keeper.TransactionCheckpoint.Get(ctx, collections.Join(testProgramID, owner))
+	require.NoError(t, err)
+	require.EqualValues(t, 3, checkpoint.TransactionCount)
+	require.EqualValues(t, 15, checkpoint.Received)
+
+	genesis, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, genesis.TransactionList, 1)
+	require.Len(t, genesis.TransactionCheckpointList, 3)
+	require.NoError(t, genesis.Validate())
+}
+
+func TestRefundAfterPruning(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	customer, shop := sample.AccAddress(), sample.AccAddress()
+	issuePoints(t, f, ctx, customer, 100, nil)
+	merchantID := registerMerchant(t, f, ctx, shop)
+	spendMsg := types.NewMsgSpendPoints(customer, testProgramID, merchantID, 60, "shoes")
+	spendMsg.OrderReference = "order-1"
+	_, err := ms.SpendPoints(ctx, spendMsg)
+	require.NoError(t, err)
+	const spendID = 1
+	_, err = ms.RefundSpend(ctx, types.NewMsgRefundSpend(shop, testProgramID, spendID, 10))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(48 * time.Hour))
+	params := types.DefaultParams()
+	params.TransactionRetention.MaxAge = 24 * time.Hour
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, f.keeper.PruneTransactions(ctx))
+	requireLastEvent(t, ctx, &types.EventTransactionsPruned{ProgramId: testProgramID, Count: 3, LastTransactionId: 2})
+
+	// the spend is gone from the transactions but kept for its refunds
+	key := collections.Join(testProgramID, uint64(spendID))
+	has, err := f.keeper.Transaction.Has(ctx, key)
+	require.NoError(t, err)
+	require.False(t, has)
+	record, err := f.keeper.RefundableSpend.Get(ctx, key)
+	require.NoError(t, err)
+	require.Empty(t, record.Memo)
+	require.Equal(t, "order-1", record.OrderReference)
+	require.EqualValues(t, 10, record.Refunded)
+
+	_, err = ms.RefundSpend(ctx, types.NewMsgRefundSpend(shop, testProgramID, spendID, 30))
+	require.NoError(t, err)
+	record, err = f.keeper.RefundableSpend.Get(ctx, key)
+	require.NoError(t, err)
+	require.EqualValues(t, 40, record.Refunded)
+
+	genesis, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, genesis.RefundableSpendList, 1)
+	require.NoError(t, genesis.Validate())
+
+	// fully refunding the spend drops its record
+	_, err = ms.RefundSpend(ctx, types.NewMsgRefundSpend(shop, testProgramID, spendID, 21))
+	require.ErrorIs(t, err, types.ErrInvalidRefund)
+	_, err = ms.RefundSpend(ctx, types.NewMsgRefundSpend(shop, testProgramID, spendID, 20))
+	require.NoError(t, err)
+	has, err = f.keeper.RefundableSpend.Has(ctx, key)
+	require.NoError(t, err)
+	require.False(t, has)
+	_, err = ms.RefundSpend(ctx, types.NewMsgRefundSpend(shop, testProgramID, spendID, 1))
+	require.ErrorIs(t, err, types.ErrTransactionNotFound)
+
+	balance, err := f.keeper.PointBalance.Get(ctx, collections.Join(testProgramID, customer))
+	require.NoError(t, err)
+	require.EqualValues(t, 100, balance.Balance)
+}
+
+func TestPruneTransactionsBatch(t *testing.T) {
+	f := initFixture(t)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	owner := sample.AccAddress()
+	for i := 0; i < types.PruneBatchSize+5; i++ {
+		issuePoints(t, f, ctx, owner, 1, nil)
+	}
+
+	ctx = ctx.WithBlockTime(blockTime.Add(2 * time.Hour))
+	params := types.DefaultParams()
+	params.TransactionRetention.MaxAge = time.Hour
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	require.NoError(t, f.keeper.PruneTransactions(ctx))
+	checkpoint, err := f.keeper.TransactionCheckpoint.Get(ctx, collections.Join(testProgramID, owner))
+	require.NoError(t, err)
+	require.EqualValues(t, types.PruneBatchSize, checkpoint.TransactionCount)
+
+	require.NoError(t, f.keeper.PruneTransactions(ctx))
+	checkpoint, err = f.keeper.TransactionCheckpoint.Get(ctx, collections.Join(testProgramID, owner))
+	require.NoError(t, err)
+	require.EqualValues(t, types.PruneBatchSize+5, checkpoint.TransactionCount)
+	require.EqualValues(t, types.PruneBatchSize+4, checkpoint.LastTransactionId)
+}
+
+func TestExportCompactGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	owner := sample.AccAddress()
+	issuePoints(t, f, ctx, owner, 10, nil)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(2 * time.Hour))
+	issuePoints(t, f, ctx, owner, 5, nil)
+	params := types.DefaultParams()
+	params.TransactionRetention.MaxAge = time.Hour
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	genesis, err := f.keeper.ExportCompactGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, genesis.TransactionList, 1)
+	require.EqualValues(t, 1, genesis.TransactionList[0].Id)
+	require.NoError(t, genesis.Validate())
+
+	// the state itself is left for the EndBlocker to prune
+	has, err := f.keeper.Transaction.Has(ctx, collections.Join(testProgramID, uint64(0)))
+	require.NoError(t, err)
+	require.True(t, has)
+}
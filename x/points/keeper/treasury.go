@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"scontract/x/points/types"
+)
+
+// treasuryAddress returns the address of the points treasury module account,
+// which holds the points the treasury collects, such as transfer fees.
+func (k Keeper) treasuryAddress() (string, error) {
+	return k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.TreasuryModuleName))
+}
+
+// TransferFee returns the fee params of point transfers.
+func (k Keeper) TransferFee(ctx context.Context) (types.TransferFee, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.TransferFee{}, err
+	}
+	return params.TransferFee, nil
+}
+
+// creditTreasury credits amount points of a program to the treasury as a lot
+// that never expires.
+func (k Keeper) creditTreasury(ctx context.Context, programID string, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+	treasury, err := k.treasuryAddress()
+	if err != nil {
+		return err
+	}
+	_, err = k.creditLot(ctx, programID, treasury, amount, sdk.UnwrapSDKContext(ctx).BlockTime().Unix(), 0)
+	return err
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// velocityKey is the primary key of a velocity usage bucket: kind, address
+// and counterparty, and the bucket, so that the buckets of one window
+// iterate oldest first.
+type velocityKey = collections.Triple[string, collections.Pair[string, string], int64]
+
+var velocityKeyCodec = collections.TripleKeyCodec(
+	collections.StringKey,
+	collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+	collections.Int64Key,
+)
+
+func newVelocityKey(usage types.VelocityUsage) velocityKey {
+	return collections.Join3(usage.Kind, collections.Join(usage.Address, usage.Counterparty), usage.Bucket)
+}
+
+// velocityLimits returns the velocity limits of address: its override if it
+// has one and the params otherwise.
+func (k Keeper) velocityLimits(ctx context.Context, address string) (limits types.VelocityLimits, overridden bool, err error) {
+	override, err := k.VelocityOverride.Get(ctx, address)
+	if err == nil {
+		return override.Limits, true, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return types.VelocityLimits{}, false, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.VelocityLimits{}, false, err
+	}
+	return params.VelocityLimits, false, nil
+}
+
+// velocityUsed returns the amounts of kind address moved to counterparty in
+// the daily and weekly windows ending with bucket.
+func (k Keeper) velocityUsed(ctx context.Context, kind, address, counterparty string, bucket int64) (daily, weekly uint64, err error) {
+	rng := collections.NewSuperPrefixedTripleRange[string, collections.Pair[string, string], int64](kind, collections.Join(address, counterparty))
+	err = k.VelocityUsage.Walk(ctx, rng, func(_ velocityKey, usage types.VelocityUsage) (bool, error) {
+		if usage.Bucket > bucket-types.VelocityWeekBuckets {
+			weekly += usage.Amount
+		}
+		if usage.Bucket > bucket-types.VelocityDayBuckets {
+			daily += usage.Amount
+		}
+		return false, nil
+	})
+	return daily, weekly, err
+}
+
+// useVelocity checks that moving amount keeps address within its velocity
+// limits of kind and records it in the current bucket. Buckets that left the
+// weekly window are pruned. Moving nothing is not recorded.
+func (k Keeper) useVelocity(ctx context.Context, kind, address, counterparty string, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+	limits, _, err := k.velocityLimits(ctx, address)
+	if err != nil {
+		return err
+	}
+	dailyLimit, weeklyLimit := limits.Windows(kind)
+
+	bucket := types.VelocityBucket(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+	if err := k.pruneVelocity(ctx, kind, address, counterparty, bucket); err != nil {
+		return err
+	}
+	daily, weekly, err := k.velocityUsed(ctx, kind, address, counterparty, bucket)
+	if err != nil {
+		return err
+	}
+
+	if exceedsLimit(dailyLimit, daily, amount) {
+		return errorsmod.Wrapf(types.ErrVelocityLimitExceeded, "%s of %d in the last 24 hours by %s, limit %d, requested %d", kind, daily, address, dailyLimit, amount)
+	}
+	if exceedsLimit(weeklyLimit, weekly, amount) {
+		return errorsmod.Wrapf(types.ErrVelocityLimitExceeded, "%s of %d in the last 7 days by %s, limit %d, requested %d", kind, weekly, address, weeklyLimit, amount)
+	}
+
+	usage := types.VelocityUsage{Kind: kind, Address: address, Counterparty: counterparty, Bucket: bucket}
+	key := newVelocityKey(usage)
+	if current, err := k.VelocityUsage.Get(ctx, key); err == nil {
+		usage = current
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if usage.Amount, err = addAmount(usage.Amount, amount); err != nil {
+		return err
+	}
+
+	return k.VelocityUsage.Set(ctx, key, usage)
+}
+
+// pruneVelocity removes the buckets that are no longer part of the weekly
+// window ending with bucket.
+func (k Keeper) pruneVelocity(ctx context.Context, kind, address, counterparty string, bucket int64) error {
+	var stale []velocityKey
+	rng := collections.NewSuperPrefixedTripleRange[string, collections.Pair[string, string], int64](kind, collections.Join(address, counterparty))
+	if err := k.VelocityUsage.Walk(ctx, rng, func(key velocityKey, usage types.VelocityUsage) (bool, error) {
+		if usage.Bucket > bucket-types.VelocityWeekBuckets {
+			return true, nil
+		}
+		stale = append(stale, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, key := range stale {
+		if err := k.VelocityUsage.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exceedsLimit reports whether moving amount on top of used exceeds limit.
+// A zero limit is never exceeded.
+func exceedsLimit(limit, used, amount uint64) bool {
+	return limit > 0 && (used > limit || amount > limit-used)
+}
+
+// newVelocityHeadroom returns the headroom of a window.
+func newVelocityHeadroom(limit, used uint64) types.VelocityHeadroom {
+	headroom := types.VelocityHeadroom{
+		Limit:     limit,
+		Used:      used,
+		Unlimited: limit == 0,
+	}
+	if limit > used {
+		headroom.Remaining = limit - used
+	}
+	return headroom
+}
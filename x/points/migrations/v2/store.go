@@ -0,0 +1,293 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"scontract/x/points/types"
+)
+
+// Store prefixes as of version 2. They are frozen here so later changes to
+// the keeper layout do not alter this migration.
+var (
+	TransactionKey            = collections.NewPrefix("transaction/value/")
+	TransactionBySenderKey    = collections.NewPrefix("transaction/sender/")
+	TransactionByRecipientKey = collections.NewPrefix("transaction/recipient/")
+	SettlementKey             = collections.NewPrefix("settlement/value/")
+	SettlementByRequesterKey  = collections.NewPrefix("settlement/requester/")
+	SettlementByStatusKey     = collections.NewPrefix("settlement/status/")
+	PointBalanceKey           = collections.NewPrefix("pointBalance/value/")
+	PointLotKey               = collections.NewPrefix("pointLot/value/")
+	PointLotCountKey          = collections.NewPrefix("pointLot/count/")
+	PointLotByExpiryKey       = collections.NewPrefix("pointLot/expiry/")
+	IssuerKey                 = collections.NewPrefix("issuer/value/")
+	ProgramKey                = collections.NewPrefix("program/value/")
+	SupplyKey                 = collections.NewPrefix("supply/value/")
+)
+
+// DefaultProgramID is the program the version 1 state is moved into.
+const DefaultProgramID = "default"
+
+type (
+	programKey = collections.Pair[string, uint64]
+	addressKey = collections.Pair[string, string]
+	lotKey     = collections.Triple[string, string, collections.Pair[int64, uint64]]
+	indexKey   = collections.Pair[addressKey, programKey]
+)
+
+var (
+	programKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
+	addressKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+	indexKeyCodec   = collections.PairKeyCodec(addressKeyCodec, programKeyCodec)
+	lotKeyCodec     = collections.TripleKeyCodec(
+		collections.StringKey, collections.StringKey,
+		collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+	)
+)
+
+// v1Store is the part of the version 1 store that is rewritten.
+type v1Store struct {
+	balances     collections.Map[string, types.PointBalance]
+	transactions collections.Map[uint64, types.Transaction]
+	settlements  collections.Map[uint64, types.Settlement]
+	issuers      collections.Map[string, types.Issuer]
+}
+
+// v2Store is the version 2 layout of the rewritten state.
+type v2Store struct {
+	programs     collections.Map[string, types.PointProgram]
+	balances     collections.Map[addressKey, types.PointBalance]
+	transactions collections.Map[programKey, types.Transaction]
+	bySender     collections.KeySet[indexKey]
+	byRecipient  collections.KeySet[indexKey]
+	settlements  collections.Map[programKey, types.Settlement]
+	byRequester  collections.KeySet[indexKey]
+	byStatus     collections.KeySet[indexKey]
+	lots         collections.Map[lotKey, types.PointLot]
+	lotSeq       collections.Sequence
+	byExpiry     collections.KeySet[collections.Pair[int64, lotKey]]
+	supply       collections.Map[string, types.PointSupply]
+}
+
+// MigrateStore migrates the x/points store from version 1 to 2. It moves the
+// balances, transactions and settlements into the default program owned by
+// authority, whose issuers are the registered issuers, builds their secondary
+// indexes, turns every balance into a point lot that never expires and
+// records the supply of the default program.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec, authority string) error {
+	sb1 := collections.NewSchemaBuilder(storeService)
+	old := v1Store{
+		balances:     collections.NewMap(sb1, PointBalanceKey, "pointBalance", collections.StringKey, codec.CollValue[types.PointBalance](cdc)),
+		transactions: collections.NewMap(sb1, TransactionKey, "transaction", collections.Uint64Key, codec.CollValue[types.Transaction](cdc)),
+		settlements:  collections.NewMap(sb1, SettlementKey, "settlement", collections.Uint64Key, codec.CollValue[types.Settlement](cdc)),
+		issuers:      collections.NewMap(sb1, IssuerKey, "issuer", collections.StringKey, codec.CollValue[types.Issuer](cdc)),
+	}
+	if _, err := sb1.Build(); err != nil {
+		return err
+	}
+
+	sb2 := collections.NewSchemaBuilder(storeService)
+	store := v2Store{
+		programs:     collections.NewMap(sb2, ProgramKey, "program", collections.StringKey, codec.CollValue[types.PointProgram](cdc)),
+		balances:     collections.NewMap(sb2, PointBalanceKey, "pointBalance", addressKeyCodec, codec.CollValue[types.PointBalance](cdc)),
+		transactions: collections.NewMap(sb2, TransactionKey, "transaction", programKeyCodec, codec.CollValue[types.Transaction](cdc)),
+		bySender:     collections.NewKeySet(sb2, TransactionBySenderKey, "transactionBySender", indexKeyCodec),
+		byRecipient:  collections.NewKeySet(sb2, TransactionByRecipientKey, "transactionByRecipient", indexKeyCodec),
+		settlements:  collections.NewMap(sb2, SettlementKey, "settlement", programKeyCodec, codec.CollValue[types.Settlement](cdc)),
+		byRequester:  collections.NewKeySet(sb2, SettlementByRequesterKey, "settlementByRequester", indexKeyCodec),
+		byStatus:     collections.NewKeySet(sb2, SettlementByStatusKey, "settlementByStatus", indexKeyCodec),
+		lots:         collections.NewMap(sb2, PointLotKey, "pointLot", lotKeyCodec, codec.CollValue[types.PointLot](cdc)),
+		lotSeq:       collections.NewSequence(sb2, PointLotCountKey, "pointLotSequence"),
+		byExpiry:     collections.NewKeySet(sb2, PointLotByExpiryKey, "pointLotByExpiry", collections.PairKeyCodec(collections.Int64Key, lotKeyCodec)),
+		supply:       collections.NewMap(sb2, SupplyKey, "supply", collections.StringKey, codec.CollValue[types.PointSupply](cdc)),
+	}
+	if _, err := sb2.Build(); err != nil {
+		return err
+	}
+
+	if err := createDefaultProgram(ctx, old, store, authority); err != nil {
+		return err
+	}
+	if err := migrateBalances(ctx, old, store); err != nil {
+		return err
+	}
+	if err := migrateTransactions(ctx, old, store); err != nil {
+		return err
+	}
+	if err := migrateSettlements(ctx, old, store); err != nil {
+		return err
+	}
+	return migrateSupply(ctx, store)
+}
+
+// createDefaultProgram creates the default program, letting every registered
+// issuer issue its points.
+func createDefaultProgram(ctx context.Context, old v1Store, store v2Store, authority string) error {
+	program := types.PointProgram{
+		Id:    DefaultProgramID,
+		Name:  "Default",
+		Owner: authority,
+	}
+	if err := old.issuers.Walk(ctx, nil, func(address string, _ types.Issuer) (bool, error) {
+		program.Issuers = append(program.Issuers, address)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	return store.programs.Set(ctx, program.Id, program)
+}
+
+// migrateBalances moves the balances into the default program and gives every
+// positive balance a single lot, issued at the current block time, that never
+// expires.
+func migrateBalances(ctx context.Context, old v1Store, store v2Store) error {
+	var all []types.PointBalance
+	if err := old.balances.Walk(ctx, nil, func(_ string, balance types.PointBalance) (bool, error) {
+		all = append(all, balance)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	issuedAt := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	for _, balance := range all {
+		if err := old.balances.Remove(ctx, balance.Index); err != nil {
+			return err
+		}
+		balance.ProgramId = DefaultProgramID
+		if err := store.balances.Set(ctx, collections.Join(balance.ProgramId, balance.Index), balance); err != nil {
+			return err
+		}
+		if balance.Balance == 0 {
+			continue
+		}
+
+		id, err := store.lotSeq.Next(ctx)
+		if err != nil {
+			return err
+		}
+		lot := types.PointLot{
+			Id:        id,
+			ProgramId: balance.ProgramId,
+			Owner:     balance.Index,
+			Amount:    balance.Balance,
+			IssuedAt:  issuedAt,
+		}
+		key := collections.Join3(lot.ProgramId, lot.Owner, collections.Join(lot.IssuedAt, lot.Id))
+		if err := store.lots.Set(ctx, key, lot); err != nil {
+			return err
+		}
+		if err := store.byExpiry.Set(ctx, collections.Join(lot.ExpiresAt, key)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateTransactions moves the transactions into the default program and
+// builds their sender and recipient indexes.
+func migrateTransactions(ctx context.Context, old v1Store, store v2Store) error {
+	var txs []types.Transaction
+	if err := old.transactions.Walk(ctx, nil, func(_ uint64, tx types.Transaction) (bool, error) {
+		txs = append(txs, tx)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, tx := range txs {
+		if err := old.transactions.Remove(ctx, tx.Id); err != nil {
+			return err
+		}
+		tx.ProgramId = DefaultProgramID
+		pk := collections.Join(tx.ProgramId, tx.Id)
+		if err := store.transactions.Set(ctx, pk, tx); err != nil {
+			return err
+		}
+		if err := store.bySender.Set(ctx, collections.Join(collections.Join(tx.ProgramId, tx.Sender), pk)); err != nil {
+			return err
+		}
+		if err := store.byRecipient.Set(ctx, collections.Join(collections.Join(tx.ProgramId, tx.Recipient), pk)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateSettlements moves the settlements into the default program and
+// builds their requester and status indexes.
+func migrateSettlements(ctx context.Context, old v1Store, store v2Store) error {
+	var all []types.Settlement
+	if err := old.settlements.Walk(ctx, nil, func(_ uint64, settlement types.Settlement) (bool, error) {
+		all = append(all, settlement)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, settlement := range all {
+		if err := old.settlements.Remove(ctx, settlement.Id); err != nil {
+			return err
+		}
+		settlement.ProgramId = DefaultProgramID
+		pk := collections.Join(settlement.ProgramId, settlement.Id)
+		if err := store.settlements.Set(ctx, pk, settlement); err != nil {
+			return err
+		}
+		if err := store.byRequester.Set(ctx, collections.Join(collections.Join(settlement.ProgramId, settlement.Requester), pk)); err != nil {
+			return err
+		}
+		if err := store.byStatus.Set(ctx, collections.Join(collections.Join(settlement.ProgramId, settlement.LegacyStatus), pk)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateSupply records the supply counters of the default program. Version 1
+// burned spent points, so issued is set to the points still held in balances
+// or settlements rather than summed from the issue transactions; spent and
+// transferred are summed from the transaction history.
+func migrateSupply(ctx context.Context, store v2Store) error {
+	supply := types.PointSupply{ProgramId: DefaultProgramID}
+
+	if err := store.settlements.Walk(ctx, nil, func(_ programKey, settlement types.Settlement) (bool, error) {
+		switch settlement.LegacyStatus {
+		case "rejected", "cancelled":
+		default:
+			supply.Settled += settlement.Amount
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	supply.Issued = supply.Settled
+	if err := store.balances.Walk(ctx, nil, func(_ addressKey, balance types.PointBalance) (bool, error) {
+		supply.Issued += balance.Balance
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	if err := store.transactions.Walk(ctx, nil, func(_ programKey, tx types.Transaction) (bool, error) {
+		switch tx.LegacyTxType {
+		case "spend":
+			supply.Spent += tx.Amount
+		case "transfer":
+			supply.Transferred += tx.Amount
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	return store.supply.Set(ctx, supply.ProgramId, supply)
+}
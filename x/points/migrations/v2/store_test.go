@@ -0,0 +1,123 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/x/points/keeper"
+	v2 "scontract/x/points/migrations/v2"
+	module "scontract/x/points/module"
+	"scontract/x/points/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockTime(blockTime)
+
+	// write transactions, settlements and balances the way version 1 stored
+	// them, without indexes or lots
+	sb := collections.NewSchemaBuilder(storeService)
+	v1Transactions := collections.NewMap(sb, v2.TransactionKey, "transaction", collections.Uint64Key, codec.CollValue[types.Transaction](encCfg.Codec))
+	v1Settlements := collections.NewMap(sb, v2.SettlementKey, "settlement", collections.Uint64Key, codec.CollValue[types.Settlement](encCfg.Codec))
+	v1Balances := collections.NewMap(sb, v2.PointBalanceKey, "pointBalance", collections.StringKey, codec.CollValue[types.PointBalance](encCfg.Codec))
+	issuers := collections.NewMap(sb, v2.IssuerKey, "issuer", collections.StringKey, codec.CollValue[types.Issuer](encCfg.Codec))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	txs := []types.Transaction{
+		{Id: 0, Sender: "issuer", Recipient: "alice", Amount: 100, LegacyTxType: "issue"},
+		{Id: 1, Sender: "alice", Recipient: "bob", Amount: 30, LegacyTxType: "transfer"},
+	}
+	for _, tx := range txs {
+		require.NoError(t, v1Transactions.Set(ctx, tx.Id, tx))
+	}
+	require.NoError(t, v1Settlements.Set(ctx, 0, types.Settlement{Id: 0, Requester: "bob", Amount: 30, LegacyStatus: "pending"}))
+	require.NoError(t, v1Balances.Set(ctx, "alice", types.PointBalance{Index: "alice", Address: "alice", Balance: 70}))
+	require.NoError(t, v1Balances.Set(ctx, "bob", types.PointBalance{Index: "bob", Address: "bob"}))
+	require.NoError(t, issuers.Set(ctx, "issuer", types.Issuer{Address: "issuer"}))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, encCfg.Codec, "gov"))
+
+	k := keeper.NewKeeper(
+		storeService,
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		nil,
+		nil,
+	)
+
+	program, err := k.Program.Get(ctx, v2.DefaultProgramID)
+	require.NoError(t, err)
+	require.Equal(t, types.PointProgram{Id: v2.DefaultProgramID, Name: "Default", Owner: "gov", Issuers: []string{"issuer"}}, program)
+
+	balance, err := k.PointBalance.Get(ctx, collections.Join(v2.DefaultProgramID, "alice"))
+	require.NoError(t, err)
+	require.Equal(t, types.PointBalance{Index: "alice", Address: "alice", Balance: 70, ProgramId: v2.DefaultProgramID}, balance)
+
+	settlement, err := k.Settlement.Get(ctx, collections.Join(v2.DefaultProgramID, uint64(0)))
+	require.NoError(t, err)
+	require.Equal(t, v2.DefaultProgramID, settlement.ProgramId)
+
+	supply, err := k.Supply.Get(ctx, v2.DefaultProgramID)
+	require.NoError(t, err)
+	require.Equal(t, types.PointSupply{ProgramId: v2.DefaultProgramID, Issued: 100, Transferred: 30, Settled: 30}, supply)
+
+	iter, err := k.Transaction.Indexes.Sender.MatchExact(ctx, collections.Join(v2.DefaultProgramID, "alice"))
+	require.NoError(t, err)
+	sent, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[string, uint64]{collections.Join(v2.DefaultProgramID, uint64(1))}, sent)
+
+	iter, err = k.Transaction.Indexes.Recipient.MatchExact(ctx, collections.Join(v2.DefaultProgramID, "alice"))
+	require.NoError(t, err)
+	received, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[string, uint64]{collections.Join(v2.DefaultProgramID, uint64(0))}, received)
+
+	iter, err = k.Settlement.Indexes.Requester.MatchExact(ctx, collections.Join(v2.DefaultProgramID, "bob"))
+	require.NoError(t, err)
+	requested, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[string, uint64]{collections.Join(v2.DefaultProgramID, uint64(0))}, requested)
+
+	// version 2 keys the status index by the legacy status string
+	iter, err = k.Settlement.Indexes.Status.MatchExact(ctx, collections.Join(v2.DefaultProgramID, "pending"))
+	require.NoError(t, err)
+	pending, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[string, uint64]{collections.Join(v2.DefaultProgramID, uint64(0))}, pending)
+
+	var lots []types.PointLot
+	require.NoError(t, k.PointLot.Walk(ctx, nil, func(_ collections.Triple[string, string, collections.Pair[int64, uint64]], lot types.PointLot) (bool, error) {
+		lots = append(lots, lot)
+		return false, nil
+	}))
+	require.Equal(t, []types.PointLot{{Id: 0, ProgramId: v2.DefaultProgramID, Owner: "alice", Amount: 70, IssuedAt: blockTime.Unix()}}, lots)
+
+	nextLotID, err := k.PointLotSeq.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), nextLotID)
+
+	expiryIter, err := k.PointLot.Indexes.Expiry.MatchExact(ctx, 0)
+	require.NoError(t, err)
+	neverExpiring, err := expiryIter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Triple[string, string, collections.Pair[int64, uint64]]{
+		collections.Join3(v2.DefaultProgramID, "alice", collections.Join(blockTime.Unix(), uint64(0))),
+	}, neverExpiring)
+}
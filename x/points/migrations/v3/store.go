@@ -0,0 +1,180 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"scontract/x/points/types"
+)
+
+// Store prefixes as of version 3. They are frozen here so later changes to
+// the keeper layout do not alter this migration.
+var (
+	TransactionKey        = collections.NewPrefix("transaction/value/")
+	SettlementKey         = collections.NewPrefix("settlement/value/")
+	SettlementByStatusKey = collections.NewPrefix("settlement/status/")
+	PointBalanceKey       = collections.NewPrefix("pointBalance/value/")
+)
+
+// LegacyTransactionTypes maps the free-form transaction types of version 2 to
+// their enum values.
+var LegacyTransactionTypes = map[string]types.TransactionType{
+	"issue":      types.TransactionTypeIssue,
+	"spend":      types.TransactionTypeSpend,
+	"transfer":   types.TransactionTypeTransfer,
+	"expire":     types.TransactionTypeExpire,
+	"tokenize":   types.TransactionTypeTokenize,
+	"detokenize": types.TransactionTypeDetokenize,
+	"refund":     types.TransactionTypeRefund,
+}
+
+// LegacySettlementStatuses maps the free-form settlement statuses of version
+// 2 to their enum values.
+var LegacySettlementStatuses = map[string]types.SettlementStatus{
+	"pending":   types.SettlementStatusPending,
+	"approved":  types.SettlementStatusApproved,
+	"rejected":  types.SettlementStatusRejected,
+	"cancelled": types.SettlementStatusCancelled,
+	"paid":      types.SettlementStatusPaid,
+}
+
+type (
+	programKey = collections.Pair[string, uint64]
+	addressKey = collections.Pair[string, string]
+	indexKey   = collections.Pair[addressKey, programKey]
+)
+
+var (
+	programKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
+	addressKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+	indexKeyCodec   = collections.PairKeyCodec(addressKeyCodec, programKeyCodec)
+)
+
+// store is the part of the version 3 store that is rewritten.
+type store struct {
+	transactions collections.Map[programKey, types.Transaction]
+	settlements  collections.Map[programKey, types.Settlement]
+	byStatus     collections.KeySet[indexKey]
+	balances     collections.Map[addressKey, types.PointBalance]
+}
+
+// MigrateStore migrates the x/points store from version 2 to 3. It converts
+// the free-form transaction types and settlement statuses into their enums,
+// re-keys the settlement status index by the enum names and backfills the
+// index, address and program of balances from their keys.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	s := store{
+		transactions: collections.NewMap(sb, TransactionKey, "transaction", programKeyCodec, codec.CollValue[types.Transaction](cdc)),
+		settlements:  collections.NewMap(sb, SettlementKey, "settlement", programKeyCodec, codec.CollValue[types.Settlement](cdc)),
+		byStatus:     collections.NewKeySet(sb, SettlementByStatusKey, "settlementByStatus", indexKeyCodec),
+		balances:     collections.NewMap(sb, PointBalanceKey, "pointBalance", addressKeyCodec, codec.CollValue[types.PointBalance](cdc)),
+	}
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	if err := migrateTransactions(ctx, s); err != nil {
+		return err
+	}
+	if err := migrateSettlements(ctx, s); err != nil {
+		return err
+	}
+	return migrateBalances(ctx, s)
+}
+
+// migrateTransactions sets the type enum of every transaction from its legacy
+// type and clears the legacy type.
+func migrateTransactions(ctx context.Context, s store) error {
+	var all []types.Transaction
+	if err := s.transactions.Walk(ctx, nil, func(_ programKey, tx types.Transaction) (bool, error) {
+		all = append(all, tx)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, tx := range all {
+		txType, ok := LegacyTransactionTypes[tx.LegacyTxType]
+		if !ok {
+			return fmt.Errorf("transaction %d of program %q has unknown type %q", tx.Id, tx.ProgramId, tx.LegacyTxType)
+		}
+		tx.TxType = txType
+		tx.LegacyTxType = ""
+		if err := s.transactions.Set(ctx, collections.Join(tx.ProgramId, tx.Id), tx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateSettlements sets the status enum of every settlement from its legacy
+// status, clears the legacy status and moves the settlement in the status
+// index from the legacy status to the enum name.
+func migrateSettlements(ctx context.Context, s store) error {
+	var all []types.Settlement
+	if err := s.settlements.Walk(ctx, nil, func(_ programKey, settlement types.Settlement) (bool, error) {
+		all = append(all, settlement)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, settlement := range all {
+		legacy := settlement.LegacyStatus
+		status, ok := LegacySettlementStatuses[legacy]
+		if !ok {
+			return fmt.Errorf("settlement %d of program %q has unknown status %q", settlement.Id, settlement.ProgramId, legacy)
+		}
+		settlement.Status = status
+		settlement.LegacyStatus = ""
+
+		pk := collections.Join(settlement.ProgramId, settlement.Id)
+		if err := s.settlements.Set(ctx, pk, settlement); err != nil {
+			return err
+		}
+		if err := s.byStatus.Remove(ctx, collections.Join(collections.Join(settlement.ProgramId, legacy), pk)); err != nil {
+			return err
+		}
+		if err := s.byStatus.Set(ctx, collections.Join(collections.Join(settlement.ProgramId, status.String()), pk)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateBalances fills in the index, address and program of balances that
+// were stored without them.
+func migrateBalances(ctx context.Context, s store) error {
+	var (
+		keys []addressKey
+		all  []types.PointBalance
+	)
+	if err := s.balances.Walk(ctx, nil, func(key addressKey, balance types.PointBalance) (bool, error) {
+		if balance.Index == key.K2() && balance.Address == key.K2() && balance.ProgramId == key.K1() {
+			return false, nil
+		}
+		balance.Index = key.K2()
+		balance.Address = key.K2()
+		balance.ProgramId = key.K1()
+		keys = append(keys, key)
+		all = append(all, balance)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		if err := s.balances.Set(ctx, key, all[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
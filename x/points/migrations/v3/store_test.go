@@ -0,0 +1,205 @@
+package v3_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"scontract/x/points/keeper"
+	v2 "scontract/x/points/migrations/v2"
+	v3 "scontract/x/points/migrations/v3"
+	module "scontract/x/points/module"
+	"scontract/x/points/types"
+)
+
+type migrationFixture struct {
+	ctx          sdk.Context
+	cdc          codec.Codec
+	storeService corestore.KVStoreService
+}
+
+func initMigrationFixture(t *testing.T) migrationFixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	return migrationFixture{ctx: ctx, cdc: encCfg.Codec, storeService: runtime.NewKVStoreService(storeKey)}
+}
+
+func (f migrationFixture) keeper() keeper.Keeper {
+	return keeper.NewKeeper(
+		f.storeService,
+		f.cdc,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		nil,
+		nil,
+	)
+}
+
+// v2Store writes state the way version 2 stored it.
+type v2Store struct {
+	transactions collections.Map[collections.Pair[string, uint64], types.Transaction]
+	settlements  collections.Map[collections.Pair[string, uint64], types.Settlement]
+	byStatus     collections.KeySet[collections.Pair[collections.Pair[string, string], collections.Pair[string, uint64]]]
+	balances     collections.Map[collections.Pair[string, string], types.PointBalance]
+}
+
+func newV2Store(t *testing.T, f migrationFixture) v2Store {
+	t.Helper()
+
+	programKeyCodec := collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
+	addressKeyCodec := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+	sb := collections.NewSchemaBuilder(f.storeService)
+	s := v2Store{
+		transactions: collections.NewMap(sb, v3.TransactionKey, "transaction", programKeyCodec, codec.CollValue[types.Transaction](f.cdc)),
+		settlements:  collections.NewMap(sb, v3.SettlementKey, "settlement", programKeyCodec, codec.CollValue[types.Settlement](f.cdc)),
+		byStatus:     collections.NewKeySet(sb, v3.SettlementByStatusKey, "settlementByStatus", collections.PairKeyCodec(addressKeyCodec, programKeyCodec)),
+		balances:     collections.NewMap(sb, v3.PointBalanceKey, "pointBalance", addressKeyCodec, codec.CollValue[types.PointBalance](f.cdc)),
+	}
+	_, err := sb.Build()
+	require.NoError(t, err)
+	return s
+}
+
+func (s v2Store) setSettlement(ctx context.Context, t *testing.T, settlement types.Settlement) {
+	t.Helper()
+
+	pk := collections.Join(settlement.ProgramId, settlement.Id)
+	require.NoError(t, s.settlements.Set(ctx, pk, settlement))
+	require.NoError(t, s.byStatus.Set(ctx, collections.Join(collections.Join(settlement.ProgramId, settlement.LegacyStatus), pk)))
+}
+
+func TestMigrateStore(t *testing.T) {
+	f := initMigrationFixture(t)
+	s := newV2Store(t, f)
+
+	for id, txType := range []string{"issue", "spend", "transfer", "expire", "tokenize", "detokenize", "refund"} {
+		tx := types.Transaction{Id: uint64(id), ProgramId: "p", LegacyTxType: txType, Amount: 1}
+		require.NoError(t, s.transactions.Set(f.ctx, collections.Join(tx.ProgramId, tx.Id), tx))
+	}
+	for id, status := range []string{"pending", "approved", "rejected", "cancelled", "paid"} {
+		s.setSettlement(f.ctx, t, types.Settlement{Id: uint64(id), ProgramId: "p", Requester: "alice", LegacyStatus: status})
+	}
+	require.NoError(t, s.balances.Set(f.ctx, collections.Join("p", "alice"), types.PointBalance{Balance: 10}))
+	require.NoError(t, s.balances.Set(f.ctx, collections.Join("p", "bob"), types.PointBalance{Index: "bob", Address: "bob", ProgramId: "p", Balance: 3}))
+
+	require.NoError(t, v3.MigrateStore(f.ctx, f.storeService, f.cdc))
+
+	k := f.keeper()
+	for id, want := range []types.TransactionType{
+		types.TransactionTypeIssue, types.TransactionTypeSpend, types.TransactionTypeTransfer, types.TransactionTypeExpire,
+		types.TransactionTypeTokenize, types.TransactionTypeDetokenize, types.TransactionTypeRefund,
+	} {
+		tx, err := k.Transaction.Get(f.ctx, collections.Join("p", uint64(id)))
+		require.NoError(t, err)
+		require.Equal(t, types.Transaction{Id: uint64(id), ProgramId: "p", TxType: want, Amount: 1}, tx)
+	}
+
+	qs := keeper.NewQueryServerImpl(k)
+	for id, want := range []types.SettlementStatus{
+		types.SettlementStatusPending, types.SettlementStatusApproved, types.SettlementStatusRejected,
+		types.SettlementStatusCancelled, types.SettlementStatusPaid,
+	} {
+		settlement, err := k.Settlement.Get(f.ctx, collections.Join("p", uint64(id)))
+		require.NoError(t, err)
+		require.Equal(t, types.Settlement{Id: uint64(id), ProgramId: "p", Requester: "alice", Status: want}, settlement)
+
+		resp, err := qs.SettlementsByStatus(f.ctx, &types.QuerySettlementsByStatusRequest{ProgramId: "p", Status: want})
+		require.NoError(t, err)
+		require.Equal(t, []types.Settlement{settlement}, resp.Settlement)
+	}
+
+	// the legacy status keys are gone from the index
+	has, err := s.byStatus.Has(f.ctx, collections.Join(collections.Join("p", "pending"), collections.Join("p", uint64(0))))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	balance, err := k.PointBalance.Get(f.ctx, collections.Join("p", "alice"))
+	require.NoError(t, err)
+	require.Equal(t, types.PointBalance{Index: "alice", Address: "alice", ProgramId: "p", Balance: 10}, balance)
+	balance, err = k.PointBalance.Get(f.ctx, collections.Join("p", "bob"))
+	require.NoError(t, err)
+	require.Equal(t, types.PointBalance{Index: "bob", Address: "bob", ProgramId: "p", Balance: 3}, balance)
+}
+
+func TestMigrateStoreUnknownLegacyValue(t *testing.T) {
+	f := initMigrationFixture(t)
+	s := newV2Store(t, f)
+	require.NoError(t, s.transactions.Set(f.ctx, collections.Join("p", uint64(0)), types.Transaction{ProgramId: "p", LegacyTxType: "gift"}))
+	require.ErrorContains(t, v3.MigrateStore(f.ctx, f.storeService, f.cdc), `unknown type "gift"`)
+
+	f = initMigrationFixture(t)
+	s = newV2Store(t, f)
+	s.setSettlement(f.ctx, t, types.Settlement{ProgramId: "p", LegacyStatus: "lost"})
+	require.ErrorContains(t, v3.MigrateStore(f.ctx, f.storeService, f.cdc), `unknown status "lost"`)
+}
+
+// TestMigrateFromVersion1 loads a version 1 store and runs every migration up
+// to version 3, the way an upgrade of a version 1 chain does.
+func TestMigrateFromVersion1(t *testing.T) {
+	f := initMigrationFixture(t)
+
+	sb := collections.NewSchemaBuilder(f.storeService)
+	v1Transactions := collections.NewMap(sb, v2.TransactionKey, "transaction", collections.Uint64Key, codec.CollValue[types.Transaction](f.cdc))
+	v1Settlements := collections.NewMap(sb, v2.SettlementKey, "settlement", collections.Uint64Key, codec.CollValue[types.Settlement](f.cdc))
+	v1Balances := collections.NewMap(sb, v2.PointBalanceKey, "pointBalance", collections.StringKey, codec.CollValue[types.PointBalance](f.cdc))
+	transactionSeq := collections.NewSequence(sb, types.TransactionCountKey, "transactionSequence")
+	settlementSeq := collections.NewSequence(sb, types.SettlementCountKey, "settlementSequence")
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, v1Transactions.Set(f.ctx, 0, types.Transaction{Id: 0, Sender: "issuer", Recipient: "alice", Amount: 100, LegacyTxType: "issue"}))
+	require.NoError(t, v1Transactions.Set(f.ctx, 1, types.Transaction{Id: 1, Sender: "alice", Recipient: "bob", Amount: 30, LegacyTxType: "transfer"}))
+	require.NoError(t, transactionSeq.Set(f.ctx, 2))
+	require.NoError(t, v1Settlements.Set(f.ctx, 0, types.Settlement{Id: 0, Requester: "bob", Amount: 30, LegacyStatus: "pending"}))
+	require.NoError(t, settlementSeq.Set(f.ctx, 1))
+	require.NoError(t, v1Balances.Set(f.ctx, "alice", types.PointBalance{Index: "alice", Address: "alice", Balance: 70}))
+
+	require.NoError(t, v2.MigrateStore(f.ctx, f.storeService, f.cdc, "gov"))
+	require.NoError(t, v3.MigrateStore(f.ctx, f.storeService, f.cdc))
+
+	k := f.keeper()
+	qs := keeper.NewQueryServerImpl(k)
+
+	balance, err := qs.GetPointBalance(f.ctx, &types.QueryGetPointBalanceRequest{ProgramId: v2.DefaultProgramID, Index: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, types.PointBalance{Index: "alice", Address: "alice", ProgramId: v2.DefaultProgramID, Balance: 70}, balance.PointBalance)
+
+	txs, err := qs.ListTransactionsByAddress(f.ctx, &types.QueryTransactionsByAddressRequest{
+		ProgramId: v2.DefaultProgramID,
+		Address:   "alice",
+		TxType:    types.TransactionTypeTransfer,
+	})
+	require.NoError(t, err)
+	require.Len(t, txs.Transaction, 1)
+	require.Equal(t, uint64(1), txs.Transaction[0].Id)
+
+	settlements, err := qs.SettlementsByStatus(f.ctx, &types.QuerySettlementsByStatusRequest{ProgramId: v2.DefaultProgramID, Status: types.SettlementStatusPending})
+	require.NoError(t, err)
+	require.Len(t, settlements.Settlement, 1)
+	require.Equal(t, "bob", settlements.Settlement[0].Requester)
+
+	summary, err := qs.SettlementSummary(f.ctx, &types.QuerySettlementSummaryRequest{ProgramId: v2.DefaultProgramID})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, summary.PendingCount)
+
+	// the tokenized invariant needs a bank keeper, which the fixture lacks
+	for _, invariant := range []sdk.Invariant{keeper.SupplyInvariant(k), keeper.SequenceInvariant(k), keeper.BalanceIndexInvariant(k)} {
+		msg, broken := invariant(f.ctx)
+		require.False(t, broken, msg)
+	}
+}
@@ -18,41 +18,196 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Shows the parameters of the module",
 				},
 				{
-					RpcMethod: "ListPointBalance",
-					Use:       "list-point-balance",
-					Short:     "List all point-balance",
+					RpcMethod:      "ListPointBalance",
+					Use:            "list-point-balance [program-id]",
+					Short:          "List all point-balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}},
 				},
 				{
 					RpcMethod:      "GetPointBalance",
-					Use:            "get-point-balance [id]",
+					Use:            "get-point-balance [program-id] [id]",
 					Short:          "Gets a point-balance",
 					Alias:          []string{"show-point-balance"},
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "index"}},
 				},
 				{
-					RpcMethod: "ListTransaction",
-					Use:       "list-transaction",
-					Short:     "List all transaction",
+					RpcMethod:      "ListTransaction",
+					Use:            "list-transaction [program-id]",
+					Short:          "List all transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}},
 				},
 				{
 					RpcMethod:      "GetTransaction",
-					Use:            "get-transaction [id]",
+					Use:            "get-transaction [program-id] [id]",
 					Short:          "Gets a transaction by id",
 					Alias:          []string{"show-transaction"},
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "id"}},
 				},
 				{
-					RpcMethod: "ListSettlement",
-					Use:       "list-settlement",
-					Short:     "List all settlement",
+					RpcMethod:      "ListSettlement",
+					Use:            "list-settlement [program-id]",
+					Short:          "List all settlement",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}},
 				},
 				{
 					RpcMethod:      "GetSettlement",
-					Use:            "get-settlement [id]",
+					Use:            "get-settlement [program-id] [id]",
 					Short:          "Gets a settlement by id",
 					Alias:          []string{"show-settlement"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "ListIssuer",
+					Use:       "list-issuer",
+					Short:     "List all issuer",
+				},
+				{
+					RpcMethod:      "GetIssuer",
+					Use:            "get-issuer [address]",
+					Short:          "Gets an issuer",
+					Alias:          []string{"show-issuer"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "IssuerAllowance",
+					Use:            "issuer-allowance [address]",
+					Short:          "Shows how much an issuer may still issue in the current epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "ListTransactionsByAddress",
+					Use:            "list-transactions-by-address [program-id] [address]",
+					Short:          "List the transactions an address sent or received",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "SettlementsByRequester",
+					Use:            "settlements-by-requester [program-id] [requester]",
+					Short:          "List the settlements an address requested",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "requester"}},
+				},
+				{
+					RpcMethod:      "SettlementsByStatus",
+					Use:            "settlements-by-status [program-id] [status]",
+					Short:          "List the settlements in a status",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "status"}},
+				},
+				{
+					RpcMethod:      "SettlementSummary",
+					Use:            "settlement-summary [program-id]",
+					Short:          "Shows the number and total amount of pending settlements",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}},
+				},
+				{
+					RpcMethod:      "UpcomingExpirations",
+					Use:            "upcoming-expirations [program-id] [address]",
+					Short:          "Lists the point lots of an address that will expire, soonest first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ListProgram",
+					Use:       "list-program",
+					Short:     "List all program",
+				},
+				{
+					RpcMethod:      "GetProgram",
+					Use:            "get-program [id]",
+					Short:          "Gets a program",
+					Alias:          []string{"show-program"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "ListMerchant",
+					Use:       "list-merchant",
+					Short:     "List all merchant",
+				},
+				{
+					RpcMethod:      "GetMerchant",
+					Use:            "get-merchant [id]",
+					Short:          "Gets a merchant by id",
+					Alias:          []string{"show-merchant"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "Supply",
+					Use:            "supply [program-id]",
+					Short:          "Shows the issued, spent, transferred, settled and expired points of a program",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}},
+				},
+				{
+					RpcMethod:      "VelocityHeadroom",
+					Use:            "velocity-headroom [address]",
+					Short:          "Shows how much an account may still spend, transfer and issue in the current windows",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ListFrozenAccount",
+					Use:       "list-frozen-account",
+					Short:     "List the accounts that are frozen",
+				},
+				{
+					RpcMethod:      "GetFrozenAccount",
+					Use:            "get-frozen-account [address]",
+					Short:          "Gets the compliance hold on an account",
+					Alias:          []string{"show-frozen-account"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "GetPointGrant",
+					Use:            "get-point-grant [program-id] [id]",
+					Short:          "Gets a point grant and how much of it was released",
+					Alias:          []string{"show-point-grant"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ListActiveGrants",
+					Use:            "list-active-grants [program-id] [beneficiary]",
+					Short:          "List the point grants still releasing to a beneficiary",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "beneficiary"}},
+				},
+				{
+					RpcMethod:      "GetSettlementBatch",
+					Use:            "get-settlement-batch [program-id] [id]",
+					Short:          "Gets a settlement batch and its settlements",
+					Alias:          []string{"show-settlement-batch"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "SettlementBatchesByStatus",
+					Use:            "settlement-batches-by-status [program-id] [status]",
+					Short:          "List the settlement batches of a program in a status (pending, paid)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "status"}},
+				},
+				{
+					RpcMethod:      "TreasuryBalance",
+					Use:            "treasury-balance [program-id]",
+					Short:          "Shows the points collected by the points treasury, such as transfer fees",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}},
+				},
+				{
+					RpcMethod: "ListBalanceSnapshot",
+					Use:       "list-balance-snapshot",
+					Short:     "List all balance snapshots",
+				},
+				{
+					RpcMethod:      "GetBalanceSnapshot",
+					Use:            "get-balance-snapshot [id]",
+					Short:          "Gets a balance snapshot",
+					Alias:          []string{"show-balance-snapshot"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "BalanceAt",
+					Use:            "balance-at [program-id] [address]",
+					Short:          "Shows the balance of an address as of a snapshot (--snapshot-id) or a unix time (--timestamp)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Statement",
+					Use:            "statement [program-id] [address] [from]",
+					Short:          "Shows the statement of an address from a unix time (to --to): opening and closing balances, transactions with running balance and totals by type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "address"}, {ProtoField: "from"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -66,27 +221,161 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "IssuePoints",
-					Use:            "issue-points [recipient] [amount] [reason]",
+					Use:            "issue-points [program-id] [recipient] [amount] [reason]",
 					Short:          "Send a issue-points tx",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "amount"}, {ProtoField: "reason"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "recipient"}, {ProtoField: "amount"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod: "BatchIssuePoints",
+					Skip:      true, // provided by the custom batch-issue-points command reading entries from a file
 				},
 				{
 					RpcMethod:      "SpendPoints",
-					Use:            "spend-points [amount] [description]",
+					Use:            "spend-points [program-id] [merchant-id] [amount] [description]",
 					Short:          "Send a spend-points tx",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "description"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "merchant_id"}, {ProtoField: "amount"}, {ProtoField: "description"}},
+				},
+				{
+					RpcMethod:      "TokenizePoints",
+					Use:            "tokenize-points [program-id] [amount]",
+					Short:          "Send a tokenize-points tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "DetokenizePoints",
+					Use:            "detokenize-points [program-id] [amount]",
+					Short:          "Send a detokenize-points tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "amount"}},
 				},
 				{
 					RpcMethod:      "TransferPoints",
-					Use:            "transfer-points [recipient] [amount]",
+					Use:            "transfer-points [program-id] [recipient] [amount]",
 					Short:          "Send a transfer-points tx",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "amount"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "recipient"}, {ProtoField: "amount"}},
 				},
 				{
 					RpcMethod:      "RequestSettlement",
-					Use:            "request-settlement [amount]",
+					Use:            "request-settlement [program-id] [amount]",
 					Short:          "Send a request-settlement tx",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "AddIssuer",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveIssuer",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SuspendIssuer",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "RefundSpend",
+					Use:            "refund-spend [program-id] [transaction-id] [amount]",
+					Short:          "Refund part or all of a spend to the spender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "transaction_id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "FreezeAccount",
+					Use:            "freeze-account [address] [reason]",
+					Short:          "Freeze a points account (compliance officers and the authority only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "UnfreezeAccount",
+					Use:            "unfreeze-account [address]",
+					Short:          "Unfreeze a points account (compliance officers and the authority only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "SetVelocityOverride",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveVelocityOverride",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "ApproveSettlement",
+					Use:            "approve-settlement [program-id] [id]",
+					Short:          "Send a approve-settlement tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "RejectSettlement",
+					Use:            "reject-settlement [program-id] [id] [reason]",
+					Short:          "Send a reject-settlement tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "id"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "CancelSettlement",
+					Use:            "cancel-settlement [program-id] [id]",
+					Short:          "Send a cancel-settlement tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "FundTreasury",
+					Use:            "fund-treasury [amount]",
+					Short:          "Send a fund-treasury tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "FundFeeSponsor",
+					Use:            "fund-fee-sponsor [amount]",
+					Short:          "Send a fund-fee-sponsor tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "CreateProgram",
+					Use:            "create-program [id] [name] [decimals] [issuers]",
+					Short:          "Send a create-program tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "name"}, {ProtoField: "decimals"}, {ProtoField: "issuers", Varargs: true}},
+				},
+				{
+					RpcMethod:      "RegisterMerchant",
+					Use:            "register-merchant [name] [settlement-address]",
+					Short:          "Send a register-merchant tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "settlement_address"}},
+				},
+				{
+					RpcMethod:      "UpdateMerchant",
+					Use:            "update-merchant [id] [name] [settlement-address]",
+					Short:          "Send a update-merchant tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "name"}, {ProtoField: "settlement_address"}},
+				},
+				{
+					RpcMethod:      "DeactivateMerchant",
+					Use:            "deactivate-merchant [id]",
+					Short:          "Send a deactivate-merchant tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "CreatePointGrant",
+					Use:            "create-point-grant [program-id] [beneficiary] [epoch-identifier] [periods] [amount-per-period]",
+					Short:          "Grant points released in equal tranches at the end of each epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "beneficiary"}, {ProtoField: "epoch_identifier"}, {ProtoField: "periods"}, {ProtoField: "amount_per_period"}},
+				},
+				{
+					RpcMethod:      "RevokeGrant",
+					Use:            "revoke-grant [program-id] [grant-id]",
+					Short:          "Revoke a revocable point grant, forfeiting its unreleased tranches",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "grant_id"}},
+				},
+				{
+					RpcMethod:      "ConfirmBatchPaid",
+					Use:            "confirm-batch-paid [program-id] [batch-id] [payment-reference]",
+					Short:          "Confirm a settlement batch was paid out (settlers and the authority only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "program_id"}, {ProtoField: "batch_id"}, {ProtoField: "payment_reference"}},
+				},
+				{
+					RpcMethod: "ApproveMerchant",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "WithdrawTreasuryPoints",
+					Skip:      true, // skipped because authority gated
 				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
@@ -8,6 +8,8 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
 	"scontract/x/points/keeper"
 	"scontract/x/points/types"
@@ -35,6 +37,9 @@ type ModuleInputs struct {
 
 	AuthKeeper types.AuthKeeper
 	BankKeeper types.BankKeeper
+	// EpochsKeeper is taken by value as x/epochs provides it; its methods
+	// have pointer receivers.
+	EpochsKeeper epochskeeper.Keeper
 }
 
 type ModuleOutputs struct {
@@ -42,6 +47,7 @@ type ModuleOutputs struct {
 
 	PointsKeeper keeper.Keeper
 	Module       appmodule.AppModule
+	EpochHooks   epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -55,8 +61,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.BankKeeper,
+		&in.EpochsKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{PointsKeeper: k, Module: m}
+	return ModuleOutputs{PointsKeeper: k, Module: m, EpochHooks: epochstypes.EpochHooksWrapper{EpochHooks: k.Hooks()}}
 }
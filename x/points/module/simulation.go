@@ -1,6 +1,7 @@
 package points
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -11,15 +12,40 @@ import (
 	"scontract/x/points/types"
 )
 
-// GenerateGenesisState creates a randomized GenState of the module.
+// GenerateGenesisState creates a randomized GenState of the module. It
+// creates the simulation program, whose issuers are a few of the simulation
+// accounts, and a few approved merchants settling to other simulation
+// accounts.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	accs := make([]string, len(simState.Accounts))
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+
+	issuers := accs[:min(len(accs), 3)]
 	pointsGenesis := types.GenesisState{
 		Params: types.DefaultParams(),
+		ProgramList: []types.PointProgram{{
+			Id:      pointssimulation.ProgramID,
+			Name:    "Simulation Points",
+			Owner:   accs[0],
+			Issuers: issuers,
+		}},
+	}
+	for _, issuer := range issuers {
+		pointsGenesis.IssuerList = append(pointsGenesis.IssuerList, types.Issuer{Address: issuer})
+	}
+	for i := 0; i < 3; i++ {
+		acc := accs[simState.Rand.Intn(len(accs))]
+		pointsGenesis.MerchantList = append(pointsGenesis.MerchantList, types.Merchant{
+			Id:                uint64(i),
+			Name:              fmt.Sprintf("Merchant %d", i),
+			Owner:             acc,
+			SettlementAddress: acc,
+			Active:            true,
+		})
 	}
+	pointsGenesis.MerchantCount = uint64(len(pointsGenesis.MerchantList))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&pointsGenesis)
 }
 
@@ -30,7 +56,7 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 	const (
-		opWeightMsgIssuePoints          = "op_weight_msg_points"
+		opWeightMsgIssuePoints          = "op_weight_msg_issue_points"
 		defaultWeightMsgIssuePoints int = 100
 	)
 
@@ -45,8 +71,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pointssimulation.SimulateMsgIssuePoints(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgSpendPoints          = "op_weight_msg_points"
-		defaultWeightMsgSpendPoints int = 100
+		opWeightMsgSpendPoints          = "op_weight_msg_spend_points"
+		defaultWeightMsgSpendPoints int = 60
 	)
 
 	var weightMsgSpendPoints int
@@ -60,8 +86,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pointssimulation.SimulateMsgSpendPoints(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgTransferPoints          = "op_weight_msg_points"
-		defaultWeightMsgTransferPoints int = 100
+		opWeightMsgTransferPoints          = "op_weight_msg_transfer_points"
+		defaultWeightMsgTransferPoints int = 60
 	)
 
 	var weightMsgTransferPoints int
@@ -75,8 +101,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pointssimulation.SimulateMsgTransferPoints(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgRequestSettlement          = "op_weight_msg_points"
-		defaultWeightMsgRequestSettlement int = 100
+		opWeightMsgRequestSettlement          = "op_weight_msg_request_settlement"
+		defaultWeightMsgRequestSettlement int = 30
 	)
 
 	var weightMsgRequestSettlement int
@@ -0,0 +1,64 @@
+package simulation
+
+import (
+	"math"
+	"math/rand"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"scontract/x/points/keeper"
+	"scontract/x/points/types"
+)
+
+// ProgramID is the id of the program the simulation genesis creates.
+const ProgramID = "sim"
+
+// maxIssueAmount bounds the points a simulated issuance gives out.
+const maxIssueAmount = 1_000
+
+// spendablePoints returns the points of owner that have not expired at the
+// block time of ctx, which is what a debit of the program may take.
+func spendablePoints(ctx sdk.Context, k keeper.Keeper, owner string) (uint64, error) {
+	var total uint64
+	blockTime := ctx.BlockTime().Unix()
+	rng := collections.NewSuperPrefixedTripleRange[string, string, collections.Pair[int64, uint64]](ProgramID, owner)
+	err := k.PointLot.Walk(ctx, rng, func(_ collections.Triple[string, string, collections.Pair[int64, uint64]], lot types.PointLot) (bool, error) {
+		if lot.ExpiresAt == 0 || lot.ExpiresAt > blockTime {
+			total += lot.Amount
+		}
+		return false, nil
+	})
+	return total, err
+}
+
+// randomHolder returns a random account of accs holding spendable points of
+// the simulation program and how many it may spend. It returns false if no
+// account holds any.
+func randomHolder(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, uint64, bool, error) {
+	var (
+		holders   []simtypes.Account
+		spendable []uint64
+	)
+	for _, acc := range accs {
+		amount, err := spendablePoints(ctx, k, acc.Address.String())
+		if err != nil {
+			return simtypes.Account{}, 0, false, err
+		}
+		if amount > 0 {
+			holders = append(holders, acc)
+			spendable = append(spendable, amount)
+		}
+	}
+	if len(holders) == 0 {
+		return simtypes.Account{}, 0, false, nil
+	}
+	i := r.Intn(len(holders))
+	return holders[i], spendable[i], true, nil
+}
+
+// randomAmount returns a random amount between 1 and limit.
+func randomAmount(r *rand.Rand, limit uint64) uint64 {
+	return uint64(r.Int63n(int64(min(limit, math.MaxInt64)))) + 1
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"scontract/x/points/keeper"
 	"scontract/x/points/types"
@@ -20,13 +21,43 @@ func SimulateMsgIssuePoints(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgIssuePoints{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgIssuePoints{}
+
+		// 1. 시뮬레이션 프로그램의 발행자 중 활성 상태인 계정 선택
+		program, err := k.Program.Get(ctx, ProgramID)
+		if err != nil || len(program.Issuers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no program to issue"), nil, nil
+		}
+		issuer := program.Issuers[r.Intn(len(program.Issuers))]
+		registered, err := k.Issuer.Get(ctx, issuer)
+		if err != nil || registered.Suspended || registered.EpochCap > 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "issuer cannot issue"), nil, nil
+		}
+		issuerAddress, err := ak.AddressCodec().StringToBytes(issuer)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "invalid issuer address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(issuerAddress))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "issuer is not a simulation account"), nil, nil
 		}
 
-		// TODO: Handle the IssuePoints simulation
+		// 2. 임의의 수령인에게 발행
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		msg = types.NewMsgIssuePoints(issuer, ProgramID, recipient.Address.String(), randomAmount(r, maxIssueAmount), "simulation")
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "IssuePoints simulation not implemented"), nil, nil
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"scontract/x/points/keeper"
 	"scontract/x/points/types"
@@ -20,13 +21,42 @@ func SimulateMsgRequestSettlement(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgRequestSettlement{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgRequestSettlement{}
+
+		// 1. 사용 가능한 포인트를 가진 가맹점 정산 주소 선택
+		settlers := make(map[string]struct{})
+		if err := k.Merchant.Walk(ctx, nil, func(_ uint64, merchant types.Merchant) (bool, error) {
+			settlers[merchant.SettlementAddress] = struct{}{}
+			return false, nil
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "cannot list merchants"), nil, err
+		}
+		var candidates []simtypes.Account
+		for _, acc := range accs {
+			if _, ok := settlers[acc.Address.String()]; ok {
+				candidates = append(candidates, acc)
+			}
+		}
+		simAccount, spendable, found, err := randomHolder(r, ctx, k, candidates)
+		if err != nil || !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no merchant holds points"), nil, err
 		}
 
-		// TODO: Handle the RequestSettlement simulation
+		// 2. 보유 포인트 범위에서 정산 요청
+		msg = types.NewMsgRequestSettlement(simAccount.Address.String(), ProgramID, randomAmount(r, spendable))
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "RequestSettlement simulation not implemented"), nil, nil
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"scontract/x/points/keeper"
 	"scontract/x/points/types"
@@ -20,13 +21,44 @@ func SimulateMsgSpendPoints(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgSpendPoints{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgSpendPoints{}
+
+		// 1. 사용 가능한 포인트를 가진 계정 선택
+		simAccount, spendable, found, err := randomHolder(r, ctx, k, accs)
+		if err != nil || !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no account holds points"), nil, err
 		}
+		spender := simAccount.Address.String()
 
-		// TODO: Handle the SpendPoints simulation
+		// 2. 정산 주소가 사용자 자신이 아닌 활성 가맹점 선택
+		var merchants []types.Merchant
+		if err := k.Merchant.Walk(ctx, nil, func(_ uint64, merchant types.Merchant) (bool, error) {
+			if merchant.Active && merchant.SettlementAddress != spender {
+				merchants = append(merchants, merchant)
+			}
+			return false, nil
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "cannot list merchants"), nil, err
+		}
+		if len(merchants) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no merchant to spend at"), nil, nil
+		}
+		merchant := merchants[r.Intn(len(merchants))]
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "SpendPoints simulation not implemented"), nil, nil
+		msg = types.NewMsgSpendPoints(spender, ProgramID, merchant.Id, randomAmount(r, spendable), "simulation")
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
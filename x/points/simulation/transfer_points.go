@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"scontract/x/points/keeper"
 	"scontract/x/points/types"
@@ -20,13 +21,41 @@ func SimulateMsgTransferPoints(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgTransferPoints{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgTransferPoints{}
+
+		// 1. 사용 가능한 포인트를 가진 보내는 사람과 임의의 받는 사람 선택
+		simAccount, spendable, found, err := randomHolder(r, ctx, k, accs)
+		if err != nil || !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no account holds points"), nil, err
 		}
+		sender := simAccount.Address.String()
+		recipient, _ := simtypes.RandomAcc(r, accs)
 
-		// TODO: Handle the TransferPoints simulation
+		// 2. 전송액과 수수료를 함께 낼 수 있는 금액인지 확인
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "cannot read params"), nil, err
+		}
+		amount := randomAmount(r, spendable)
+		fee, err := params.TransferFee.Fee(amount, sender, recipient.Address.String())
+		if err != nil || fee > spendable-amount {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "cannot pay the transfer fee"), nil, nil
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "TransferPoints simulation not implemented"), nil, nil
+		msg = types.NewMsgTransferPoints(sender, ProgramID, recipient.Address.String(), amount)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
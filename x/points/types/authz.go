@@ -0,0 +1,193 @@
+package types
+
+import (
+	"context"
+	"math/bits"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// authzGasCostPerIteration is charged for every allow list entry checked,
+// matching the cost the bank send authorization uses.
+const authzGasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &PointsSpendAuthorization{}
+
+// TransferFeeFunc returns the transfer fee params of the state of ctx.
+type TransferFeeFunc func(ctx context.Context) (TransferFee, error)
+
+// transferFeeKey is the context key of the TransferFeeFunc.
+type transferFeeKey struct{}
+
+// ContextWithTransferFee returns ctx carrying the function looking up the
+// transfer fee params, which PointsSpendAuthorization needs to count the fee
+// of a transfer against its spend limit. The points ante handler sets it for
+// every tx.
+func ContextWithTransferFee(ctx sdk.Context, transferFee TransferFeeFunc) sdk.Context {
+	return ctx.WithValue(transferFeeKey{}, transferFee)
+}
+
+// NewPointsSpendAuthorization creates an authorization for MsgSpendPoints
+// limited to the given merchants, any merchant if none are given.
+func NewPointsSpendAuthorization(programID string, spendLimit uint64, allowedMerchants []uint64, expiresAt int64) *PointsSpendAuthorization {
+	return &PointsSpendAuthorization{
+		MsgTypeUrl:       sdk.MsgTypeURL(&MsgSpendPoints{}),
+		ProgramId:        programID,
+		SpendLimit:       spendLimit,
+		AllowedMerchants: allowedMerchants,
+		ExpiresAt:        expiresAt,
+	}
+}
+
+// NewPointsTransferAuthorization creates an authorization for
+// MsgTransferPoints limited to the given recipients, any recipient if none are
+// given.
+func NewPointsTransferAuthorization(programID string, spendLimit uint64, allowedRecipients []string, expiresAt int64) *PointsSpendAuthorization {
+	return &PointsSpendAuthorization{
+		MsgTypeUrl:        sdk.MsgTypeURL(&MsgTransferPoints{}),
+		ProgramId:         programID,
+		SpendLimit:        spendLimit,
+		AllowedRecipients: allowedRecipients,
+		ExpiresAt:         expiresAt,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PointsSpendAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept. The amount of the message, plus the
+// fee of a transfer, is taken off the spend limit and the grant is deleted
+// once nothing is left. Transfers are rejected if the context does not carry
+// the transfer fee params.
+func (a PointsSpendAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if a.ExpiresAt > 0 && sdkCtx.BlockTime().Unix() >= a.ExpiresAt {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("points authorization has expired")
+	}
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	var programID string
+	var amount uint64
+	switch m := msg.(type) {
+	case *MsgSpendPoints:
+		programID, amount = m.ProgramId, m.Amount
+		if !allowed(sdkCtx, a.AllowedMerchants, m.MerchantId) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot spend at merchant %d", m.MerchantId)
+		}
+	case *MsgTransferPoints:
+		programID = m.ProgramId
+		if !allowed(sdkCtx, a.AllowedRecipients, m.Recipient) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot transfer to %s", m.Recipient)
+		}
+		fee, err := transferFee(sdkCtx, m)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		var carry uint64
+		if amount, carry = bits.Add64(m.Amount, fee, 0); carry != 0 {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(ErrAmountOverflow, "transfer of %d points with a fee of %d", m.Amount, fee)
+		}
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if programID != a.ProgramId {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("authorization does not cover program %s", programID)
+	}
+	if amount > a.SpendLimit {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested %d points, %d left in spend limit", amount, a.SpendLimit)
+	}
+
+	limitLeft := a.SpendLimit - amount
+	if limitLeft == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	updated := a
+	updated.SpendLimit = limitLeft
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PointsSpendAuthorization) ValidateBasic() error {
+	if a.ProgramId == "" {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "program id cannot be empty")
+	}
+	if a.SpendLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "spend limit must be positive")
+	}
+	if a.ExpiresAt < 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "expiry cannot be negative")
+	}
+
+	switch a.MsgTypeUrl {
+	case sdk.MsgTypeURL(&MsgSpendPoints{}):
+		if len(a.AllowedRecipients) > 0 {
+			return errorsmod.Wrap(ErrInvalidAuthorization, "allowed recipients only apply to transfers")
+		}
+		seen := make(map[uint64]struct{}, len(a.AllowedMerchants))
+		for _, id := range a.AllowedMerchants {
+			if _, ok := seen[id]; ok {
+				return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicated merchant %d", id)
+			}
+			seen[id] = struct{}{}
+		}
+	case sdk.MsgTypeURL(&MsgTransferPoints{}):
+		if len(a.AllowedMerchants) > 0 {
+			return errorsmod.Wrap(ErrInvalidAuthorization, "allowed merchants only apply to spends")
+		}
+		seen := make(map[string]struct{}, len(a.AllowedRecipients))
+		for _, addr := range a.AllowedRecipients {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return errorsmod.Wrapf(ErrInvalidAuthorization, "invalid recipient %s: %s", addr, err)
+			}
+			if _, ok := seen[addr]; ok {
+				return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicated recipient %s", addr)
+			}
+			seen[addr] = struct{}{}
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "unsupported message type %s", a.MsgTypeUrl)
+	}
+
+	return nil
+}
+
+// transferFee returns the fee msg is charged, looked up with the
+// TransferFeeFunc of ctx.
+func transferFee(ctx sdk.Context, msg *MsgTransferPoints) (uint64, error) {
+	lookup, ok := ctx.Value(transferFeeKey{}).(TransferFeeFunc)
+	if !ok {
+		return 0, sdkerrors.ErrUnauthorized.Wrap("transfer fee params are not available to the points authorization")
+	}
+	params, err := lookup(ctx)
+	if err != nil {
+		return 0, err
+	}
+	fee, err := params.Fee(msg.Amount, msg.Creator, msg.Recipient)
+	if err != nil {
+		return 0, errorsmod.Wrap(ErrInvalidTransferFee, err.Error())
+	}
+	return fee, nil
+}
+
+// allowed reports whether v is in list, an empty list allowing everything.
+func allowed[T comparable](ctx sdk.Context, list []T, v T) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, item := range list {
+		ctx.GasMeter().ConsumeGas(authzGasCostPerIteration, "points authorization")
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
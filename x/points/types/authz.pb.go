@@ -0,0 +1,628 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scontract/points/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PointsSpendAuthorization lets a grantee spend or transfer points of one
+// program on behalf of the granter through x/authz, up to spend_limit.
+type PointsSpendAuthorization struct {
+	// msg_type_url is the message the grant covers, either MsgSpendPoints or
+	// MsgTransferPoints.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ProgramId  string `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// spend_limit is the number of points still available to the grantee. It
+	// is decremented on every use and the grant is removed once it reaches
+	// zero.
+	SpendLimit uint64 `protobuf:"varint,3,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// allowed_merchants restricts spends to these merchant ids. Empty allows
+	// any merchant.
+	AllowedMerchants []uint64 `protobuf:"varint,4,rep,packed,name=allowed_merchants,json=allowedMerchants,proto3" json:"allowed_merchants,omitempty"`
+	// allowed_recipients restricts transfers to these addresses. Empty allows
+	// any recipient.
+	AllowedRecipients []string `protobuf:"bytes,5,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+	// expires_at is the unix time after which the authorization is no longer
+	// accepted, zero if it does not expire.
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *PointsSpendAuthorization) Reset()         { *m = PointsSpendAuthorization{} }
+func (m *PointsSpendAuthorization) String() string { return proto.CompactTextString(m) }
+func (*PointsSpendAuthorization) ProtoMessage()    {}
+func (*PointsSpendAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4a0ff3920d1dca9, []int{0}
+}
+func (m *PointsSpendAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointsSpendAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointsSpendAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointsSpendAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointsSpendAuthorization.Merge(m, src)
+}
+func (m *PointsSpendAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointsSpendAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointsSpendAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointsSpendAuthorization proto.InternalMessageInfo
+
+func (m *PointsSpendAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *PointsSpendAuthorization) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *PointsSpendAuthorization) GetSpendLimit() uint64 {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return 0
+}
+
+func (m *PointsSpendAuthorization) GetAllowedMerchants() []uint64 {
+	if m != nil {
+		return m.AllowedMerchants
+	}
+	return nil
+}
+
+func (m *PointsSpendAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+func (m *PointsSpendAuthorization) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PointsSpendAuthorization)(nil), "scontract.points.v1.PointsSpendAuthorization")
+}
+
+func init() { proto.RegisterFile("scontract/points/v1/authz.proto", fileDescriptor_a4a0ff3920d1dca9) }
+
+var fileDescriptor_a4a0ff3920d1dca9 = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x4a, 0xeb, 0x40,
+	0x18, 0x6d, 0x9a, 0xde, 0x42, 0xe6, 0xde, 0xc5, 0x6d, 0xee, 0x5d, 0x8c, 0x05, 0xd3, 0xd0, 0x55,
+	0xb0, 0x34, 0x21, 0xba, 0x73, 0xd7, 0x6e, 0x44, 0x50, 0x90, 0x54, 0x5d, 0xb8, 0x09, 0xd3, 0x64,
+	0x48, 0x07, 0x92, 0xcc, 0x30, 0x33, 0xad, 0x6d, 0x1f, 0xc1, 0x95, 0x8f, 0xe2, 0xa2, 0x0f, 0x21,
+	0xae, 0x8a, 0x2b, 0x97, 0xd2, 0x2e, 0xc4, 0xb7, 0x90, 0xfc, 0x55, 0x04, 0xdd, 0x0c, 0x7c, 0xe7,
+	0x9c, 0xef, 0xcc, 0xcc, 0x39, 0xa0, 0x23, 0x02, 0x9a, 0x4a, 0x8e, 0x02, 0xe9, 0x30, 0x4a, 0x52,
+	0x29, 0x9c, 0x99, 0xeb, 0xa0, 0xa9, 0x9c, 0x2c, 0x6d, 0xc6, 0xa9, 0xa4, 0xfa, 0xbf, 0x9d, 0xc0,
+	0x2e, 0x04, 0xf6, 0xcc, 0x6d, 0xb7, 0x50, 0x42, 0x52, 0xea, 0xe4, 0x67, 0xa1, 0x6b, 0xef, 0x05,
+	0x54, 0x24, 0x54, 0xf8, 0xf9, 0xe4, 0x14, 0x43, 0x41, 0x75, 0xdf, 0xeb, 0x00, 0x5e, 0xe4, 0xbb,
+	0x23, 0x86, 0xd3, 0x70, 0x30, 0x95, 0x13, 0xca, 0xc9, 0x12, 0x49, 0x42, 0x53, 0xdd, 0x04, 0x7f,
+	0x12, 0x11, 0xf9, 0x72, 0xc1, 0xb0, 0x3f, 0xe5, 0x31, 0x54, 0x4c, 0xc5, 0xd2, 0x3c, 0x90, 0x88,
+	0xe8, 0x72, 0xc1, 0xf0, 0x15, 0x8f, 0xf5, 0x7d, 0x00, 0x18, 0xa7, 0x11, 0x47, 0x89, 0x4f, 0x42,
+	0x58, 0xcf, 0x79, 0xad, 0x44, 0x4e, 0x43, 0xbd, 0x03, 0x7e, 0x8b, 0xcc, 0xd6, 0x8f, 0x49, 0x42,
+	0x24, 0x54, 0x4d, 0xc5, 0x6a, 0x78, 0x20, 0x87, 0xce, 0x32, 0x44, 0xef, 0x81, 0x16, 0x8a, 0x63,
+	0x7a, 0x8b, 0x43, 0x3f, 0xc1, 0x3c, 0x98, 0xa0, 0x54, 0x0a, 0xd8, 0x30, 0x55, 0xab, 0xe1, 0xfd,
+	0x2d, 0x89, 0xf3, 0x0a, 0xd7, 0x4f, 0x80, 0x5e, 0x89, 0x39, 0x0e, 0x08, 0x23, 0x38, 0x53, 0xff,
+	0x32, 0x55, 0x4b, 0x1b, 0xc2, 0xe7, 0x55, 0xff, 0x7f, 0xf9, 0xb3, 0x41, 0x18, 0x72, 0x2c, 0xc4,
+	0x48, 0x72, 0x92, 0x46, 0x5e, 0x75, 0x81, 0xb7, 0x5b, 0xc9, 0x5e, 0x8d, 0xe7, 0x8c, 0x70, 0x2c,
+	0x7c, 0x24, 0x61, 0xd3, 0x54, 0x2c, 0xd5, 0xd3, 0x4a, 0x64, 0x20, 0x8f, 0xaf, 0x9f, 0x56, 0xfd,
+	0x6e, 0xe9, 0x55, 0xc4, 0x3d, 0x73, 0xc7, 0x58, 0x22, 0xd7, 0xfe, 0x12, 0xcf, 0xdd, 0xdb, 0xc3,
+	0x41, 0xef, 0xb3, 0xa2, 0x79, 0x55, 0xd2, 0x4f, 0x71, 0x0e, 0x0f, 0x1f, 0x37, 0x86, 0xb2, 0xde,
+	0x18, 0xca, 0xeb, 0xc6, 0x50, 0xee, 0xb7, 0x46, 0x6d, 0xbd, 0x35, 0x6a, 0x2f, 0x5b, 0xa3, 0x76,
+	0x03, 0xbf, 0xb1, 0xc9, 0x52, 0x17, 0xe3, 0x66, 0x5e, 0xd3, 0xd1, 0x47, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x5c, 0x85, 0x1c, 0xea, 0x0c, 0x02, 0x00, 0x00,
+}
+
+func (m *PointsSpendAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PointsSpendAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointsSpendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedMerchants) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedMerchants)*10)
+		var j1 int
+		for _, num := range m.AllowedMerchants {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthz(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SpendLimit != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.SpendLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PointsSpendAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.SpendLimit != 0 {
+		n += 1 + sovAuthz(uint64(m.SpendLimit))
+	}
+	if len(m.AllowedMerchants) > 0 {
+		l = 0
+		for _, e := range m.AllowedMerchants {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovAuthz(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PointsSpendAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointsSpendAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointsSpendAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			m.SpendLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedMerchants = append(m.AllowedMerchants, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AllowedMerchants) == 0 {
+					m.AllowedMerchants = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedMerchants = append(m.AllowedMerchants, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMerchants", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
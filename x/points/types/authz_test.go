@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/types"
+)
+
+func authzContext(blockTime time.Time) sdk.Context {
+	return sdk.NewContext(nil, cmtproto.Header{Time: blockTime}, false, log.NewNopLogger()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+}
+
+func TestPointsSpendAuthorizationAccept(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	ctx := authzContext(now)
+	owner := sample.AccAddress()
+
+	auth := types.NewPointsSpendAuthorization("p", 100, []uint64{1, 2}, 0)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgSpendPoints{}), auth.MsgTypeURL())
+
+	resp, err := auth.Accept(ctx, &types.MsgSpendPoints{Creator: owner, ProgramId: "p", MerchantId: 2, Amount: 40})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*types.PointsSpendAuthorization)
+	require.EqualValues(t, 60, updated.SpendLimit)
+	require.Equal(t, []uint64{1, 2}, updated.AllowedMerchants)
+	require.EqualValues(t, 100, auth.SpendLimit)
+
+	resp, err = updated.Accept(ctx, &types.MsgSpendPoints{Creator: owner, ProgramId: "p", MerchantId: 1, Amount: 60})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	_, err = auth.Accept(ctx, &types.MsgSpendPoints{Creator: owner, ProgramId: "p", MerchantId: 3, Amount: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = auth.Accept(ctx, &types.MsgSpendPoints{Creator: owner, ProgramId: "other", MerchantId: 1, Amount: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = auth.Accept(ctx, &types.MsgSpendPoints{Creator: owner, ProgramId: "p", MerchantId: 1, Amount: 101})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = auth.Accept(ctx, &types.MsgTransferPoints{Creator: owner, ProgramId: "p", Recipient: sample.AccAddress(), Amount: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	expiring := types.NewPointsSpendAuthorization("p", 100, nil, now.Unix())
+	_, err = expiring.Accept(ctx, &types.MsgSpendPoints{Creator: owner, ProgramId: "p", MerchantId: 9, Amount: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	resp, err = expiring.Accept(authzContext(now.Add(-time.Second)), &types.MsgSpendPoints{Creator: owner, ProgramId: "p", MerchantId: 9, Amount: 1})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+}
+
+func TestPointsTransferAuthorizationAccept(t *testing.T) {
+	owner, shop := sample.AccAddress(), sample.AccAddress()
+	transferFee := types.TransferFee{Flat: 2}
+	ctx := types.ContextWithTransferFee(authzContext(time.Unix(1_700_000_000, 0)), func(context.Context) (types.TransferFee, error) {
+		return transferFee, nil
+	})
+
+	auth := types.NewPointsTransferAuthorization("p", 50, []string{shop}, 0)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgTransferPoints{}), auth.MsgTypeURL())
+
+	// the transfer fee counts against the spend limit
+	resp, err := auth.Accept(ctx, &types.MsgTransferPoints{Creator: owner, ProgramId: "p", Recipient: shop, Amount: 20})
+	require.NoError(t, err)
+	require.EqualValues(t, 28, resp.Updated.(*types.PointsSpendAuthorization).SpendLimit)
+	_, err = auth.Accept(ctx, &types.MsgTransferPoints{Creator: owner, ProgramId: "p", Recipient: shop, Amount: 49})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	resp, err = auth.Accept(ctx, &types.MsgTransferPoints{Creator: owner, ProgramId: "p", Recipient: shop, Amount: 48})
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+
+	transferFee.Exempt = []string{shop}
+	resp, err = auth.Accept(ctx, &types.MsgTransferPoints{Creator: owner, ProgramId: "p", Recipient: shop, Amount: 20})
+	require.NoError(t, err)
+	require.EqualValues(t, 30, resp.Updated.(*types.PointsSpendAuthorization).SpendLimit)
+
+	// without the transfer fee params the fee cannot be counted
+	_, err = auth.Accept(authzContext(time.Unix(1_700_000_000, 0)), &types.MsgTransferPoints{Creator: owner, ProgramId: "p", Recipient: shop, Amount: 20})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = auth.Accept(ctx, &types.MsgTransferPoints{Creator: owner, ProgramId: "p", Recipient: sample.AccAddress(), Amount: 20})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = auth.Accept(ctx, &types.MsgSpendPoints{Creator: owner, ProgramId: "p", MerchantId: 1, Amount: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestPointsSpendAuthorizationValidateBasic(t *testing.T) {
+	addr := sample.AccAddress()
+
+	tests := []struct {
+		desc  string
+		auth  *types.PointsSpendAuthorization
+		valid bool
+	}{
+		{desc: "spend", auth: types.NewPointsSpendAuthorization("p", 1, []uint64{0, 1}, 0), valid: true},
+		{desc: "transfer", auth: types.NewPointsTransferAuthorization("p", 1, []string{addr}, 10), valid: true},
+		{desc: "empty program", auth: types.NewPointsSpendAuthorization("", 1, nil, 0)},
+		{desc: "zero limit", auth: types.NewPointsSpendAuthorization("p", 0, nil, 0)},
+		{desc: "negative expiry", auth: types.NewPointsSpendAuthorization("p", 1, nil, -1)},
+		{desc: "duplicated merchant", auth: types.NewPointsSpendAuthorization("p", 1, []uint64{1, 1}, 0)},
+		{desc: "duplicated recipient", auth: types.NewPointsTransferAuthorization("p", 1, []string{addr, addr}, 0)},
+		{desc: "invalid recipient", auth: types.NewPointsTransferAuthorization("p", 1, []string{"invalid"}, 0)},
+		{desc: "recipients on a spend grant", auth: &types.PointsSpendAuthorization{
+			MsgTypeUrl: sdk.MsgTypeURL(&types.MsgSpendPoints{}), ProgramId: "p", SpendLimit: 1, AllowedRecipients: []string{addr},
+		}},
+		{desc: "merchants on a transfer grant", auth: &types.PointsSpendAuthorization{
+			MsgTypeUrl: sdk.MsgTypeURL(&types.MsgTransferPoints{}), ProgramId: "p", SpendLimit: 1, AllowedMerchants: []uint64{1},
+		}},
+		{desc: "unsupported message", auth: &types.PointsSpendAuthorization{
+			MsgTypeUrl: sdk.MsgTypeURL(&types.MsgIssuePoints{}), ProgramId: "p", SpendLimit: 1,
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidAuthorization)
+			}
+		})
+	}
+}
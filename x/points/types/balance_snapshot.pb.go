@@ -0,0 +1,1135 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scontract/points/v1/balance_snapshot.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BalanceSnapshot records the state of the points ledger at the end of a
+// balance snapshot epoch. The balances of the accounts that changed since the
+// previous snapshot are stored as SnapshotBalance records; the first snapshot
+// records every account.
+type BalanceSnapshot struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// epoch_identifier and epoch_number identify the epoch whose end took the
+	// snapshot.
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	EpochNumber     int64  `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// timestamp is the block time, in unix seconds, the snapshot was taken at.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// next_transaction_id and next_settlement_id are the ids the next
+	// transaction and settlement were given after the snapshot, from which
+	// balances are replayed.
+	NextTransactionId uint64 `protobuf:"varint,5,opt,name=next_transaction_id,json=nextTransactionId,proto3" json:"next_transaction_id,omitempty"`
+	NextSettlementId  uint64 `protobuf:"varint,6,opt,name=next_settlement_id,json=nextSettlementId,proto3" json:"next_settlement_id,omitempty"`
+	// supply holds the supply counters of every program.
+	Supply []PointSupply `protobuf:"bytes,7,rep,name=supply,proto3" json:"supply"`
+	// account_count is the number of balances recorded by the snapshot.
+	AccountCount uint64 `protobuf:"varint,8,opt,name=account_count,json=accountCount,proto3" json:"account_count,omitempty"`
+}
+
+func (m *BalanceSnapshot) Reset()         { *m = BalanceSnapshot{} }
+func (m *BalanceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*BalanceSnapshot) ProtoMessage()    {}
+func (*BalanceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff534e9792cb72db, []int{0}
+}
+func (m *BalanceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceSnapshot.Merge(m, src)
+}
+func (m *BalanceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceSnapshot proto.InternalMessageInfo
+
+func (m *BalanceSnapshot) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BalanceSnapshot) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *BalanceSnapshot) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *BalanceSnapshot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BalanceSnapshot) GetNextTransactionId() uint64 {
+	if m != nil {
+		return m.NextTransactionId
+	}
+	return 0
+}
+
+func (m *BalanceSnapshot) GetNextSettlementId() uint64 {
+	if m != nil {
+		return m.NextSettlementId
+	}
+	return 0
+}
+
+func (m *BalanceSnapshot) GetSupply() []PointSupply {
+	if m != nil {
+		return m.Supply
+	}
+	return nil
+}
+
+func (m *BalanceSnapshot) GetAccountCount() uint64 {
+	if m != nil {
+		return m.AccountCount
+	}
+	return 0
+}
+
+// SnapshotBalance is the balance of an account in a program as of a
+// snapshot.
+type SnapshotBalance struct {
+	SnapshotId uint64 `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	ProgramId  string `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Address    string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Balance    uint64 `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *SnapshotBalance) Reset()         { *m = SnapshotBalance{} }
+func (m *SnapshotBalance) String() string { return proto.CompactTextString(m) }
+func (*SnapshotBalance) ProtoMessage()    {}
+func (*SnapshotBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff534e9792cb72db, []int{1}
+}
+func (m *SnapshotBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotBalance.Merge(m, src)
+}
+func (m *SnapshotBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotBalance proto.InternalMessageInfo
+
+func (m *SnapshotBalance) GetSnapshotId() uint64 {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return 0
+}
+
+func (m *SnapshotBalance) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *SnapshotBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SnapshotBalance) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+// ChangedBalance is an account whose balance in a program changed since the
+// last snapshot.
+type ChangedBalance struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ChangedBalance) Reset()         { *m = ChangedBalance{} }
+func (m *ChangedBalance) String() string { return proto.CompactTextString(m) }
+func (*ChangedBalance) ProtoMessage()    {}
+func (*ChangedBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff534e9792cb72db, []int{2}
+}
+func (m *ChangedBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangedBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangedBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChangedBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangedBalance.Merge(m, src)
+}
+func (m *ChangedBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangedBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangedBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangedBalance proto.InternalMessageInfo
+
+func (m *ChangedBalance) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *ChangedBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BalanceSnapshot)(nil), "scontract.points.v1.BalanceSnapshot")
+	proto.RegisterType((*SnapshotBalance)(nil), "scontract.points.v1.SnapshotBalance")
+	proto.RegisterType((*ChangedBalance)(nil), "scontract.points.v1.ChangedBalance")
+}
+
+func init() {
+	proto.RegisterFile("scontract/points/v1/balance_snapshot.proto", fileDescriptor_ff534e9792cb72db)
+}
+
+var fileDescriptor_ff534e9792cb72db = []byte{
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xeb, 0xb4, 0x74, 0xf4, 0xed, 0x58, 0x87, 0xc7, 0xc1, 0x9a, 0x20, 0x0b, 0x45, 0x42,
+	0x05, 0xa1, 0x54, 0x1b, 0x77, 0x0e, 0xdd, 0x29, 0x17, 0x84, 0x52, 0x4e, 0x5c, 0x22, 0x37, 0x36,
+	0xad, 0xa5, 0xc6, 0xb6, 0x62, 0x77, 0xda, 0x3e, 0x00, 0x77, 0x3e, 0xd6, 0x8e, 0x3d, 0x72, 0x42,
+	0xa8, 0xfd, 0x22, 0xc8, 0xce, 0x9f, 0x6a, 0xd2, 0xb4, 0x8b, 0x65, 0x3f, 0xcf, 0x2f, 0x79, 0x9c,
+	0x27, 0x2f, 0x7c, 0x34, 0xb9, 0x92, 0xb6, 0xa4, 0xb9, 0x9d, 0x6a, 0x25, 0xa4, 0x35, 0xd3, 0x9b,
+	0xcb, 0xe9, 0x82, 0xae, 0xa9, 0xcc, 0x79, 0x66, 0x24, 0xd5, 0x66, 0xa5, 0x6c, 0xac, 0x4b, 0x65,
+	0x15, 0x3e, 0x6b, 0xd9, 0xb8, 0x62, 0xe3, 0x9b, 0xcb, 0xf3, 0x57, 0x4b, 0xb5, 0x54, 0xde, 0x9f,
+	0xba, 0x5d, 0x85, 0x9e, 0xbf, 0x7f, 0xec, 0xb5, 0x7e, 0x97, 0x99, 0x8d, 0xd6, 0xeb, 0xbb, 0x8a,
+	0x1b, 0x6f, 0x03, 0x18, 0xcd, 0xaa, 0xb4, 0x79, 0x1d, 0x86, 0x4f, 0x20, 0x10, 0x8c, 0xa0, 0x08,
+	0x4d, 0x7a, 0x69, 0x20, 0x18, 0xfe, 0x00, 0xa7, 0x5c, 0xab, 0x7c, 0x95, 0x09, 0xc6, 0xa5, 0x15,
+	0x3f, 0x05, 0x2f, 0x49, 0x10, 0xa1, 0xc9, 0x20, 0x1d, 0x79, 0x3d, 0x69, 0x65, 0xfc, 0x16, 0x8e,
+	0x2b, 0x54, 0x6e, 0x8a, 0x05, 0x2f, 0x49, 0x37, 0x42, 0x93, 0x6e, 0x3a, 0xf4, 0xda, 0x57, 0x2f,
+	0xe1, 0xd7, 0x30, 0xb0, 0xa2, 0xe0, 0xc6, 0xd2, 0x42, 0x93, 0x9e, 0xf7, 0x0f, 0x02, 0x8e, 0xe1,
+	0x4c, 0xf2, 0x5b, 0x9b, 0xd9, 0x92, 0x4a, 0x43, 0x73, 0x2b, 0x94, 0xcc, 0x04, 0x23, 0xcf, 0xfc,
+	0x65, 0x5e, 0x3a, 0xeb, 0xfb, 0xc1, 0x49, 0x18, 0xfe, 0x04, 0xd8, 0xf3, 0x86, 0x5b, 0xbb, 0xe6,
+	0x05, 0x97, 0xd6, 0xe1, 0x7d, 0x8f, 0x9f, 0x3a, 0x67, 0xde, 0x1a, 0x09, 0xc3, 0x5f, 0xa0, 0x5f,
+	0x7d, 0x3d, 0x39, 0x8a, 0xba, 0x93, 0xe1, 0x55, 0x14, 0x3f, 0xd2, 0x68, 0xfc, 0xcd, 0xed, 0xe6,
+	0x9e, 0x9b, 0xf5, 0xee, 0xff, 0x5e, 0x74, 0xd2, 0xfa, 0x29, 0xfc, 0x0e, 0x5e, 0xd0, 0x3c, 0x57,
+	0x1b, 0x69, 0x33, 0xbf, 0x92, 0xe7, 0x3e, 0xe8, 0xb8, 0x16, 0xaf, 0xdd, 0x32, 0xfe, 0x85, 0x60,
+	0xd4, 0x74, 0x59, 0x57, 0x8b, 0x2f, 0x60, 0xd8, 0xfc, 0xcb, 0xac, 0xed, 0x16, 0x1a, 0x29, 0x61,
+	0xf8, 0x0d, 0x80, 0x2e, 0xd5, 0xb2, 0xa4, 0x85, 0xf3, 0xab, 0x76, 0x07, 0xb5, 0x92, 0x30, 0x4c,
+	0xe0, 0x88, 0x32, 0x56, 0x72, 0x63, 0x7c, 0xa5, 0x83, 0xb4, 0x39, 0x3a, 0xa7, 0x9e, 0x16, 0x5f,
+	0x66, 0x2f, 0x6d, 0x8e, 0xe3, 0x04, 0x4e, 0xae, 0x57, 0x54, 0x2e, 0x39, 0x6b, 0x6e, 0xf1, 0x30,
+	0x04, 0x3d, 0x11, 0x12, 0x3c, 0x08, 0x99, 0x5d, 0xdd, 0xef, 0x42, 0xb4, 0xdd, 0x85, 0xe8, 0xdf,
+	0x2e, 0x44, 0xbf, 0xf7, 0x61, 0x67, 0xbb, 0x0f, 0x3b, 0x7f, 0xf6, 0x61, 0xe7, 0x07, 0x39, 0xcc,
+	0xd9, 0x6d, 0x33, 0x69, 0xf6, 0x4e, 0x73, 0xb3, 0xe8, 0xfb, 0x01, 0xfb, 0xfc, 0x3f, 0x00, 0x00,
+	0xff, 0xff, 0x25, 0x56, 0x41, 0xb8, 0xe1, 0x02, 0x00, 0x00,
+}
+
+func (m *BalanceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountCount != 0 {
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(m.AccountCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Supply) > 0 {
+		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBalanceSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextSettlementId != 0 {
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(m.NextSettlementId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextTransactionId != 0 {
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(m.NextTransactionId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SnapshotId != 0 {
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(m.SnapshotId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangedBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangedBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintBalanceSnapshot(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBalanceSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBalanceSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BalanceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBalanceSnapshot(uint64(m.Id))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovBalanceSnapshot(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovBalanceSnapshot(uint64(m.EpochNumber))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovBalanceSnapshot(uint64(m.Timestamp))
+	}
+	if m.NextTransactionId != 0 {
+		n += 1 + sovBalanceSnapshot(uint64(m.NextTransactionId))
+	}
+	if m.NextSettlementId != 0 {
+		n += 1 + sovBalanceSnapshot(uint64(m.NextSettlementId))
+	}
+	if len(m.Supply) > 0 {
+		for _, e := range m.Supply {
+			l = e.Size()
+			n += 1 + l + sovBalanceSnapshot(uint64(l))
+		}
+	}
+	if m.AccountCount != 0 {
+		n += 1 + sovBalanceSnapshot(uint64(m.AccountCount))
+	}
+	return n
+}
+
+func (m *SnapshotBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		n += 1 + sovBalanceSnapshot(uint64(m.SnapshotId))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovBalanceSnapshot(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBalanceSnapshot(uint64(l))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovBalanceSnapshot(uint64(m.Balance))
+	}
+	return n
+}
+
+func (m *ChangedBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovBalanceSnapshot(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBalanceSnapshot(uint64(l))
+	}
+	return n
+}
+
+func sovBalanceSnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBalanceSnapshot(x uint64) (n int) {
+	return sovBalanceSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BalanceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalanceSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTransactionId", wireType)
+			}
+			m.NextTransactionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTransactionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSettlementId", wireType)
+			}
+			m.NextSettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = append(m.Supply, PointSupply{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountCount", wireType)
+			}
+			m.AccountCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalanceSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalanceSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalanceSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangedBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalanceSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangedBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalanceSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalanceSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBalanceSnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBalanceSnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBalanceSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBalanceSnapshot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBalanceSnapshot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBalanceSnapshot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBalanceSnapshot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBalanceSnapshot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBalanceSnapshot = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// PointDenomPrefix prefixes the bank denom of every program's tokenized
+// points.
+const PointDenomPrefix = ModuleName + "/"
+
+// ProgramDenom returns the bank denom the points of a program are tokenized
+// into.
+func ProgramDenom(programID string) string {
+	return PointDenomPrefix + programID
+}
+
+// ProgramDenomMetadata returns the bank metadata of a program's denom. One
+// base unit is one point; programs with decimals get an upper-case display
+// unit with that exponent.
+func ProgramDenomMetadata(program PointProgram) banktypes.Metadata {
+	denom := ProgramDenom(program.Id)
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("Tokenized points of the %s program", program.Name),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        program.Name,
+		Symbol:      strings.ToUpper(program.Id),
+	}
+	if program.Decimals > 0 {
+		display := strings.ToUpper(denom)
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: display, Exponent: program.Decimals})
+		metadata.Display = display
+	}
+	return metadata
+}
@@ -8,6 +8,43 @@ import (
 
 // x/points module sentinel errors
 var (
-	ErrInvalidSigner     = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrInsufficientFunds = errors.Register(ModuleName, 1101, "insufficient funds")
+	ErrInvalidSigner               = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInsufficientFunds           = errors.Register(ModuleName, 1101, "insufficient funds")
+	ErrUnauthorizedIssuer          = errors.Register(ModuleName, 1102, "creator is not a registered issuer")
+	ErrIssuerSuspended             = errors.Register(ModuleName, 1103, "issuer is suspended")
+	ErrIssuerCapExceeded           = errors.Register(ModuleName, 1104, "issuer epoch cap exceeded")
+	ErrIssuerNotFound              = errors.Register(ModuleName, 1105, "issuer not found")
+	ErrInvalidAddress              = errors.Register(ModuleName, 1106, "invalid address")
+	ErrSettlementNotFound          = errors.Register(ModuleName, 1107, "settlement not found")
+	ErrInvalidSettlementTransition = errors.Register(ModuleName, 1108, "invalid settlement status transition")
+	ErrUnauthorizedSettler         = errors.Register(ModuleName, 1109, "creator is not a settler")
+	ErrUnauthorized                = errors.Register(ModuleName, 1110, "unauthorized")
+	ErrInvalidCoins                = errors.Register(ModuleName, 1111, "invalid coins")
+	ErrInvalidExpiry               = errors.Register(ModuleName, 1112, "invalid expiry")
+	ErrProgramNotFound             = errors.Register(ModuleName, 1113, "program not found")
+	ErrProgramExists               = errors.Register(ModuleName, 1114, "program already exists")
+	ErrInvalidProgram              = errors.Register(ModuleName, 1115, "invalid program")
+	ErrMerchantNotFound            = errors.Register(ModuleName, 1116, "merchant not found")
+	ErrMerchantInactive            = errors.Register(ModuleName, 1117, "merchant is inactive")
+	ErrInvalidMerchant             = errors.Register(ModuleName, 1118, "invalid merchant")
+	ErrVelocityLimitExceeded       = errors.Register(ModuleName, 1119, "velocity limit exceeded")
+	ErrVelocityOverrideNotFound    = errors.Register(ModuleName, 1120, "velocity override not found")
+	ErrAccountFrozen               = errors.Register(ModuleName, 1121, "account is frozen")
+	ErrAccountNotFrozen            = errors.Register(ModuleName, 1122, "account is not frozen")
+	ErrTransactionNotFound         = errors.Register(ModuleName, 1123, "transaction not found")
+	ErrInvalidRefund               = errors.Register(ModuleName, 1124, "invalid refund")
+	ErrInvalidBatch                = errors.Register(ModuleName, 1125, "invalid batch")
+	ErrInvalidMetadata             = errors.Register(ModuleName, 1126, "invalid transaction metadata")
+	ErrInvalidAuthorization        = errors.Register(ModuleName, 1127, "invalid points authorization")
+	ErrInvalidFeePayment           = errors.Register(ModuleName, 1128, "invalid fee payment in points")
+	ErrGrantNotFound               = errors.Register(ModuleName, 1129, "point grant not found")
+	ErrInvalidGrant                = errors.Register(ModuleName, 1130, "invalid point grant")
+	ErrSettlementBatchNotFound     = errors.Register(ModuleName, 1131, "settlement batch not found")
+	ErrInvalidSettlementBatch      = errors.Register(ModuleName, 1132, "invalid settlement batch")
+	ErrInvalidTransferFee          = errors.Register(ModuleName, 1133, "invalid transfer fee")
+	ErrInvalidBalanceSnapshot      = errors.Register(ModuleName, 1134, "invalid balance snapshot")
+	ErrHistoryPruned               = errors.Register(ModuleName, 1135, "transaction history pruned")
+	ErrInvalidVelocityLimits       = errors.Register(ModuleName, 1136, "invalid velocity limits")
+	ErrPayoutsDisabled             = errors.Register(ModuleName, 1137, "settlement payouts are disabled")
+	ErrAmountOverflow              = errors.Register(ModuleName, 1138, "amount overflows")
 )
@@ -0,0 +1,362 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scontract/points/v1/fee_payment.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeePaymentOption is a transaction extension option asking for the fee to be
+// paid in points of a program. The fee payer's points are burned at the
+// fee_payment rate and the fee is paid from the fee sponsor pool.
+type FeePaymentOption struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// max_points is the most points the payer accepts to be charged. Zero
+	// means no limit.
+	MaxPoints uint64 `protobuf:"varint,2,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+}
+
+func (m *FeePaymentOption) Reset()         { *m = FeePaymentOption{} }
+func (m *FeePaymentOption) String() string { return proto.CompactTextString(m) }
+func (*FeePaymentOption) ProtoMessage()    {}
+func (*FeePaymentOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8d2f5975780342, []int{0}
+}
+func (m *FeePaymentOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePaymentOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePaymentOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePaymentOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePaymentOption.Merge(m, src)
+}
+func (m *FeePaymentOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePaymentOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePaymentOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePaymentOption proto.InternalMessageInfo
+
+func (m *FeePaymentOption) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *FeePaymentOption) GetMaxPoints() uint64 {
+	if m != nil {
+		return m.MaxPoints
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FeePaymentOption)(nil), "scontract.points.v1.FeePaymentOption")
+}
+
+func init() {
+	proto.RegisterFile("scontract/points/v1/fee_payment.proto", fileDescriptor_1d8d2f5975780342)
+}
+
+var fileDescriptor_1d8d2f5975780342 = []byte{
+	// 231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4e, 0xce, 0xcf,
+	0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0xd1, 0x2f, 0xc8, 0xcf, 0xcc, 0x2b, 0x29, 0xd6, 0x2f, 0x33, 0xd4,
+	0x4f, 0x4b, 0x4d, 0x8d, 0x2f, 0x48, 0xac, 0xcc, 0x4d, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0x12, 0x86, 0x2b, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0x94, 0x92, 0x4c, 0xce, 0x2f,
+	0xce, 0xcd, 0x2f, 0x8e, 0x07, 0x2b, 0xd1, 0x87, 0x70, 0x20, 0xea, 0x95, 0xaa, 0xb8, 0x04, 0xdc,
+	0x52, 0x53, 0x03, 0x20, 0x66, 0xf8, 0x17, 0x94, 0x64, 0xe6, 0xe7, 0x09, 0xc9, 0x72, 0x71, 0x15,
+	0x14, 0xe5, 0xa7, 0x17, 0x25, 0xe6, 0xc6, 0x67, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06,
+	0x71, 0x42, 0x45, 0x3c, 0x53, 0x40, 0xd2, 0xb9, 0x89, 0x15, 0xf1, 0x10, 0xe3, 0x25, 0x98, 0x14,
+	0x18, 0x35, 0x58, 0x82, 0x38, 0x73, 0x13, 0x2b, 0x02, 0xc0, 0x02, 0x56, 0x1a, 0xa7, 0xb6, 0xe8,
+	0xaa, 0x40, 0xed, 0x28, 0xa9, 0xd0, 0x2b, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x0b, 0xa9,
+	0x70, 0xad, 0x28, 0x49, 0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0x83, 0x58, 0xe3, 0xe9, 0x64, 0x74, 0xe2,
+	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
+	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x12, 0x08, 0xcf, 0x56, 0xc0, 0xbc, 0x5b,
+	0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xb6, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xe4,
+	0x3b, 0xa2, 0xed, 0x0f, 0x01, 0x00, 0x00,
+}
+
+func (m *FeePaymentOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePaymentOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePaymentOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPoints != 0 {
+		i = encodeVarintFeePayment(dAtA, i, uint64(m.MaxPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintFeePayment(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeePayment(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeePayment(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeePaymentOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovFeePayment(uint64(l))
+	}
+	if m.MaxPoints != 0 {
+		n += 1 + sovFeePayment(uint64(m.MaxPoints))
+	}
+	return n
+}
+
+func sovFeePayment(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeePayment(x uint64) (n int) {
+	return sovFeePayment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeePaymentOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeePayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePaymentOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePaymentOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeePayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeePayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeePayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoints", wireType)
+			}
+			m.MaxPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeePayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeePayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeePayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeePayment(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeePayment
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeePayment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeePayment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeePayment
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeePayment
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeePayment
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeePayment        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeePayment          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeePayment = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,8 @@
+package types
+
+import "time"
+
+// IsActive reports whether the hold is in force at blockTime.
+func (f FrozenAccount) IsActive(blockTime time.Time) bool {
+	return f.ExpiresAt == 0 || blockTime.Unix() < f.ExpiresAt
+}
@@ -0,0 +1,543 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scontract/points/v1/frozen_account.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FrozenAccount is a compliance hold on an account. A frozen account cannot
+// spend, transfer, settle or tokenize points.
+type FrozenAccount struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// reason records why the account was frozen.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// frozen_by is the compliance officer or authority that froze the account.
+	FrozenBy string `protobuf:"bytes,3,opt,name=frozen_by,json=frozenBy,proto3" json:"frozen_by,omitempty"`
+	// frozen_at is the unix time the account was frozen.
+	FrozenAt int64 `protobuf:"varint,4,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+	// expires_at is the unix time the hold lifts by itself, zero if it only
+	// lifts when the account is unfrozen.
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// block_credits also stops the account from receiving points.
+	BlockCredits bool `protobuf:"varint,6,opt,name=block_credits,json=blockCredits,proto3" json:"block_credits,omitempty"`
+}
+
+func (m *FrozenAccount) Reset()         { *m = FrozenAccount{} }
+func (m *FrozenAccount) String() string { return proto.CompactTextString(m) }
+func (*FrozenAccount) ProtoMessage()    {}
+func (*FrozenAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafa958d36cbd864, []int{0}
+}
+func (m *FrozenAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenAccount.Merge(m, src)
+}
+func (m *FrozenAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenAccount proto.InternalMessageInfo
+
+func (m *FrozenAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FrozenAccount) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FrozenAccount) GetFrozenBy() string {
+	if m != nil {
+		return m.FrozenBy
+	}
+	return ""
+}
+
+func (m *FrozenAccount) GetFrozenAt() int64 {
+	if m != nil {
+		return m.FrozenAt
+	}
+	return 0
+}
+
+func (m *FrozenAccount) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *FrozenAccount) GetBlockCredits() bool {
+	if m != nil {
+		return m.BlockCredits
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*FrozenAccount)(nil), "scontract.points.v1.FrozenAccount")
+}
+
+func init() {
+	proto.RegisterFile("scontract/points/v1/frozen_account.proto", fileDescriptor_cafa958d36cbd864)
+}
+
+var fileDescriptor_cafa958d36cbd864 = []byte{
+	// 247 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x41, 0x4a, 0xc4, 0x30,
+	0x14, 0x86, 0x1b, 0x47, 0xeb, 0x34, 0x38, 0x9b, 0x08, 0x12, 0x10, 0x43, 0xd1, 0x4d, 0x57, 0x2d,
+	0xa3, 0x27, 0xe8, 0x08, 0x1e, 0x60, 0x96, 0x6e, 0x86, 0x34, 0x8d, 0x50, 0x94, 0xa4, 0xe4, 0x3d,
+	0x87, 0xa9, 0xa7, 0xf0, 0x46, 0x6e, 0x5d, 0xce, 0xd2, 0xa5, 0xb4, 0x17, 0x11, 0x93, 0x56, 0x67,
+	0xf9, 0xbe, 0xef, 0x83, 0x07, 0x3f, 0xcd, 0x40, 0x59, 0x83, 0x4e, 0x2a, 0x2c, 0x5a, 0xdb, 0x18,
+	0x84, 0x62, 0xbb, 0x2c, 0x9e, 0x9c, 0x7d, 0xd3, 0x66, 0x23, 0x95, 0xb2, 0xaf, 0x06, 0xf3, 0xd6,
+	0x59, 0xb4, 0xec, 0xfc, 0xaf, 0xcc, 0x43, 0x99, 0x6f, 0x97, 0xd7, 0x1f, 0x84, 0x2e, 0x1e, 0x7c,
+	0x5d, 0x86, 0x98, 0x71, 0x7a, 0x2a, 0xeb, 0xda, 0x69, 0x00, 0x4e, 0x52, 0x92, 0x25, 0xeb, 0xe9,
+	0x64, 0x17, 0x34, 0x76, 0x5a, 0x82, 0x35, 0xfc, 0xc8, 0x8b, 0xf1, 0x62, 0x97, 0x34, 0x19, 0x1f,
+	0x56, 0x1d, 0x9f, 0x79, 0x35, 0x0f, 0x60, 0xd5, 0x1d, 0x48, 0x89, 0xfc, 0x38, 0x25, 0xd9, 0x6c,
+	0x92, 0x25, 0xb2, 0x2b, 0x4a, 0xf5, 0xae, 0x6d, 0x9c, 0x86, 0x5f, 0x7b, 0xe2, 0x6d, 0x32, 0x92,
+	0x12, 0xd9, 0x0d, 0x5d, 0x54, 0x2f, 0x56, 0x3d, 0x6f, 0x94, 0xd3, 0x75, 0x83, 0xc0, 0xe3, 0x94,
+	0x64, 0xf3, 0xf5, 0x99, 0x87, 0xf7, 0x81, 0xad, 0x6e, 0x3f, 0x7b, 0x41, 0xf6, 0xbd, 0x20, 0xdf,
+	0xbd, 0x20, 0xef, 0x83, 0x88, 0xf6, 0x83, 0x88, 0xbe, 0x06, 0x11, 0x3d, 0xf2, 0xff, 0x69, 0x76,
+	0xd3, 0x38, 0xd8, 0xb5, 0x1a, 0xaa, 0xd8, 0x2f, 0x72, 0xf7, 0x13, 0x00, 0x00, 0xff, 0xff, 0xdb,
+	0x95, 0xcb, 0xd7, 0x3d, 0x01, 0x00, 0x00,
+}
+
+func (m *FrozenAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockCredits {
+		i--
+		if m.BlockCredits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintFrozenAccount(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FrozenAt != 0 {
+		i = encodeVarintFrozenAccount(dAtA, i, uint64(m.FrozenAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FrozenBy) > 0 {
+		i -= len(m.FrozenBy)
+		copy(dAtA[i:], m.FrozenBy)
+		i = encodeVarintFrozenAccount(dAtA, i, uint64(len(m.FrozenBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintFrozenAccount(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFrozenAccount(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFrozenAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFrozenAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FrozenAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFrozenAccount(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovFrozenAccount(uint64(l))
+	}
+	l = len(m.FrozenBy)
+	if l > 0 {
+		n += 1 + l + sovFrozenAccount(uint64(l))
+	}
+	if m.FrozenAt != 0 {
+		n += 1 + sovFrozenAccount(uint64(m.FrozenAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovFrozenAccount(uint64(m.ExpiresAt))
+	}
+	if m.BlockCredits {
+		n += 2
+	}
+	return n
+}
+
+func sovFrozenAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFrozenAccount(x uint64) (n int) {
+	return sovFrozenAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FrozenAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrozenAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozenAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrozenAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozenAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozenAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrozenAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozenAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozenAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrozenAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozenAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAt", wireType)
+			}
+			m.FrozenAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozenAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrozenAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozenAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCredits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozenAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockCredits = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrozenAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrozenAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFrozenAccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFrozenAccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFrozenAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFrozenAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFrozenAccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFrozenAccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFrozenAccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFrozenAccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFrozenAccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFrozenAccount = fmt.Errorf("proto: unexpected end of group")
+)
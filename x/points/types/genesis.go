@@ -1,21 +1,45 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params:          DefaultParams(),
-		PointBalanceMap: []PointBalance{}, TransactionList: []Transaction{}, SettlementList: []Settlement{}}
+		PointBalanceMap: []PointBalance{}, TransactionList: []Transaction{}, SettlementList: []Settlement{}, IssuerList: []Issuer{}, PointLotList: []PointLot{}, ProgramList: []PointProgram{}, MerchantList: []Merchant{}, SupplyList: []PointSupply{}, VelocityOverrideList: []VelocityOverride{}, VelocityUsageList: []VelocityUsage{}, FrozenAccountList: []FrozenAccount{}, TransactionCheckpointList: []TransactionCheckpoint{}, PointGrantList: []PointGrant{}, ProcessedEpochList: []ProcessedEpoch{}, SettlementBatchList: []SettlementBatch{}, BalanceSnapshotList: []BalanceSnapshot{}, SnapshotBalanceList: []SnapshotBalance{}, ChangedBalanceList: []ChangedBalance{}, TokenizedLotList: []PointLot{}, RefundableSpendList: []Transaction{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	programIdMap := make(map[string]struct{})
+	for _, elem := range gs.ProgramList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if _, ok := programIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for program")
+		}
+		programIdMap[elem.Id] = struct{}{}
+	}
+	checkProgram := func(kind, programID string) error {
+		if _, ok := programIdMap[programID]; !ok {
+			return fmt.Errorf("%s references unknown program %q", kind, programID)
+		}
+		return nil
+	}
+
 	pointBalanceIndexMap := make(map[string]struct{})
 
 	for _, elem := range gs.PointBalanceMap {
-		index := fmt.Sprint(elem.Index)
+		if err := checkProgram("pointBalance", elem.ProgramId); err != nil {
+			return err
+		}
+		index := programOwnerKey(elem.ProgramId, elem.Index)
 		if _, ok := pointBalanceIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for pointBalance")
 		}
@@ -24,17 +48,41 @@ func (gs GenesisState) Validate() error {
 	transactionIdMap := make(map[uint64]bool)
 	transactionCount := gs.GetTransactionCount()
 	for _, elem := range gs.TransactionList {
+		if err := checkProgram("transaction", elem.ProgramId); err != nil {
+			return err
+		}
 		if _, ok := transactionIdMap[elem.Id]; ok {
 			return fmt.Errorf("duplicated id for transaction")
 		}
 		if elem.Id >= transactionCount {
 			return fmt.Errorf("transaction id should be lower or equal than the last id")
 		}
+		if elem.Refunded > elem.Amount {
+			return fmt.Errorf("transaction %d refunded more than its amount", elem.Id)
+		}
+		transactionIdMap[elem.Id] = true
+	}
+	for _, elem := range gs.RefundableSpendList {
+		if err := checkProgram("refundableSpend", elem.ProgramId); err != nil {
+			return err
+		}
+		if _, ok := transactionIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for refundableSpend")
+		}
+		if elem.Id >= transactionCount {
+			return fmt.Errorf("refundableSpend id should be lower or equal than the last transaction id")
+		}
+		if !elem.IsRefundable() {
+			return fmt.Errorf("refundableSpend %d is not a spend with points left to refund", elem.Id)
+		}
 		transactionIdMap[elem.Id] = true
 	}
 	settlementIdMap := make(map[uint64]bool)
 	settlementCount := gs.GetSettlementCount()
 	for _, elem := range gs.SettlementList {
+		if err := checkProgram("settlement", elem.ProgramId); err != nil {
+			return err
+		}
 		if _, ok := settlementIdMap[elem.Id]; ok {
 			return fmt.Errorf("duplicated id for settlement")
 		}
@@ -43,6 +91,243 @@ func (gs GenesisState) Validate() error {
 		}
 		settlementIdMap[elem.Id] = true
 	}
+	issuerAddressMap := make(map[string]struct{})
+	for _, elem := range gs.IssuerList {
+		if elem.Address == "" {
+			return fmt.Errorf("issuer address cannot be empty")
+		}
+		if _, ok := issuerAddressMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for issuer")
+		}
+		issuerAddressMap[elem.Address] = struct{}{}
+	}
+	pointLotIdMap := make(map[uint64]bool)
+	pointLotCount := gs.GetPointLotCount()
+	lotTotals := make(map[string]uint64)
+	for _, elem := range gs.PointLotList {
+		if err := checkProgram("pointLot", elem.ProgramId); err != nil {
+			return err
+		}
+		if _, ok := pointLotIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for pointLot")
+		}
+		if elem.Id >= pointLotCount {
+			return fmt.Errorf("pointLot id should be lower or equal than the last id")
+		}
+		pointLotIdMap[elem.Id] = true
+		lotTotals[programOwnerKey(elem.ProgramId, elem.Owner)] += elem.Amount
+	}
+	merchantIdMap := make(map[uint64]bool)
+	merchantCount := gs.GetMerchantCount()
+	for _, elem := range gs.MerchantList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if _, ok := merchantIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for merchant")
+		}
+		if elem.Id >= merchantCount {
+			return fmt.Errorf("merchant id should be lower or equal than the last id")
+		}
+		merchantIdMap[elem.Id] = true
+	}
+	supplyProgramMap := make(map[string]struct{})
+	for _, elem := range gs.SupplyList {
+		if err := checkProgram("supply", elem.ProgramId); err != nil {
+			return err
+		}
+		if _, ok := supplyProgramMap[elem.ProgramId]; ok {
+			return fmt.Errorf("duplicated program for supply")
+		}
+		if _, ok := elem.Circulating(); !ok {
+			return fmt.Errorf("supply of program %q settled or expired more than it issued", elem.ProgramId)
+		}
+		supplyProgramMap[elem.ProgramId] = struct{}{}
+	}
+	velocityOverrideMap := make(map[string]struct{})
+	for _, elem := range gs.VelocityOverrideList {
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid velocity override address %q: %w", elem.Address, err)
+		}
+		if err := elem.Limits.Validate(); err != nil {
+			return fmt.Errorf("invalid velocity override limits of %s: %w", elem.Address, err)
+		}
+		if _, ok := velocityOverrideMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for velocity override")
+		}
+		velocityOverrideMap[elem.Address] = struct{}{}
+	}
+	velocityUsageMap := make(map[string]struct{})
+	for _, elem := range gs.VelocityUsageList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s/%s/%d", elem.Kind, elem.Address, elem.Counterparty, elem.Bucket)
+		if _, ok := velocityUsageMap[key]; ok {
+			return fmt.Errorf("duplicated velocity usage %s", key)
+		}
+		velocityUsageMap[key] = struct{}{}
+	}
+	frozenAccountMap := make(map[string]struct{})
+	for _, elem := range gs.FrozenAccountList {
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid frozen account address %q: %w", elem.Address, err)
+		}
+		if _, ok := frozenAccountMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for frozen account")
+		}
+		frozenAccountMap[elem.Address] = struct{}{}
+	}
+	checkpointMap := make(map[string]struct{})
+	for _, elem := range gs.TransactionCheckpointList {
+		if err := checkProgram("transactionCheckpoint", elem.ProgramId); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid transaction checkpoint address %q: %w", elem.Address, err)
+		}
+		key := programOwnerKey(elem.ProgramId, elem.Address)
+		if _, ok := checkpointMap[key]; ok {
+			return fmt.Errorf("duplicated transaction checkpoint %s", key)
+		}
+		if elem.TransactionCount > 0 && elem.LastTransactionId >= transactionCount {
+			return fmt.Errorf("transaction checkpoint %s is past the last transaction id", key)
+		}
+		checkpointMap[key] = struct{}{}
+	}
+	pointGrantIdMap := make(map[uint64]bool)
+	pointGrantCount := gs.GetPointGrantCount()
+	for _, elem := range gs.PointGrantList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if err := checkProgram("pointGrant", elem.ProgramId); err != nil {
+			return err
+		}
+		if _, ok := pointGrantIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for pointGrant")
+		}
+		if elem.Id >= pointGrantCount {
+			return fmt.Errorf("pointGrant id should be lower or equal than the last id")
+		}
+		pointGrantIdMap[elem.Id] = true
+	}
+	processedEpochMap := make(map[string]struct{})
+	for _, elem := range gs.ProcessedEpochList {
+		if elem.Identifier == "" {
+			return fmt.Errorf("processed epoch identifier cannot be empty")
+		}
+		if _, ok := processedEpochMap[elem.Identifier]; ok {
+			return fmt.Errorf("duplicated identifier for processed epoch")
+		}
+		processedEpochMap[elem.Identifier] = struct{}{}
+	}
+	settlementBatchIdMap := make(map[uint64]bool)
+	settlementBatchCount := gs.GetSettlementBatchCount()
+	for _, elem := range gs.SettlementBatchList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if err := checkProgram("settlementBatch", elem.ProgramId); err != nil {
+			return err
+		}
+		if _, ok := settlementBatchIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for settlementBatch")
+		}
+		if elem.Id >= settlementBatchCount {
+			return fmt.Errorf("settlementBatch id should be lower or equal than the last id")
+		}
+		for _, id := range elem.SettlementIds {
+			if !settlementIdMap[id] {
+				return fmt.Errorf("settlementBatch %d includes unknown settlement %d", elem.Id, id)
+			}
+		}
+		settlementBatchIdMap[elem.Id] = true
+	}
+	balanceSnapshotIdMap := make(map[uint64]bool)
+	balanceSnapshotCount := gs.GetBalanceSnapshotCount()
+	for _, elem := range gs.BalanceSnapshotList {
+		if _, ok := balanceSnapshotIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for balanceSnapshot")
+		}
+		if elem.Id >= balanceSnapshotCount {
+			return fmt.Errorf("balanceSnapshot id should be lower or equal than the last id")
+		}
+		balanceSnapshotIdMap[elem.Id] = true
+	}
+	snapshotBalanceMap := make(map[string]struct{})
+	for _, elem := range gs.SnapshotBalanceList {
+		if err := checkProgram("snapshotBalance", elem.ProgramId); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid snapshot balance address %q: %w", elem.Address, err)
+		}
+		if !balanceSnapshotIdMap[elem.SnapshotId] {
+			return fmt.Errorf("snapshotBalance of unknown snapshot %d", elem.SnapshotId)
+		}
+		key := fmt.Sprintf("%s/%d", programOwnerKey(elem.ProgramId, elem.Address), elem.SnapshotId)
+		if _, ok := snapshotBalanceMap[key]; ok {
+			return fmt.Errorf("duplicated snapshot balance %s", key)
+		}
+		snapshotBalanceMap[key] = struct{}{}
+	}
+	changedBalanceMap := make(map[string]struct{})
+	for _, elem := range gs.ChangedBalanceList {
+		if err := checkProgram("changedBalance", elem.ProgramId); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid changed balance address %q: %w", elem.Address, err)
+		}
+		key := programOwnerKey(elem.ProgramId, elem.Address)
+		if _, ok := changedBalanceMap[key]; ok {
+			return fmt.Errorf("duplicated changed balance %s", key)
+		}
+		changedBalanceMap[key] = struct{}{}
+	}
+	tokenizedLotMap := make(map[string]struct{})
+	tokenizedTotals := make(map[string]uint64)
+	for _, elem := range gs.TokenizedLotList {
+		if err := checkProgram("tokenizedLot", elem.ProgramId); err != nil {
+			return err
+		}
+		if elem.Owner != "" {
+			return fmt.Errorf("tokenizedLot %d of program %q has owner %q", elem.Id, elem.ProgramId, elem.Owner)
+		}
+		if elem.Id >= pointLotCount {
+			return fmt.Errorf("tokenizedLot id should be lower or equal than the last pointLot id")
+		}
+		key := fmt.Sprintf("%s/%d/%d", elem.ProgramId, elem.IssuedAt, elem.Id)
+		if _, ok := tokenizedLotMap[key]; ok {
+			return fmt.Errorf("duplicated tokenizedLot %s", key)
+		}
+		tokenizedLotMap[key] = struct{}{}
+		tokenizedTotals[elem.ProgramId] += elem.Amount
+	}
+	for _, elem := range gs.SupplyList {
+		if tokenizedTotals[elem.ProgramId] != elem.Tokenized {
+			return fmt.Errorf("tokenizedLots of program %q total %d, supply tokenized %d", elem.ProgramId, tokenizedTotals[elem.ProgramId], elem.Tokenized)
+		}
+		delete(tokenizedTotals, elem.ProgramId)
+	}
+	for programID, total := range tokenizedTotals {
+		if total > 0 {
+			return fmt.Errorf("tokenizedLots of program %q total %d without a supply", programID, total)
+		}
+	}
+	for _, elem := range gs.PointBalanceMap {
+		key := programOwnerKey(elem.ProgramId, elem.Index)
+		if lotTotals[key] != elem.Balance {
+			return fmt.Errorf("pointBalance %s of %d does not match its lots totalling %d", key, elem.Balance, lotTotals[key])
+		}
+		delete(lotTotals, key)
+	}
+	for owner, total := range lotTotals {
+		if total > 0 {
+			return fmt.Errorf("pointLots of %s total %d without a pointBalance", owner, total)
+		}
+	}
 
 	return gs.Params.Validate()
 }
@@ -27,12 +27,38 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the points module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params           Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PointBalanceMap  []PointBalance `protobuf:"bytes,2,rep,name=point_balance_map,json=pointBalanceMap,proto3" json:"point_balance_map"`
-	TransactionList  []Transaction  `protobuf:"bytes,3,rep,name=transaction_list,json=transactionList,proto3" json:"transaction_list"`
-	TransactionCount uint64         `protobuf:"varint,4,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
-	SettlementList   []Settlement   `protobuf:"bytes,5,rep,name=settlement_list,json=settlementList,proto3" json:"settlement_list"`
-	SettlementCount  uint64         `protobuf:"varint,6,opt,name=settlement_count,json=settlementCount,proto3" json:"settlement_count,omitempty"`
+	Params                    Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PointBalanceMap           []PointBalance          `protobuf:"bytes,2,rep,name=point_balance_map,json=pointBalanceMap,proto3" json:"point_balance_map"`
+	TransactionList           []Transaction           `protobuf:"bytes,3,rep,name=transaction_list,json=transactionList,proto3" json:"transaction_list"`
+	TransactionCount          uint64                  `protobuf:"varint,4,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	SettlementList            []Settlement            `protobuf:"bytes,5,rep,name=settlement_list,json=settlementList,proto3" json:"settlement_list"`
+	SettlementCount           uint64                  `protobuf:"varint,6,opt,name=settlement_count,json=settlementCount,proto3" json:"settlement_count,omitempty"`
+	IssuerList                []Issuer                `protobuf:"bytes,7,rep,name=issuer_list,json=issuerList,proto3" json:"issuer_list"`
+	PointLotList              []PointLot              `protobuf:"bytes,8,rep,name=point_lot_list,json=pointLotList,proto3" json:"point_lot_list"`
+	PointLotCount             uint64                  `protobuf:"varint,9,opt,name=point_lot_count,json=pointLotCount,proto3" json:"point_lot_count,omitempty"`
+	ProgramList               []PointProgram          `protobuf:"bytes,10,rep,name=program_list,json=programList,proto3" json:"program_list"`
+	MerchantList              []Merchant              `protobuf:"bytes,11,rep,name=merchant_list,json=merchantList,proto3" json:"merchant_list"`
+	MerchantCount             uint64                  `protobuf:"varint,12,opt,name=merchant_count,json=merchantCount,proto3" json:"merchant_count,omitempty"`
+	SupplyList                []PointSupply           `protobuf:"bytes,13,rep,name=supply_list,json=supplyList,proto3" json:"supply_list"`
+	VelocityOverrideList      []VelocityOverride      `protobuf:"bytes,14,rep,name=velocity_override_list,json=velocityOverrideList,proto3" json:"velocity_override_list"`
+	VelocityUsageList         []VelocityUsage         `protobuf:"bytes,15,rep,name=velocity_usage_list,json=velocityUsageList,proto3" json:"velocity_usage_list"`
+	FrozenAccountList         []FrozenAccount         `protobuf:"bytes,16,rep,name=frozen_account_list,json=frozenAccountList,proto3" json:"frozen_account_list"`
+	TransactionCheckpointList []TransactionCheckpoint `protobuf:"bytes,17,rep,name=transaction_checkpoint_list,json=transactionCheckpointList,proto3" json:"transaction_checkpoint_list"`
+	PointGrantList            []PointGrant            `protobuf:"bytes,18,rep,name=point_grant_list,json=pointGrantList,proto3" json:"point_grant_list"`
+	PointGrantCount           uint64                  `protobuf:"varint,19,opt,name=point_grant_count,json=pointGrantCount,proto3" json:"point_grant_count,omitempty"`
+	ProcessedEpochList        []ProcessedEpoch        `protobuf:"bytes,20,rep,name=processed_epoch_list,json=processedEpochList,proto3" json:"processed_epoch_list"`
+	SettlementBatchList       []SettlementBatch       `protobuf:"bytes,21,rep,name=settlement_batch_list,json=settlementBatchList,proto3" json:"settlement_batch_list"`
+	SettlementBatchCount      uint64                  `protobuf:"varint,22,opt,name=settlement_batch_count,json=settlementBatchCount,proto3" json:"settlement_batch_count,omitempty"`
+	BalanceSnapshotList       []BalanceSnapshot       `protobuf:"bytes,23,rep,name=balance_snapshot_list,json=balanceSnapshotList,proto3" json:"balance_snapshot_list"`
+	BalanceSnapshotCount      uint64                  `protobuf:"varint,24,opt,name=balance_snapshot_count,json=balanceSnapshotCount,proto3" json:"balance_snapshot_count,omitempty"`
+	SnapshotBalanceList       []SnapshotBalance       `protobuf:"bytes,25,rep,name=snapshot_balance_list,json=snapshotBalanceList,proto3" json:"snapshot_balance_list"`
+	ChangedBalanceList        []ChangedBalance        `protobuf:"bytes,26,rep,name=changed_balance_list,json=changedBalanceList,proto3" json:"changed_balance_list"`
+	// tokenized_lot_list holds the lots backing each program's tokenized
+	// points. Their owner is empty.
+	TokenizedLotList []PointLot `protobuf:"bytes,27,rep,name=tokenized_lot_list,json=tokenizedLotList,proto3" json:"tokenized_lot_list"`
+	// refundable_spend_list holds the pruned spends that can still be
+	// refunded, without their memo and metadata.
+	RefundableSpendList []Transaction `protobuf:"bytes,28,rep,name=refundable_spend_list,json=refundableSpendList,proto3" json:"refundable_spend_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +136,160 @@ func (m *GenesisState) GetSettlementCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetIssuerList() []Issuer {
+	if m != nil {
+		return m.IssuerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPointLotList() []PointLot {
+	if m != nil {
+		return m.PointLotList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPointLotCount() uint64 {
+	if m != nil {
+		return m.PointLotCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetProgramList() []PointProgram {
+	if m != nil {
+		return m.ProgramList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerchantList() []Merchant {
+	if m != nil {
+		return m.MerchantList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerchantCount() uint64 {
+	if m != nil {
+		return m.MerchantCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSupplyList() []PointSupply {
+	if m != nil {
+		return m.SupplyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVelocityOverrideList() []VelocityOverride {
+	if m != nil {
+		return m.VelocityOverrideList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVelocityUsageList() []VelocityUsage {
+	if m != nil {
+		return m.VelocityUsageList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAccountList() []FrozenAccount {
+	if m != nil {
+		return m.FrozenAccountList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransactionCheckpointList() []TransactionCheckpoint {
+	if m != nil {
+		return m.TransactionCheckpointList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPointGrantList() []PointGrant {
+	if m != nil {
+		return m.PointGrantList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPointGrantCount() uint64 {
+	if m != nil {
+		return m.PointGrantCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetProcessedEpochList() []ProcessedEpoch {
+	if m != nil {
+		return m.ProcessedEpochList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettlementBatchList() []SettlementBatch {
+	if m != nil {
+		return m.SettlementBatchList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettlementBatchCount() uint64 {
+	if m != nil {
+		return m.SettlementBatchCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBalanceSnapshotList() []BalanceSnapshot {
+	if m != nil {
+		return m.BalanceSnapshotList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBalanceSnapshotCount() uint64 {
+	if m != nil {
+		return m.BalanceSnapshotCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSnapshotBalanceList() []SnapshotBalance {
+	if m != nil {
+		return m.SnapshotBalanceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChangedBalanceList() []ChangedBalance {
+	if m != nil {
+		return m.ChangedBalanceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizedLotList() []PointLot {
+	if m != nil {
+		return m.TokenizedLotList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRefundableSpendList() []Transaction {
+	if m != nil {
+		return m.RefundableSpendList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "scontract.points.v1.GenesisState")
 }
@@ -117,31 +297,66 @@ func init() {
 func init() { proto.RegisterFile("scontract/points/v1/genesis.proto", fileDescriptor_040d0f5c27e80d40) }
 
 var fileDescriptor_040d0f5c27e80d40 = []byte{
-	// 373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0xdb, 0xde, 0xc2, 0x9d, 0x5e, 0x6e, 0xdb, 0x5c, 0x17, 0xa1, 0x42, 0x9a, 0x8a,
-	0x62, 0x55, 0x48, 0x68, 0xdd, 0xbb, 0x88, 0x0b, 0x37, 0x2a, 0xda, 0xba, 0x72, 0x53, 0xa6, 0x61,
-	0x28, 0x81, 0x66, 0x66, 0xc8, 0x1c, 0x8b, 0xbe, 0x85, 0x4f, 0xe0, 0xda, 0xa5, 0x8f, 0xd1, 0x65,
-	0x97, 0xae, 0x44, 0xda, 0x85, 0xaf, 0x21, 0x99, 0x49, 0x9b, 0x11, 0x66, 0x13, 0x0e, 0x87, 0xef,
-	0xfc, 0xdf, 0x99, 0x1c, 0xd4, 0x15, 0x31, 0xa3, 0x90, 0xe1, 0x18, 0x42, 0xce, 0x12, 0x0a, 0x22,
-	0x9c, 0xf7, 0xc3, 0x29, 0xa1, 0x44, 0x24, 0x22, 0xe0, 0x19, 0x03, 0xe6, 0xfc, 0xdf, 0x22, 0x81,
-	0x42, 0x82, 0x79, 0xbf, 0xdd, 0xc2, 0x69, 0x42, 0x59, 0x28, 0xbf, 0x8a, 0x6b, 0xef, 0x4c, 0xd9,
-	0x94, 0xc9, 0x32, 0xcc, 0xab, 0xa2, 0xeb, 0x9b, 0x04, 0x1c, 0x67, 0x38, 0x2d, 0xf2, 0xdb, 0x87,
-	0x46, 0x22, 0xaf, 0xc6, 0x13, 0x3c, 0xc3, 0x34, 0x26, 0x05, 0xb8, 0x6f, 0x02, 0x05, 0x01, 0x98,
-	0x91, 0x94, 0x50, 0x28, 0xa8, 0x03, 0x13, 0x05, 0x19, 0xa6, 0x02, 0xc7, 0x90, 0x30, 0xaa, 0xb0,
-	0xbd, 0x97, 0x0a, 0xfa, 0x7b, 0xa1, 0xde, 0x39, 0x02, 0x0c, 0xc4, 0x39, 0x43, 0x35, 0xb5, 0x96,
-	0x6b, 0xfb, 0x76, 0xaf, 0x3e, 0xd8, 0x0d, 0x0c, 0xef, 0x0e, 0x6e, 0x24, 0x12, 0xfd, 0x59, 0x7c,
-	0x74, 0xac, 0xd7, 0xaf, 0xb7, 0x63, 0x7b, 0x58, 0x4c, 0x39, 0x23, 0xd4, 0xfa, 0xb1, 0xf4, 0x38,
-	0xc5, 0xdc, 0xfd, 0xe5, 0x57, 0x7a, 0xf5, 0x41, 0xd7, 0x1c, 0x95, 0x57, 0x91, 0x82, 0xa3, 0x6a,
-	0x1e, 0x38, 0x6c, 0x70, 0xad, 0x77, 0x85, 0xb9, 0x73, 0x8b, 0x9a, 0xda, 0xea, 0xe3, 0x59, 0x22,
-	0xc0, 0xad, 0xc8, 0x4c, 0xdf, 0x98, 0x79, 0x57, 0xc2, 0x9b, 0x48, 0x6d, 0xfe, 0x32, 0x11, 0xe0,
-	0x9c, 0xa0, 0x96, 0x1e, 0x19, 0xb3, 0x07, 0x0a, 0x6e, 0xd5, 0xb7, 0x7b, 0xd5, 0xa1, 0xee, 0x3a,
-	0xcf, 0xfb, 0xce, 0x35, 0x6a, 0x94, 0x3f, 0x58, 0xe9, 0x7f, 0x4b, 0x7d, 0xc7, 0xa8, 0x1f, 0x6d,
-	0xd9, 0xc2, 0xfe, 0xaf, 0x9c, 0x96, 0xf2, 0x23, 0xd4, 0xd4, 0xf2, 0x94, 0xbb, 0x26, 0xdd, 0x9a,
-	0x47, 0xaa, 0xa3, 0xc1, 0x62, 0xe5, 0xd9, 0xcb, 0x95, 0x67, 0x7f, 0xae, 0x3c, 0xfb, 0x79, 0xed,
-	0x59, 0xcb, 0xb5, 0x67, 0xbd, 0xaf, 0x3d, 0xeb, 0xde, 0x2d, 0x2f, 0xfc, 0xb8, 0xb9, 0x31, 0x3c,
-	0x71, 0x22, 0x26, 0x35, 0x79, 0xdb, 0xd3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x74, 0x45,
-	0x6e, 0xd6, 0x02, 0x00, 0x00,
+	// 936 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdf, 0x6e, 0x1b, 0x45,
+	0x14, 0xc6, 0x63, 0x1a, 0x02, 0x1d, 0x27, 0xb1, 0xbd, 0xb6, 0x83, 0xeb, 0x80, 0xe3, 0xa6, 0x4d,
+	0x31, 0x46, 0xb2, 0xd5, 0xc0, 0x35, 0x12, 0x8e, 0x20, 0x14, 0xb5, 0xb4, 0x8d, 0x01, 0xa1, 0x56,
+	0xea, 0x6a, 0xbc, 0x9e, 0xd8, 0xab, 0xda, 0x3b, 0xa3, 0x9d, 0xb1, 0x45, 0xfb, 0x14, 0x3c, 0x06,
+	0x97, 0x3c, 0x46, 0x2f, 0x7b, 0x85, 0xb8, 0x42, 0x28, 0xb9, 0xe0, 0x35, 0xd0, 0x9e, 0x33, 0xb3,
+	0x7f, 0x9c, 0xf1, 0x86, 0xde, 0x44, 0x9b, 0xe3, 0xef, 0xfc, 0xbe, 0x6f, 0x76, 0xfe, 0x2d, 0xb9,
+	0x2d, 0x3d, 0x1e, 0xa8, 0x90, 0x7a, 0xaa, 0x2f, 0xb8, 0x1f, 0x28, 0xd9, 0x5f, 0xde, 0xef, 0x4f,
+	0x58, 0xc0, 0xa4, 0x2f, 0x7b, 0x22, 0xe4, 0x8a, 0x3b, 0xd5, 0x58, 0xd2, 0x43, 0x49, 0x6f, 0x79,
+	0xbf, 0x59, 0xa1, 0x73, 0x3f, 0xe0, 0x7d, 0xf8, 0x8b, 0xba, 0x66, 0x6d, 0xc2, 0x27, 0x1c, 0x1e,
+	0xfb, 0xd1, 0x93, 0xae, 0x76, 0x6d, 0x06, 0x23, 0x3a, 0xa3, 0x81, 0xc7, 0x5c, 0x19, 0x50, 0x21,
+	0xa7, 0x5c, 0x69, 0xed, 0x81, 0x4d, 0xcb, 0x04, 0xf7, 0xa6, 0x5a, 0xd0, 0xb1, 0x09, 0xce, 0x43,
+	0xfe, 0x9a, 0x05, 0x2e, 0xf5, 0x3c, 0xbe, 0x08, 0x0c, 0xaa, 0x6d, 0x53, 0xfa, 0x52, 0x2e, 0x58,
+	0xa8, 0x15, 0x87, 0x36, 0xc5, 0x9c, 0x85, 0xde, 0x94, 0xe6, 0x53, 0x04, 0x0d, 0xe9, 0x5c, 0xbf,
+	0x9c, 0xe6, 0xa7, 0x56, 0x45, 0xf4, 0xe4, 0xea, 0x41, 0x6a, 0xe1, 0xd1, 0x7a, 0xe1, 0x24, 0x4c,
+	0x1c, 0xef, 0xac, 0x97, 0xcd, 0xe2, 0xf7, 0x94, 0x63, 0x2a, 0x42, 0x3e, 0x09, 0xe9, 0x5c, 0x0b,
+	0xef, 0xad, 0x17, 0xca, 0x85, 0x10, 0xb3, 0x57, 0x5a, 0x77, 0xd7, 0xa6, 0x93, 0x4c, 0xa9, 0x19,
+	0x9b, 0xb3, 0x38, 0x5b, 0x37, 0x5f, 0xe5, 0x8e, 0xa8, 0x8a, 0x67, 0xca, 0x3a, 0x5c, 0x15, 0xd2,
+	0x40, 0x52, 0x4f, 0xf9, 0x3c, 0xc8, 0x9b, 0x84, 0x25, 0x9b, 0x71, 0xcf, 0x57, 0x3a, 0xdc, 0xe1,
+	0x9f, 0x65, 0xb2, 0x7d, 0x8a, 0x2b, 0x72, 0xa8, 0xa8, 0x62, 0xce, 0x57, 0x64, 0x0b, 0xe7, 0xa0,
+	0x51, 0x68, 0x17, 0x3a, 0xc5, 0xe3, 0xfd, 0x9e, 0x65, 0x85, 0xf6, 0x9e, 0x80, 0x64, 0x70, 0xf3,
+	0xcd, 0xdf, 0x07, 0x1b, 0xbf, 0xff, 0xfb, 0x47, 0xb7, 0x70, 0xa6, 0xbb, 0x9c, 0x21, 0xa9, 0x64,
+	0x66, 0xc8, 0x9d, 0x53, 0xd1, 0x78, 0xaf, 0x7d, 0xa3, 0x53, 0x3c, 0xbe, 0x6d, 0x47, 0x45, 0x4f,
+	0x03, 0x14, 0x0f, 0x36, 0x23, 0xe0, 0x59, 0x49, 0xa4, 0x6a, 0x8f, 0xa8, 0x70, 0x9e, 0x92, 0x72,
+	0x6a, 0x78, 0xee, 0xcc, 0x97, 0xaa, 0x71, 0x03, 0x98, 0x6d, 0x2b, 0xf3, 0xc7, 0x44, 0x6c, 0x90,
+	0xa9, 0xfe, 0x87, 0xbe, 0x54, 0xce, 0xe7, 0xa4, 0x92, 0x46, 0xc2, 0xf2, 0x6e, 0x6c, 0xb6, 0x0b,
+	0x9d, 0xcd, 0xb3, 0xb4, 0xd7, 0x49, 0x54, 0x77, 0x7e, 0x20, 0xa5, 0xd4, 0x54, 0x80, 0xfd, 0xfb,
+	0x60, 0x7f, 0x60, 0xb5, 0x1f, 0xc6, 0x5a, 0xed, 0xbe, 0x9b, 0x74, 0x83, 0xf9, 0x67, 0xa4, 0x9c,
+	0xe2, 0xa1, 0xf7, 0x16, 0x78, 0xa7, 0x7c, 0xd0, 0x7a, 0x40, 0x8a, 0xb8, 0xb3, 0xd0, 0xf6, 0x03,
+	0xb0, 0xb5, 0x4f, 0xca, 0x03, 0xd0, 0x69, 0x4b, 0x82, 0x5d, 0x60, 0xf7, 0x80, 0xec, 0xc6, 0xab,
+	0x1c, 0x31, 0x1f, 0x02, 0xe6, 0x93, 0xf5, 0x13, 0xf2, 0x90, 0x9b, 0xec, 0xdb, 0x42, 0xff, 0x0f,
+	0xa8, 0x7b, 0xa4, 0x94, 0xa0, 0x30, 0xf8, 0x4d, 0x08, 0xbe, 0x63, 0x64, 0x18, 0xfb, 0x7b, 0xb2,
+	0xad, 0x77, 0x0b, 0x1a, 0x92, 0xeb, 0x56, 0xc0, 0x13, 0x54, 0x6b, 0xd3, 0xa2, 0x6e, 0x06, 0xcf,
+	0xef, 0xc8, 0x8e, 0x39, 0x3a, 0x10, 0x56, 0xcc, 0x49, 0xff, 0x48, 0x2b, 0x4d, 0x7a, 0xd3, 0x09,
+	0xa4, 0x23, 0xb2, 0x1b, 0x93, 0x30, 0xfc, 0x36, 0x86, 0x37, 0x55, 0x0c, 0x7f, 0x4a, 0x8a, 0xb8,
+	0x83, 0xd1, 0x6e, 0x27, 0x67, 0xa5, 0x41, 0xf6, 0x21, 0x88, 0xcd, 0x8b, 0xc7, 0x56, 0xf0, 0xa3,
+	0x64, 0xcf, 0xec, 0x37, 0x97, 0x2f, 0x59, 0x18, 0xfa, 0x63, 0x86, 0xcc, 0x5d, 0x60, 0x1e, 0x59,
+	0x99, 0x3f, 0xeb, 0x96, 0xc7, 0xba, 0x43, 0x83, 0x6b, 0xcb, 0x95, 0x3a, 0x58, 0xfc, 0x42, 0xaa,
+	0xb1, 0xc5, 0x42, 0xd2, 0x89, 0xe6, 0x97, 0x80, 0x7f, 0x98, 0xcb, 0xff, 0x29, 0x92, 0x6b, 0x78,
+	0x65, 0x99, 0x2e, 0x1a, 0x72, 0xf6, 0xf4, 0x47, 0x72, 0x39, 0x87, 0xfc, 0x2d, 0xe8, 0xbf, 0x46,
+	0xb9, 0x21, 0x9f, 0xa7, 0x8b, 0x40, 0x16, 0x64, 0x3f, 0xb3, 0xf7, 0xa6, 0xcc, 0x7b, 0xa9, 0x57,
+	0x55, 0xe4, 0x50, 0x01, 0x87, 0xee, 0x75, 0x3b, 0xfb, 0x24, 0x6e, 0xd3, 0x4e, 0xb7, 0x94, 0xed,
+	0x47, 0x70, 0x7c, 0x4c, 0xca, 0xa9, 0xeb, 0x00, 0x6d, 0x9c, 0x9c, 0x1d, 0x0c, 0xd3, 0x7a, 0x1a,
+	0x26, 0xeb, 0x08, 0x37, 0x10, 0x54, 0x00, 0xd8, 0x35, 0xc7, 0x1c, 0x02, 0x71, 0x31, 0x55, 0x71,
+	0x0b, 0x27, 0x52, 0x5c, 0x4e, 0xcf, 0x49, 0x4d, 0x84, 0xdc, 0x63, 0x52, 0xb2, 0xb1, 0x0b, 0x37,
+	0x2e, 0x06, 0xa8, 0x41, 0x80, 0x3b, 0xf6, 0x00, 0xa6, 0xe1, 0x9b, 0x48, 0xaf, 0x43, 0x38, 0x22,
+	0x53, 0x85, 0x20, 0x2f, 0x48, 0x7d, 0xf5, 0x96, 0x40, 0x7a, 0x1d, 0xe8, 0x77, 0xaf, 0x3b, 0xa0,
+	0xa2, 0x06, 0x8d, 0xaf, 0xca, 0x6c, 0x19, 0xf8, 0x5f, 0x92, 0xbd, 0x2b, 0x7c, 0x1c, 0xed, 0x1e,
+	0x8c, 0xb6, 0xb6, 0xd2, 0x84, 0x43, 0x7e, 0x41, 0xea, 0xab, 0x9f, 0x21, 0x98, 0xea, 0xa3, 0x9c,
+	0x54, 0xfa, 0xc0, 0x1f, 0xea, 0x06, 0x93, 0x6a, 0x94, 0x2d, 0x9b, 0x54, 0x57, 0xf8, 0x98, 0xaa,
+	0x81, 0xa9, 0x56, 0x9a, 0xe2, 0x54, 0xb1, 0xda, 0xb4, 0x43, 0xaa, 0x5b, 0x79, 0xef, 0xca, 0xc4,
+	0xc9, 0x5c, 0x51, 0x55, 0x99, 0x2d, 0x43, 0xaa, 0xe7, 0xa4, 0x16, 0x9d, 0x22, 0x13, 0x36, 0xce,
+	0xe2, 0x9b, 0x39, 0x13, 0x7d, 0x82, 0x0d, 0x59, 0xba, 0xe3, 0x65, 0xaa, 0x00, 0x7f, 0x4a, 0x1c,
+	0xc5, 0x5f, 0xb2, 0xc0, 0x7f, 0xcd, 0xc6, 0xc9, 0x41, 0xbe, 0xff, 0xff, 0x0f, 0xf2, 0x72, 0xdc,
+	0x6e, 0x0e, 0xf3, 0x67, 0xa4, 0x1e, 0xb2, 0xf3, 0x45, 0x30, 0xa6, 0xa3, 0x19, 0x73, 0xa5, 0x60,
+	0xc1, 0x18, 0xa9, 0x1f, 0xbf, 0xd3, 0xdd, 0x5a, 0x4d, 0x20, 0xc3, 0x88, 0x11, 0xb1, 0x07, 0xc7,
+	0x6f, 0x2e, 0x5a, 0x85, 0xb7, 0x17, 0xad, 0xc2, 0x3f, 0x17, 0xad, 0xc2, 0x6f, 0x97, 0xad, 0x8d,
+	0xb7, 0x97, 0xad, 0x8d, 0xbf, 0x2e, 0x5b, 0x1b, 0xcf, 0x1a, 0xc9, 0x67, 0xc9, 0xaf, 0xe6, 0xc3,
+	0x44, 0xbd, 0x12, 0x4c, 0x8e, 0xb6, 0xe0, 0x9b, 0xe4, 0x8b, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
+	0xa3, 0x84, 0x42, 0xff, 0x38, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,155 +379,1165 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.SettlementCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SettlementCount))
+	if len(m.RefundableSpendList) > 0 {
+		for iNdEx := len(m.RefundableSpendList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundableSpendList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.TokenizedLotList) > 0 {
+		for iNdEx := len(m.TokenizedLotList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizedLotList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.ChangedBalanceList) > 0 {
+		for iNdEx := len(m.ChangedBalanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangedBalanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.SnapshotBalanceList) > 0 {
+		for iNdEx := len(m.SnapshotBalanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SnapshotBalanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.BalanceSnapshotCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BalanceSnapshotCount))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	if len(m.SettlementList) > 0 {
-		for iNdEx := len(m.SettlementList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BalanceSnapshotList) > 0 {
+		for iNdEx := len(m.BalanceSnapshotList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SettlementList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BalanceSnapshotList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.SettlementBatchCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SettlementBatchCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.SettlementBatchList) > 0 {
+		for iNdEx := len(m.SettlementBatchList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementBatchList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ProcessedEpochList) > 0 {
+		for iNdEx := len(m.ProcessedEpochList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProcessedEpochList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.PointGrantCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PointGrantCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.PointGrantList) > 0 {
+		for iNdEx := len(m.PointGrantList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PointGrantList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TransactionCheckpointList) > 0 {
+		for iNdEx := len(m.TransactionCheckpointList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransactionCheckpointList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FrozenAccountList) > 0 {
+		for iNdEx := len(m.FrozenAccountList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccountList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.VelocityUsageList) > 0 {
+		for iNdEx := len(m.VelocityUsageList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VelocityUsageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.VelocityOverrideList) > 0 {
+		for iNdEx := len(m.VelocityOverrideList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VelocityOverrideList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.SupplyList) > 0 {
+		for iNdEx := len(m.SupplyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
 				i -= size
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.TransactionCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TransactionCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.TransactionList) > 0 {
-		for iNdEx := len(m.TransactionList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TransactionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.MerchantCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MerchantCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.MerchantList) > 0 {
+		for iNdEx := len(m.MerchantList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerchantList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ProgramList) > 0 {
+		for iNdEx := len(m.ProgramList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProgramList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.PointLotCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PointLotCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PointLotList) > 0 {
+		for iNdEx := len(m.PointLotList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PointLotList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.IssuerList) > 0 {
+		for iNdEx := len(m.IssuerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssuerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SettlementCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SettlementCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SettlementList) > 0 {
+		for iNdEx := len(m.SettlementList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TransactionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TransactionCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TransactionList) > 0 {
+		for iNdEx := len(m.TransactionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransactionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PointBalanceMap) > 0 {
+		for iNdEx := len(m.PointBalanceMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PointBalanceMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PointBalanceMap) > 0 {
+		for _, e := range m.PointBalanceMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransactionList) > 0 {
+		for _, e := range m.TransactionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TransactionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TransactionCount))
+	}
+	if len(m.SettlementList) > 0 {
+		for _, e := range m.SettlementList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SettlementCount != 0 {
+		n += 1 + sovGenesis(uint64(m.SettlementCount))
+	}
+	if len(m.IssuerList) > 0 {
+		for _, e := range m.IssuerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PointLotList) > 0 {
+		for _, e := range m.PointLotList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PointLotCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PointLotCount))
+	}
+	if len(m.ProgramList) > 0 {
+		for _, e := range m.ProgramList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerchantList) > 0 {
+		for _, e := range m.MerchantList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MerchantCount != 0 {
+		n += 1 + sovGenesis(uint64(m.MerchantCount))
+	}
+	if len(m.SupplyList) > 0 {
+		for _, e := range m.SupplyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VelocityOverrideList) > 0 {
+		for _, e := range m.VelocityOverrideList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VelocityUsageList) > 0 {
+		for _, e := range m.VelocityUsageList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAccountList) > 0 {
+		for _, e := range m.FrozenAccountList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransactionCheckpointList) > 0 {
+		for _, e := range m.TransactionCheckpointList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PointGrantList) > 0 {
+		for _, e := range m.PointGrantList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PointGrantCount != 0 {
+		n += 2 + sovGenesis(uint64(m.PointGrantCount))
+	}
+	if len(m.ProcessedEpochList) > 0 {
+		for _, e := range m.ProcessedEpochList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettlementBatchList) > 0 {
+		for _, e := range m.SettlementBatchList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SettlementBatchCount != 0 {
+		n += 2 + sovGenesis(uint64(m.SettlementBatchCount))
+	}
+	if len(m.BalanceSnapshotList) > 0 {
+		for _, e := range m.BalanceSnapshotList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BalanceSnapshotCount != 0 {
+		n += 2 + sovGenesis(uint64(m.BalanceSnapshotCount))
+	}
+	if len(m.SnapshotBalanceList) > 0 {
+		for _, e := range m.SnapshotBalanceList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChangedBalanceList) > 0 {
+		for _, e := range m.ChangedBalanceList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizedLotList) > 0 {
+		for _, e := range m.TokenizedLotList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RefundableSpendList) > 0 {
+		for _, e := range m.RefundableSpendList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointBalanceMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointBalanceMap = append(m.PointBalanceMap, PointBalance{})
+			if err := m.PointBalanceMap[len(m.PointBalanceMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionList = append(m.TransactionList, Transaction{})
+			if err := m.TransactionList[len(m.TransactionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionCount", wireType)
+			}
+			m.TransactionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementList = append(m.SettlementList, Settlement{})
+			if err := m.SettlementList[len(m.SettlementList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementCount", wireType)
+			}
+			m.SettlementCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerList = append(m.IssuerList, Issuer{})
+			if err := m.IssuerList[len(m.IssuerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointLotList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointLotList = append(m.PointLotList, PointLot{})
+			if err := m.PointLotList[len(m.PointLotList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointLotCount", wireType)
+			}
+			m.PointLotCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointLotCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramList = append(m.ProgramList, PointProgram{})
+			if err := m.ProgramList[len(m.ProgramList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerchantList = append(m.MerchantList, Merchant{})
+			if err := m.MerchantList[len(m.MerchantList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantCount", wireType)
+			}
+			m.MerchantCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyList = append(m.SupplyList, PointSupply{})
+			if err := m.SupplyList[len(m.SupplyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityOverrideList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VelocityOverrideList = append(m.VelocityOverrideList, VelocityOverride{})
+			if err := m.VelocityOverrideList[len(m.VelocityOverrideList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityUsageList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VelocityUsageList = append(m.VelocityUsageList, VelocityUsage{})
+			if err := m.VelocityUsageList[len(m.VelocityUsageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccountList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccountList = append(m.FrozenAccountList, FrozenAccount{})
+			if err := m.FrozenAccountList[len(m.FrozenAccountList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionCheckpointList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionCheckpointList = append(m.TransactionCheckpointList, TransactionCheckpoint{})
+			if err := m.TransactionCheckpointList[len(m.TransactionCheckpointList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointGrantList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointGrantList = append(m.PointGrantList, PointGrant{})
+			if err := m.PointGrantList[len(m.PointGrantList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointGrantCount", wireType)
+			}
+			m.PointGrantCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointGrantCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.PointBalanceMap) > 0 {
-		for iNdEx := len(m.PointBalanceMap) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PointBalanceMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedEpochList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.PointBalanceMap) > 0 {
-		for _, e := range m.PointBalanceMap {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TransactionList) > 0 {
-		for _, e := range m.TransactionList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.TransactionCount != 0 {
-		n += 1 + sovGenesis(uint64(m.TransactionCount))
-	}
-	if len(m.SettlementList) > 0 {
-		for _, e := range m.SettlementList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.SettlementCount != 0 {
-		n += 1 + sovGenesis(uint64(m.SettlementCount))
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ProcessedEpochList = append(m.ProcessedEpochList, ProcessedEpoch{})
+			if err := m.ProcessedEpochList[len(m.ProcessedEpochList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementBatchList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -339,13 +1564,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SettlementBatchList = append(m.SettlementBatchList, SettlementBatch{})
+			if err := m.SettlementBatchList[len(m.SettlementBatchList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementBatchCount", wireType)
+			}
+			m.SettlementBatchCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementBatchCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PointBalanceMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceSnapshotList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -372,14 +1617,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PointBalanceMap = append(m.PointBalanceMap, PointBalance{})
-			if err := m.PointBalanceMap[len(m.PointBalanceMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BalanceSnapshotList = append(m.BalanceSnapshotList, BalanceSnapshot{})
+			if err := m.BalanceSnapshotList[len(m.BalanceSnapshotList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceSnapshotCount", wireType)
+			}
+			m.BalanceSnapshotCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceSnapshotCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotBalanceList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -406,16 +1670,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransactionList = append(m.TransactionList, Transaction{})
-			if err := m.TransactionList[len(m.TransactionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SnapshotBalanceList = append(m.SnapshotBalanceList, SnapshotBalance{})
+			if err := m.SnapshotBalanceList[len(m.SnapshotBalanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionCount", wireType)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedBalanceList", wireType)
 			}
-			m.TransactionCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -425,14 +1689,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TransactionCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedBalanceList = append(m.ChangedBalanceList, ChangedBalance{})
+			if err := m.ChangedBalanceList[len(m.ChangedBalanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizedLotList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -459,16 +1738,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlementList = append(m.SettlementList, Settlement{})
-			if err := m.SettlementList[len(m.SettlementList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenizedLotList = append(m.TokenizedLotList, PointLot{})
+			if err := m.TokenizedLotList[len(m.TokenizedLotList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementCount", wireType)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundableSpendList", wireType)
 			}
-			m.SettlementCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -478,11 +1757,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundableSpendList = append(m.RefundableSpendList, Transaction{})
+			if err := m.RefundableSpendList[len(m.RefundableSpendList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
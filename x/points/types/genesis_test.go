@@ -3,12 +3,19 @@ package types_test
 import (
 	"testing"
 
+	"scontract/testutil/sample"
 	"scontract/x/points/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	programs := []types.PointProgram{{Id: "p", Name: "P"}}
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	grant := func(id, released uint64) types.PointGrant {
+		return types.PointGrant{Id: id, ProgramId: "p", Issuer: alice, Beneficiary: bob, EpochIdentifier: "week", Periods: 4, AmountPerPeriod: 10, ReleasedPeriods: released, Status: types.PointGrantStatusActive}
+	}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -21,53 +28,78 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{PointBalanceMap: []types.PointBalance{{Index: "0"}, {Index: "1"}}, TransactionList: []types.Transaction{{Id: 0}, {Id: 1}}, TransactionCount: 2, SettlementList: []types.Settlement{{Id: 0}, {Id: 1}}, SettlementCount: 2}, valid: true,
+			genState: &types.GenesisState{ProgramList: programs, PointBalanceMap: []types.PointBalance{{ProgramId: "p", Index: "0"}, {ProgramId: "p", Index: "1"}}, TransactionList: []types.Transaction{{ProgramId: "p", Id: 0}, {ProgramId: "p", Id: 1}}, TransactionCount: 2, SettlementList: []types.Settlement{{ProgramId: "p", Id: 0}, {ProgramId: "p", Id: 1}}, SettlementCount: 2, IssuerList: []types.Issuer{{Address: "0"}, {Address: "1"}}}, valid: true,
 		}, {
 			desc: "duplicated pointBalance",
 			genState: &types.GenesisState{
+				ProgramList: programs,
 				PointBalanceMap: []types.PointBalance{
 					{
-						Index: "0",
+						ProgramId: "p",
+						Index:     "0",
 					},
 					{
-						Index: "0",
+						ProgramId: "p",
+						Index:     "0",
 					},
 				},
-				TransactionList: []types.Transaction{{Id: 0}, {Id: 1}}, TransactionCount: 2,
-				SettlementList: []types.Settlement{{Id: 0}, {Id: 1}}, SettlementCount: 2}, valid: false,
+				TransactionList: []types.Transaction{{ProgramId: "p", Id: 0}, {ProgramId: "p", Id: 1}}, TransactionCount: 2,
+				SettlementList: []types.Settlement{{ProgramId: "p", Id: 0}, {ProgramId: "p", Id: 1}}, SettlementCount: 2}, valid: false,
 		}, {
 			desc: "duplicated transaction",
 			genState: &types.GenesisState{
+				ProgramList: programs,
 				TransactionList: []types.Transaction{
 					{
-						Id: 0,
+						ProgramId: "p",
+						Id:        0,
 					},
 					{
-						Id: 0,
+						ProgramId: "p",
+						Id:        0,
 					},
 				},
-				SettlementList: []types.Settlement{{Id: 0}, {Id: 1}}, SettlementCount: 2,
+				SettlementList: []types.Settlement{{ProgramId: "p", Id: 0}, {ProgramId: "p", Id: 1}}, SettlementCount: 2,
 			}, valid: false,
 		}, {
 			desc: "invalid transaction count",
 			genState: &types.GenesisState{
+				ProgramList: programs,
 				TransactionList: []types.Transaction{
 					{
-						Id: 1,
+						ProgramId: "p",
+						Id:        1,
 					},
 				},
 				TransactionCount: 0,
-				SettlementList:   []types.Settlement{{Id: 0}, {Id: 1}}, SettlementCount: 2,
+				SettlementList:   []types.Settlement{{ProgramId: "p", Id: 0}, {ProgramId: "p", Id: 1}}, SettlementCount: 2,
 			}, valid: false,
 		}, {
 			desc: "duplicated settlement",
 			genState: &types.GenesisState{
+				ProgramList: programs,
 				SettlementList: []types.Settlement{
 					{
-						Id: 0,
+						ProgramId: "p",
+						Id:        0,
+					},
+					{
+						ProgramId: "p",
+						Id:        0,
+					},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated issuer",
+			genState: &types.GenesisState{
+				ProgramList: programs,
+				IssuerList: []types.Issuer{
+					{
+						Address: "0",
 					},
 					{
-						Id: 0,
+						Address: "0",
 					},
 				},
 			},
@@ -75,14 +107,345 @@ func TestGenesisState_Validate(t *testing.T) {
 		}, {
 			desc: "invalid settlement count",
 			genState: &types.GenesisState{
+				ProgramList: programs,
 				SettlementList: []types.Settlement{
 					{
-						Id: 1,
+						ProgramId: "p",
+						Id:        1,
 					},
 				},
 				SettlementCount: 0,
 			},
 			valid: false,
+		}, {
+			desc: "point lots do not sum to balance",
+			genState: &types.GenesisState{
+				ProgramList:     programs,
+				PointBalanceMap: []types.PointBalance{{ProgramId: "p", Index: "0", Balance: 10}},
+				PointLotList:    []types.PointLot{{ProgramId: "p", Id: 0, Owner: "0", Amount: 5}},
+				PointLotCount:   1,
+			},
+			valid: false,
+		}, {
+			desc: "invalid point lot count",
+			genState: &types.GenesisState{
+				ProgramList:     programs,
+				PointBalanceMap: []types.PointBalance{{ProgramId: "p", Index: "0", Balance: 5}},
+				PointLotList:    []types.PointLot{{ProgramId: "p", Id: 1, Owner: "0", Amount: 5}},
+				PointLotCount:   1,
+			},
+			valid: false,
+		}, {
+			desc: "balances of an address in several programs",
+			genState: &types.GenesisState{
+				ProgramList:     append(programs, types.PointProgram{Id: "q", Name: "Q"}),
+				PointBalanceMap: []types.PointBalance{{ProgramId: "p", Index: "0"}, {ProgramId: "q", Index: "0"}},
+			},
+			valid: true,
+		}, {
+			desc: "unknown program",
+			genState: &types.GenesisState{
+				ProgramList:     programs,
+				PointBalanceMap: []types.PointBalance{{ProgramId: "q", Index: "0"}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated program",
+			genState: &types.GenesisState{
+				ProgramList: append(programs, programs...),
+			},
+			valid: false,
+		}, {
+			desc: "invalid program id",
+			genState: &types.GenesisState{
+				ProgramList: []types.PointProgram{{Id: "Not Valid", Name: "P"}},
+			},
+			valid: false,
+		}, {
+			desc: "valid merchants",
+			genState: &types.GenesisState{
+				MerchantList:  []types.Merchant{{Id: 0, Name: "A", Owner: "0", SettlementAddress: "0"}, {Id: 1, Name: "B", Owner: "1", SettlementAddress: "1"}},
+				MerchantCount: 2,
+			},
+			valid: true,
+		}, {
+			desc: "duplicated merchant",
+			genState: &types.GenesisState{
+				MerchantList:  []types.Merchant{{Id: 0, Name: "A", Owner: "0", SettlementAddress: "0"}, {Id: 0, Name: "B", Owner: "1", SettlementAddress: "1"}},
+				MerchantCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "invalid merchant count",
+			genState: &types.GenesisState{
+				MerchantList:  []types.Merchant{{Id: 1, Name: "A", Owner: "0", SettlementAddress: "0"}},
+				MerchantCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "valid supply",
+			genState: &types.GenesisState{
+				ProgramList: programs,
+				SupplyList:  []types.PointSupply{{ProgramId: "p", Issued: 10, Settled: 4, Expired: 6}},
+			},
+			valid: true,
+		}, {
+			desc: "duplicated supply",
+			genState: &types.GenesisState{
+				ProgramList: programs,
+				SupplyList:  []types.PointSupply{{ProgramId: "p"}, {ProgramId: "p"}},
+			},
+			valid: false,
+		}, {
+			desc: "supply of unknown program",
+			genState: &types.GenesisState{
+				ProgramList: programs,
+				SupplyList:  []types.PointSupply{{ProgramId: "q"}},
+			},
+			valid: false,
+		}, {
+			desc: "supply out of more points than issued",
+			genState: &types.GenesisState{
+				ProgramList: programs,
+				SupplyList:  []types.PointSupply{{ProgramId: "p", Issued: 10, Settled: 4, Expired: 7}},
+			},
+			valid: false,
+		}, {
+			desc: "valid tokenized lots",
+			genState: &types.GenesisState{
+				ProgramList:      programs,
+				SupplyList:       []types.PointSupply{{ProgramId: "p", Issued: 10, Tokenized: 4}},
+				TokenizedLotList: []types.PointLot{{ProgramId: "p", Id: 0, Amount: 3, IssuedAt: 1}, {ProgramId: "p", Id: 1, Amount: 1, IssuedAt: 2}},
+				PointLotCount:    2,
+			},
+			valid: true,
+		}, {
+			desc: "tokenized lots not matching the tokenized supply",
+			genState: &types.GenesisState{
+				ProgramList:      programs,
+				SupplyList:       []types.PointSupply{{ProgramId: "p", Issued: 10, Tokenized: 4}},
+				TokenizedLotList: []types.PointLot{{ProgramId: "p", Id: 0, Amount: 3, IssuedAt: 1}},
+				PointLotCount:    1,
+			},
+			valid: false,
+		}, {
+			desc: "tokenized lot with an owner",
+			genState: &types.GenesisState{
+				ProgramList:      programs,
+				SupplyList:       []types.PointSupply{{ProgramId: "p", Issued: 10, Tokenized: 3}},
+				TokenizedLotList: []types.PointLot{{ProgramId: "p", Id: 0, Owner: alice, Amount: 3, IssuedAt: 1}},
+				PointLotCount:    1,
+			},
+			valid: false,
+		}, {
+			desc: "valid refundable spend",
+			genState: &types.GenesisState{
+				ProgramList:         programs,
+				TransactionList:     []types.Transaction{{ProgramId: "p", Id: 1}},
+				RefundableSpendList: []types.Transaction{{ProgramId: "p", Id: 0, Amount: 5, Refunded: 2, TxType: types.TransactionTypeSpend}},
+				TransactionCount:    2,
+			},
+			valid: true,
+		}, {
+			desc: "refundable spend also in the transaction list",
+			genState: &types.GenesisState{
+				ProgramList:         programs,
+				TransactionList:     []types.Transaction{{ProgramId: "p", Id: 0, Amount: 5, TxType: types.TransactionTypeSpend}},
+				RefundableSpendList: []types.Transaction{{ProgramId: "p", Id: 0, Amount: 5, TxType: types.TransactionTypeSpend}},
+				TransactionCount:    1,
+			},
+			valid: false,
+		}, {
+			desc: "fully refunded refundable spend",
+			genState: &types.GenesisState{
+				ProgramList:         programs,
+				RefundableSpendList: []types.Transaction{{ProgramId: "p", Id: 0, Amount: 5, Refunded: 5, TxType: types.TransactionTypeSpend}},
+				TransactionCount:    1,
+			},
+			valid: false,
+		}, {
+			desc: "valid velocity state",
+			genState: &types.GenesisState{
+				VelocityOverrideList: []types.VelocityOverride{{Address: alice, Limits: types.VelocityLimits{DailySpend: 10}}},
+				VelocityUsageList: []types.VelocityUsage{
+					{Kind: types.VelocitySpend, Address: alice, Bucket: 1, Amount: 5},
+					{Kind: types.VelocityIssue, Address: alice, Counterparty: bob, Bucket: 1, Amount: 5},
+				},
+			},
+			valid: true,
+		}, {
+			desc: "duplicated velocity override",
+			genState: &types.GenesisState{
+				VelocityOverrideList: []types.VelocityOverride{{Address: alice}, {Address: alice}},
+			},
+			valid: false,
+		}, {
+			desc: "velocity override with a daily limit above the weekly one",
+			genState: &types.GenesisState{
+				VelocityOverrideList: []types.VelocityOverride{{Address: alice, Limits: types.VelocityLimits{DailyTransfer: 20, WeeklyTransfer: 10}}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated velocity usage",
+			genState: &types.GenesisState{
+				VelocityUsageList: []types.VelocityUsage{
+					{Kind: types.VelocitySpend, Address: alice, Bucket: 1, Amount: 5},
+					{Kind: types.VelocitySpend, Address: alice, Bucket: 1, Amount: 1},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "velocity usage of unknown kind",
+			genState: &types.GenesisState{
+				VelocityUsageList: []types.VelocityUsage{{Kind: "settle", Address: alice, Bucket: 1, Amount: 5}},
+			},
+			valid: false,
+		}, {
+			desc: "issue velocity usage without recipient",
+			genState: &types.GenesisState{
+				VelocityUsageList: []types.VelocityUsage{{Kind: types.VelocityIssue, Address: alice, Bucket: 1, Amount: 5}},
+			},
+			valid: false,
+		}, {
+			desc: "transaction refunded more than its amount",
+			genState: &types.GenesisState{
+				ProgramList:      programs,
+				TransactionList:  []types.Transaction{{ProgramId: "p", Id: 0, Amount: 5, Refunded: 6, TxType: types.TransactionTypeSpend}},
+				TransactionCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "valid frozen accounts",
+			genState: &types.GenesisState{
+				FrozenAccountList: []types.FrozenAccount{{Address: alice, Reason: "court order"}, {Address: bob, ExpiresAt: 100}},
+			},
+			valid: true,
+		}, {
+			desc: "duplicated frozen account",
+			genState: &types.GenesisState{
+				FrozenAccountList: []types.FrozenAccount{{Address: alice}, {Address: alice}},
+			},
+			valid: false,
+		}, {
+			desc: "invalid compliance officer",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultIssuerEpochDuration, nil, "", types.DefaultSettlementRate, 0, types.VelocityLimits{}, []string{"invalid"}, types.DefaultMetadataLimits, types.DefaultFeePayment, types.DefaultTransactionRetention, "", types.DefaultTransferFee, ""),
+			},
+			valid: false,
+		}, {
+			desc: "valid transaction checkpoints",
+			genState: &types.GenesisState{
+				ProgramList:               programs,
+				TransactionCount:          5,
+				TransactionCheckpointList: []types.TransactionCheckpoint{{ProgramId: "p", Address: alice, TransactionCount: 2, LastTransactionId: 4}, {ProgramId: "p", Address: bob}},
+			},
+			valid: true,
+		}, {
+			desc: "duplicated transaction checkpoint",
+			genState: &types.GenesisState{
+				ProgramList:               programs,
+				TransactionCheckpointList: []types.TransactionCheckpoint{{ProgramId: "p", Address: alice}, {ProgramId: "p", Address: alice}},
+			},
+			valid: false,
+		}, {
+			desc: "transaction checkpoint past the last transaction",
+			genState: &types.GenesisState{
+				ProgramList:               programs,
+				TransactionCount:          5,
+				TransactionCheckpointList: []types.TransactionCheckpoint{{ProgramId: "p", Address: alice, TransactionCount: 1, LastTransactionId: 5}},
+			},
+			valid: false,
+		}, {
+			desc: "valid point grants",
+			genState: &types.GenesisState{
+				ProgramList:        programs,
+				PointGrantList:     []types.PointGrant{grant(0, 1), grant(1, 0)},
+				PointGrantCount:    2,
+				ProcessedEpochList: []types.ProcessedEpoch{{Identifier: "week", EpochNumber: 3}, {Identifier: "day", EpochNumber: 20}},
+			},
+			valid: true,
+		}, {
+			desc: "point grant past the grant count",
+			genState: &types.GenesisState{
+				ProgramList:     programs,
+				PointGrantList:  []types.PointGrant{grant(1, 0)},
+				PointGrantCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "point grant released past its periods",
+			genState: &types.GenesisState{
+				ProgramList:     programs,
+				PointGrantList:  []types.PointGrant{grant(0, 5)},
+				PointGrantCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated processed epoch",
+			genState: &types.GenesisState{
+				ProcessedEpochList: []types.ProcessedEpoch{{Identifier: "week", EpochNumber: 3}, {Identifier: "week", EpochNumber: 4}},
+			},
+			valid: false,
+		}, {
+			desc: "valid settlement batch",
+			genState: &types.GenesisState{
+				ProgramList:          programs,
+				SettlementList:       []types.Settlement{{ProgramId: "p", Id: 0}, {ProgramId: "p", Id: 1}},
+				SettlementCount:      2,
+				SettlementBatchList:  []types.SettlementBatch{{Id: 0, ProgramId: "p", Requester: alice, SettlementIds: []uint64{0, 1}, MerkleRoot: types.SettlementBatchRoot([]uint64{0, 1}), Status: types.SettlementBatchStatusPending}},
+				SettlementBatchCount: 1,
+			},
+			valid: true,
+		}, {
+			desc: "settlement batch with unknown settlement",
+			genState: &types.GenesisState{
+				ProgramList:          programs,
+				SettlementList:       []types.Settlement{{ProgramId: "p", Id: 0}},
+				SettlementCount:      2,
+				SettlementBatchList:  []types.SettlementBatch{{Id: 0, ProgramId: "p", Requester: alice, SettlementIds: []uint64{0, 1}, MerkleRoot: types.SettlementBatchRoot([]uint64{0, 1}), Status: types.SettlementBatchStatusPending}},
+				SettlementBatchCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "valid balance snapshots",
+			genState: &types.GenesisState{
+				ProgramList:          programs,
+				BalanceSnapshotList:  []types.BalanceSnapshot{{Id: 0, Timestamp: 10}, {Id: 1, Timestamp: 20}},
+				BalanceSnapshotCount: 2,
+				SnapshotBalanceList:  []types.SnapshotBalance{{SnapshotId: 0, ProgramId: "p", Address: alice, Balance: 5}, {SnapshotId: 1, ProgramId: "p", Address: alice}},
+				ChangedBalanceList:   []types.ChangedBalance{{ProgramId: "p", Address: alice}},
+			},
+			valid: true,
+		}, {
+			desc: "snapshot balance of unknown snapshot",
+			genState: &types.GenesisState{
+				ProgramList:          programs,
+				BalanceSnapshotList:  []types.BalanceSnapshot{{Id: 0, Timestamp: 10}},
+				BalanceSnapshotCount: 1,
+				SnapshotBalanceList:  []types.SnapshotBalance{{SnapshotId: 1, ProgramId: "p", Address: alice}},
+			},
+			valid: false,
+		}, {
+			desc: "balance snapshot past the snapshot count",
+			genState: &types.GenesisState{
+				BalanceSnapshotList:  []types.BalanceSnapshot{{Id: 1, Timestamp: 10}},
+				BalanceSnapshotCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated changed balance",
+			genState: &types.GenesisState{
+				ProgramList:        programs,
+				ChangedBalanceList: []types.ChangedBalance{{ProgramId: "p", Address: alice}, {ProgramId: "p", Address: alice}},
+			},
+			valid: false,
+		}, {
+			desc: "merchant without settlement address",
+			genState: &types.GenesisState{
+				MerchantList:  []types.Merchant{{Id: 0, Name: "A", Owner: "0"}},
+				MerchantCount: 1,
+			},
+			valid: false,
 		},
 	}
 	for _, tc := range tests {
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	BalanceSnapshotKey      = collections.NewPrefix("balanceSnapshot/value/")
+	BalanceSnapshotCountKey = collections.NewPrefix("balanceSnapshot/count/")
+	SnapshotBalanceKey      = collections.NewPrefix("snapshotBalance/value/")
+	ChangedBalanceKey       = collections.NewPrefix("changedBalance/value/")
+)
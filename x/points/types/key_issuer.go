@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// IssuerKey is the prefix to retrieve all Issuer
+var IssuerKey = collections.NewPrefix("issuer/value/")
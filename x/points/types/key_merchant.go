@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	MerchantKey      = collections.NewPrefix("merchant/value/")
+	MerchantCountKey = collections.NewPrefix("merchant/count/")
+)
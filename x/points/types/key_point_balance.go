@@ -4,3 +4,13 @@ import "cosmossdk.io/collections"
 
 // PointBalanceKey is the prefix to retrieve all PointBalance
 var PointBalanceKey = collections.NewPrefix("pointBalance/value/")
+
+var (
+	PointLotKey         = collections.NewPrefix("pointLot/value/")
+	PointLotCountKey    = collections.NewPrefix("pointLot/count/")
+	PointLotByExpiryKey = collections.NewPrefix("pointLot/expiry/")
+	TokenizedLotKey     = collections.NewPrefix("tokenizedLot/value/")
+)
+
+// ExpiryBatchSize is the maximum number of point lots expired per block.
+const ExpiryBatchSize = 100
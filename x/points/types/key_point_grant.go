@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	PointGrantKey              = collections.NewPrefix("pointGrant/value/")
+	PointGrantCountKey         = collections.NewPrefix("pointGrant/count/")
+	PointGrantByBeneficiaryKey = collections.NewPrefix("pointGrant/beneficiary/")
+	PointGrantByEpochKey       = collections.NewPrefix("pointGrant/epoch/")
+)
+
+// ProcessedEpochKey is the prefix to retrieve all ProcessedEpoch
+var ProcessedEpochKey = collections.NewPrefix("processedEpoch/value/")
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// SupplyKey is the prefix to retrieve all PointSupply
+var SupplyKey = collections.NewPrefix("supply/value/")
@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	SettlementBatchKey         = collections.NewPrefix("settlementBatch/value/")
+	SettlementBatchCountKey    = collections.NewPrefix("settlementBatch/count/")
+	SettlementBatchByStatusKey = collections.NewPrefix("settlementBatch/status/")
+)
@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	VelocityOverrideKey = collections.NewPrefix("velocity/override/")
+	VelocityUsageKey    = collections.NewPrefix("velocity/usage/")
+)
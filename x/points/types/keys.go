@@ -13,17 +13,38 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// TreasuryModuleName is the name of the module account approved
+	// settlements are paid out from.
+	TreasuryModuleName = ModuleName + "_treasury"
+
+	// FeeSponsorModuleName is the name of the module account that pays the
+	// fees of transactions paying their fee in points.
+	FeeSponsorModuleName = ModuleName + "_fee_sponsor"
 )
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_points")
 
 var (
-	TransactionKey      = collections.NewPrefix("transaction/value/")
-	TransactionCountKey = collections.NewPrefix("transaction/count/")
+	TransactionKey            = collections.NewPrefix("transaction/value/")
+	TransactionCountKey       = collections.NewPrefix("transaction/count/")
+	TransactionBySenderKey    = collections.NewPrefix("transaction/sender/")
+	TransactionByRecipientKey = collections.NewPrefix("transaction/recipient/")
+	TransactionByOrderRefKey  = collections.NewPrefix("transaction/order_reference/")
+	TransactionCheckpointKey  = collections.NewPrefix("transaction/checkpoint/")
+	RefundableSpendKey        = collections.NewPrefix("transaction/refundable/")
 )
 
+// PruneBatchSize is the maximum number of transactions pruned per block.
+const PruneBatchSize = 100
+
 var (
-	SettlementKey      = collections.NewPrefix("settlement/value/")
-	SettlementCountKey = collections.NewPrefix("settlement/count/")
+	SettlementKey            = collections.NewPrefix("settlement/value/")
+	SettlementCountKey       = collections.NewPrefix("settlement/count/")
+	SettlementByRequesterKey = collections.NewPrefix("settlement/requester/")
+	SettlementByStatusKey    = collections.NewPrefix("settlement/status/")
 )
+
+// ProgramKey is the prefix to retrieve all PointProgram
+var ProgramKey = collections.NewPrefix("program/value/")
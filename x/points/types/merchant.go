@@ -0,0 +1,18 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// Validate performs basic validation of the merchant. Addresses are only
+// checked for presence; the keeper decodes them with its address codec.
+func (m Merchant) Validate() error {
+	if m.Name == "" {
+		return errorsmod.Wrap(ErrInvalidMerchant, "merchant name cannot be empty")
+	}
+	if m.Owner == "" {
+		return errorsmod.Wrap(ErrInvalidMerchant, "merchant owner cannot be empty")
+	}
+	if m.SettlementAddress == "" {
+		return errorsmod.Wrap(ErrInvalidMerchant, "merchant settlement address cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package types
+
+func NewMsgAddIssuer(authority string, address string, epochCap uint64) *MsgAddIssuer {
+	return &MsgAddIssuer{
+		Authority: authority,
+		Address:   address,
+		EpochCap:  epochCap,
+	}
+}
@@ -0,0 +1,8 @@
+package types
+
+func NewMsgApproveMerchant(authority string, id uint64) *MsgApproveMerchant {
+	return &MsgApproveMerchant{
+		Authority: authority,
+		Id:        id,
+	}
+}
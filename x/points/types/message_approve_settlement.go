@@ -0,0 +1,9 @@
+package types
+
+func NewMsgApproveSettlement(creator string, programID string, id uint64) *MsgApproveSettlement {
+	return &MsgApproveSettlement{
+		Creator:   creator,
+		ProgramId: programID,
+		Id:        id,
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+const (
+	// MaxBatchIssueEntries is the most entries a MsgBatchIssuePoints may hold.
+	MaxBatchIssueEntries = 1000
+
+	// BatchIssueEntryGas is the gas charged for every entry of a
+	// MsgBatchIssuePoints, on top of the gas of the store accesses.
+	BatchIssueEntryGas = 10_000
+)
+
+func NewMsgBatchIssuePoints(creator string, programID string, entries []BatchIssueEntry) *MsgBatchIssuePoints {
+	return &MsgBatchIssuePoints{
+		Creator:   creator,
+		ProgramId: programID,
+		Entries:   entries,
+	}
+}
@@ -0,0 +1,9 @@
+package types
+
+func NewMsgCancelSettlement(creator string, programID string, id uint64) *MsgCancelSettlement {
+	return &MsgCancelSettlement{
+		Creator:   creator,
+		ProgramId: programID,
+		Id:        id,
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+func NewMsgConfirmBatchPaid(creator string, programID string, batchID uint64, paymentReference string) *MsgConfirmBatchPaid {
+	return &MsgConfirmBatchPaid{
+		Creator:          creator,
+		ProgramId:        programID,
+		BatchId:          batchID,
+		PaymentReference: paymentReference,
+	}
+}
@@ -0,0 +1,13 @@
+package types
+
+func NewMsgCreatePointGrant(creator string, programID string, beneficiary string, epochIdentifier string, periods uint64, amountPerPeriod uint64, revocable bool) *MsgCreatePointGrant {
+	return &MsgCreatePointGrant{
+		Creator:         creator,
+		ProgramId:       programID,
+		Beneficiary:     beneficiary,
+		EpochIdentifier: epochIdentifier,
+		Periods:         periods,
+		AmountPerPeriod: amountPerPeriod,
+		Revocable:       revocable,
+	}
+}
@@ -0,0 +1,11 @@
+package types
+
+func NewMsgCreateProgram(creator string, id string, name string, decimals uint32, issuers []string) *MsgCreateProgram {
+	return &MsgCreateProgram{
+		Creator:  creator,
+		Id:       id,
+		Name:     name,
+		Decimals: decimals,
+		Issuers:  issuers,
+	}
+}
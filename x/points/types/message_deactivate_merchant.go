@@ -0,0 +1,8 @@
+package types
+
+func NewMsgDeactivateMerchant(creator string, id uint64) *MsgDeactivateMerchant {
+	return &MsgDeactivateMerchant{
+		Creator: creator,
+		Id:      id,
+	}
+}
@@ -0,0 +1,9 @@
+package types
+
+func NewMsgDetokenizePoints(creator string, programID string, amount uint64) *MsgDetokenizePoints {
+	return &MsgDetokenizePoints{
+		Creator:   creator,
+		ProgramId: programID,
+		Amount:    amount,
+	}
+}
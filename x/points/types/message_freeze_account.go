@@ -0,0 +1,10 @@
+package types
+
+func NewMsgFreezeAccount(creator string, address string, reason string, blockCredits bool) *MsgFreezeAccount {
+	return &MsgFreezeAccount{
+		Creator:      creator,
+		Address:      address,
+		Reason:       reason,
+		BlockCredits: blockCredits,
+	}
+}
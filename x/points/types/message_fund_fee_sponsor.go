@@ -0,0 +1,10 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+func NewMsgFundFeeSponsor(creator string, amount sdk.Coins) *MsgFundFeeSponsor {
+	return &MsgFundFeeSponsor{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+func NewMsgFundTreasury(creator string, amount sdk.Coins) *MsgFundTreasury {
+	return &MsgFundTreasury{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
@@ -1,8 +1,9 @@
 package types
 
-func NewMsgIssuePoints(creator string, recipient string, amount uint64, reason string) *MsgIssuePoints {
+func NewMsgIssuePoints(creator string, programID string, recipient string, amount uint64, reason string) *MsgIssuePoints {
 	return &MsgIssuePoints{
 		Creator:   creator,
+		ProgramId: programID,
 		Recipient: recipient,
 		Amount:    amount,
 		Reason:    reason,
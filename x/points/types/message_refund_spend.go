@@ -0,0 +1,10 @@
+package types
+
+func NewMsgRefundSpend(creator string, programID string, transactionID uint64, amount uint64) *MsgRefundSpend {
+	return &MsgRefundSpend{
+		Creator:       creator,
+		ProgramId:     programID,
+		TransactionId: transactionID,
+		Amount:        amount,
+	}
+}
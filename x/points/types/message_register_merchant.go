@@ -0,0 +1,9 @@
+package types
+
+func NewMsgRegisterMerchant(creator string, name string, settlementAddress string) *MsgRegisterMerchant {
+	return &MsgRegisterMerchant{
+		Creator:           creator,
+		Name:              name,
+		SettlementAddress: settlementAddress,
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+func NewMsgRejectSettlement(creator string, programID string, id uint64, reason string) *MsgRejectSettlement {
+	return &MsgRejectSettlement{
+		Creator:   creator,
+		ProgramId: programID,
+		Id:        id,
+		Reason:    reason,
+	}
+}
@@ -0,0 +1,8 @@
+package types
+
+func NewMsgRemoveIssuer(authority string, address string) *MsgRemoveIssuer {
+	return &MsgRemoveIssuer{
+		Authority: authority,
+		Address:   address,
+	}
+}
@@ -0,0 +1,8 @@
+package types
+
+func NewMsgRemoveVelocityOverride(authority string, address string) *MsgRemoveVelocityOverride {
+	return &MsgRemoveVelocityOverride{
+		Authority: authority,
+		Address:   address,
+	}
+}
@@ -1,8 +1,9 @@
 package types
 
-func NewMsgRequestSettlement(creator string, amount uint64) *MsgRequestSettlement {
+func NewMsgRequestSettlement(creator string, programID string, amount uint64) *MsgRequestSettlement {
 	return &MsgRequestSettlement{
-		Creator: creator,
-		Amount:  amount,
+		Creator:   creator,
+		ProgramId: programID,
+		Amount:    amount,
 	}
 }
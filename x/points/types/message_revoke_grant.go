@@ -0,0 +1,9 @@
+package types
+
+func NewMsgRevokeGrant(creator string, programID string, grantID uint64) *MsgRevokeGrant {
+	return &MsgRevokeGrant{
+		Creator:   creator,
+		ProgramId: programID,
+		GrantId:   grantID,
+	}
+}
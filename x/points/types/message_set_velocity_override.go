@@ -0,0 +1,9 @@
+package types
+
+func NewMsgSetVelocityOverride(authority string, address string, limits VelocityLimits) *MsgSetVelocityOverride {
+	return &MsgSetVelocityOverride{
+		Authority: authority,
+		Address:   address,
+		Limits:    limits,
+	}
+}
@@ -1,8 +1,10 @@
 package types
 
-func NewMsgSpendPoints(creator string, amount uint64, description string) *MsgSpendPoints {
+func NewMsgSpendPoints(creator string, programID string, merchantID uint64, amount uint64, description string) *MsgSpendPoints {
 	return &MsgSpendPoints{
 		Creator:     creator,
+		ProgramId:   programID,
+		MerchantId:  merchantID,
 		Amount:      amount,
 		Description: description,
 	}
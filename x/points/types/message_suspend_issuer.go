@@ -0,0 +1,9 @@
+package types
+
+func NewMsgSuspendIssuer(authority string, address string, suspended bool) *MsgSuspendIssuer {
+	return &MsgSuspendIssuer{
+		Authority: authority,
+		Address:   address,
+		Suspended: suspended,
+	}
+}
@@ -0,0 +1,9 @@
+package types
+
+func NewMsgTokenizePoints(creator string, programID string, amount uint64) *MsgTokenizePoints {
+	return &MsgTokenizePoints{
+		Creator:   creator,
+		ProgramId: programID,
+		Amount:    amount,
+	}
+}
@@ -1,8 +1,9 @@
 package types
 
-func NewMsgTransferPoints(creator string, recipient string, amount uint64) *MsgTransferPoints {
+func NewMsgTransferPoints(creator string, programID string, recipient string, amount uint64) *MsgTransferPoints {
 	return &MsgTransferPoints{
 		Creator:   creator,
+		ProgramId: programID,
 		Recipient: recipient,
 		Amount:    amount,
 	}
@@ -0,0 +1,8 @@
+package types
+
+func NewMsgUnfreezeAccount(creator string, address string) *MsgUnfreezeAccount {
+	return &MsgUnfreezeAccount{
+		Creator: creator,
+		Address: address,
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+func NewMsgUpdateMerchant(creator string, id uint64, name string, settlementAddress string) *MsgUpdateMerchant {
+	return &MsgUpdateMerchant{
+		Creator:           creator,
+		Id:                id,
+		Name:              name,
+		SettlementAddress: settlementAddress,
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+func NewMsgWithdrawTreasuryPoints(authority string, programID string, recipient string, amount uint64) *MsgWithdrawTreasuryPoints {
+	return &MsgWithdrawTreasuryPoints{
+		Authority: authority,
+		ProgramId: programID,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
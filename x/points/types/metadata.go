@@ -0,0 +1,64 @@
+package types
+
+import "fmt"
+
+// MaxMetadataLimit is the highest value params may set a metadata limit to.
+const MaxMetadataLimit = 64 * 1024
+
+// Validate checks the memo, order reference and metadata of a transaction
+// against the limits.
+func (l MetadataLimits) Validate(memo, orderReference string, metadata []TransactionMetadata) error {
+	if exceeds(len(memo), l.MaxMemoLength) {
+		return fmt.Errorf("memo is longer than %d bytes", l.MaxMemoLength)
+	}
+	if exceeds(len(orderReference), l.MaxOrderReferenceLength) {
+		return fmt.Errorf("order reference is longer than %d bytes", l.MaxOrderReferenceLength)
+	}
+	if exceeds(len(metadata), l.MaxEntries) {
+		return fmt.Errorf("more than %d metadata entries", l.MaxEntries)
+	}
+
+	seen := make(map[string]struct{}, len(metadata))
+	for _, entry := range metadata {
+		if entry.Key == "" {
+			return fmt.Errorf("metadata key cannot be empty")
+		}
+		if _, ok := seen[entry.Key]; ok {
+			return fmt.Errorf("duplicated metadata key %q", entry.Key)
+		}
+		seen[entry.Key] = struct{}{}
+		if exceeds(len(entry.Key), l.MaxKeyLength) {
+			return fmt.Errorf("metadata key %q is longer than %d bytes", entry.Key, l.MaxKeyLength)
+		}
+		if exceeds(len(entry.Value), l.MaxValueLength) {
+			return fmt.Errorf("metadata value of %q is longer than %d bytes", entry.Key, l.MaxValueLength)
+		}
+	}
+
+	return nil
+}
+
+// validateMetadataLimits checks that no metadata limit is above
+// MaxMetadataLimit.
+func validateMetadataLimits(l MetadataLimits) error {
+	for _, limit := range []struct {
+		name  string
+		value uint32
+	}{
+		{"memo length", l.MaxMemoLength},
+		{"order reference length", l.MaxOrderReferenceLength},
+		{"metadata entries", l.MaxEntries},
+		{"metadata key length", l.MaxKeyLength},
+		{"metadata value length", l.MaxValueLength},
+	} {
+		if limit.value > MaxMetadataLimit {
+			return fmt.Errorf("%s limit %d exceeds %d", limit.name, limit.value, MaxMetadataLimit)
+		}
+	}
+	return nil
+}
+
+// exceeds reports whether n is above limit, a zero limit being unlimited.
+func exceeds(n int, limit uint32) bool {
+	return limit > 0 && n > int(limit)
+}
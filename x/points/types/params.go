@@ -1,17 +1,280 @@
 package types
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultIssuerEpochDuration is the default length of an issuer cap epoch.
+	DefaultIssuerEpochDuration = 24 * time.Hour
+
+	// MaxEpochIdentifierLength is the longest x/epochs identifier params may
+	// refer to.
+	MaxEpochIdentifierLength = 64
+
+	// DefaultSettlementDenom is the default denom settlements are paid out in.
+	DefaultSettlementDenom = "sjcoin"
+
+	// DefaultPointExpiry is how long issued points stay valid by default.
+	DefaultPointExpiry = 365 * 24 * time.Hour
+)
+
+// DefaultMetadataLimits are the default bounds of the data attached to transactions.
+var DefaultMetadataLimits = MetadataLimits{
+	MaxMemoLength:           256,
+	MaxOrderReferenceLength: 64,
+	MaxEntries:              16,
+	MaxKeyLength:            64,
+	MaxValueLength:          256,
+}
+
+// DefaultSettlementRate is the default amount of the settlement denom paid per point.
+var DefaultSettlementRate = math.LegacyOneDec()
+
+// DefaultFeePayment leaves paying transaction fees in points disabled.
+var DefaultFeePayment = FeePayment{PointsPerUnit: math.LegacyZeroDec()}
+
+// DefaultTransferFee leaves point transfers free.
+var DefaultTransferFee = TransferFee{Rate: math.LegacyZeroDec()}
+
+// DefaultTransactionRetention keeps every transaction record.
+var DefaultTransactionRetention = TransactionRetention{}
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	issuerEpochDuration time.Duration,
+	settlers []string,
+	settlementDenom string,
+	settlementRate math.LegacyDec,
+	pointExpiry time.Duration,
+	velocityLimits VelocityLimits,
+	complianceOfficers []string,
+	metadataLimits MetadataLimits,
+	feePayment FeePayment,
+	transactionRetention TransactionRetention,
+	settlementBatchEpoch string,
+	transferFee TransferFee,
+	balanceSnapshotEpoch string,
+) Params {
+	return Params{
+		IssuerEpochDuration: issuerEpochDuration,
+		Settlers:            settlers,
+		SettlementDenom:     settlementDenom,
+		SettlementRate:      settlementRate,
+		PointExpiry:         pointExpiry,
+		VelocityLimits:      velocityLimits,
+		ComplianceOfficers:  complianceOfficers,
+		MetadataLimits:      metadataLimits,
+		FeePayment:          feePayment,
+
+		TransactionRetention: transactionRetention,
+		SettlementBatchEpoch: settlementBatchEpoch,
+		TransferFee:          transferFee,
+		BalanceSnapshotEpoch: balanceSnapshotEpoch,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultIssuerEpochDuration, nil, DefaultSettlementDenom, DefaultSettlementRate, DefaultPointExpiry, VelocityLimits{}, nil, DefaultMetadataLimits, DefaultFeePayment, DefaultTransactionRetention, "", DefaultTransferFee, "")
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if p.IssuerEpochDuration < 0 {
+		return fmt.Errorf("issuer epoch duration cannot be negative: %s", p.IssuerEpochDuration)
+	}
+	if p.IssuerEpochDuration > 0 && p.IssuerEpochDuration < time.Second {
+		return fmt.Errorf("issuer epoch duration must be at least one second: %s", p.IssuerEpochDuration)
+	}
+	if p.PointExpiry < 0 {
+		return fmt.Errorf("point expiry cannot be negative: %s", p.PointExpiry)
+	}
+	if p.TransactionRetention.MaxAge < 0 {
+		return fmt.Errorf("transaction retention age cannot be negative: %s", p.TransactionRetention.MaxAge)
+	}
+	if err := p.VelocityLimits.Validate(); err != nil {
+		return fmt.Errorf("invalid velocity limits: %w", err)
+	}
+	if err := validateMetadataLimits(p.MetadataLimits); err != nil {
+		return err
+	}
+	if err := validateAddresses("settler", p.Settlers); err != nil {
+		return err
+	}
+	if err := validateAddresses("compliance officer", p.ComplianceOfficers); err != nil {
+		return err
+	}
+	if p.SettlementDenom != "" {
+		if err := sdk.ValidateDenom(p.SettlementDenom); err != nil {
+			return fmt.Errorf("invalid settlement denom: %w", err)
+		}
+		if p.SettlementRate.IsNil() || !p.SettlementRate.IsPositive() {
+			return fmt.Errorf("settlement rate must be positive: %s", p.SettlementRate)
+		}
+	}
+	if p.FeePayment.Denom != "" {
+		if err := sdk.ValidateDenom(p.FeePayment.Denom); err != nil {
+			return fmt.Errorf("invalid fee payment denom: %w", err)
+		}
+		if p.FeePayment.PointsPerUnit.IsNil() || !p.FeePayment.PointsPerUnit.IsPositive() {
+			return fmt.Errorf("fee payment rate must be positive: %s", p.FeePayment.PointsPerUnit)
+		}
+	}
+	if err := validateEpochIdentifier("settlement batch", p.SettlementBatchEpoch); err != nil {
+		return err
+	}
+	if err := validateEpochIdentifier("balance snapshot", p.BalanceSnapshotEpoch); err != nil {
+		return err
+	}
+	if err := p.TransferFee.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IssuerEpochNumber returns the issuer cap epoch the given block time falls into.
+// A zero epoch duration puts every block in epoch zero, turning issuer caps
+// into lifetime caps.
+func (p Params) IssuerEpochNumber(blockTime time.Time) int64 {
+	seconds := int64(p.IssuerEpochDuration / time.Second)
+	if seconds == 0 {
+		return 0
+	}
+	return blockTime.Unix() / seconds
+}
+
+// IsSettler reports whether address may decide on settlements.
+func (p Params) IsSettler(address string) bool {
+	for _, settler := range p.Settlers {
+		if settler == address {
+			return true
+		}
+	}
+	return false
+}
+
+// IsComplianceOfficer reports whether address may freeze and unfreeze accounts.
+func (p Params) IsComplianceOfficer(address string) bool {
+	for _, officer := range p.ComplianceOfficers {
+		if officer == address {
+			return true
+		}
+	}
+	return false
+}
+
+// PointExpiresAt returns the unix time points issued at issuedAt expire, or
+// zero if they never expire. A non-nil override replaces the point expiry
+// param.
+func (p Params) PointExpiresAt(issuedAt time.Time, override *time.Duration) int64 {
+	expiry := p.PointExpiry
+	if override != nil {
+		expiry = *override
+	}
+	if expiry == 0 {
+		return 0
+	}
+	return issuedAt.Add(expiry).Unix()
+}
+
+// SettlementPayout returns the coins paid out for settling amount points,
+// rounded down. ok is false while payouts are disabled.
+func (p Params) SettlementPayout(amount uint64) (payout sdk.Coin, ok bool) {
+	if p.SettlementDenom == "" {
+		return sdk.Coin{}, false
+	}
+	paid := p.SettlementRate.MulInt(math.NewIntFromUint64(amount)).TruncateInt()
+	return sdk.NewCoin(p.SettlementDenom, paid), true
+}
+
+// FeePoints returns the points charged for paying fee in points, rounded up.
+// It fails if fee payment is disabled or fee holds another denom.
+func (f FeePayment) FeePoints(fee sdk.Coins) (uint64, error) {
+	if f.Denom == "" {
+		return 0, fmt.Errorf("paying fees in points is disabled")
+	}
+	for _, coin := range fee {
+		if coin.Denom != f.Denom {
+			return 0, fmt.Errorf("only %s fees can be paid in points, got %s", f.Denom, fee)
+		}
+	}
+	points := f.PointsPerUnit.MulInt(fee.AmountOf(f.Denom)).Ceil().TruncateInt()
+	if !points.IsUint64() {
+		return 0, fmt.Errorf("fee %s is too large to be paid in points", fee)
+	}
+	return points.Uint64(), nil
+}
+
+// Validate checks the transfer fee rate, bounds and exempt addresses.
+func (f TransferFee) Validate() error {
+	if !f.Rate.IsNil() && (f.Rate.IsNegative() || f.Rate.GTE(math.LegacyOneDec())) {
+		return fmt.Errorf("transfer fee rate must be at least zero and below one: %s", f.Rate)
+	}
+	if f.Max > 0 && f.Min > f.Max {
+		return fmt.Errorf("transfer fee min %d exceeds max %d", f.Min, f.Max)
+	}
+	return validateAddresses("transfer fee exempt", f.Exempt)
+}
+
+// IsExempt reports whether the transfers address sends or receives are free.
+func (f TransferFee) IsExempt(address string) bool {
+	return slices.Contains(f.Exempt, address)
+}
+
+// Fee returns the fee charged for transferring amount points from sender to
+// recipient.
+func (f TransferFee) Fee(amount uint64, sender, recipient string) (uint64, error) {
+	if f.IsExempt(sender) || f.IsExempt(recipient) {
+		return 0, nil
+	}
+	fee := math.NewIntFromUint64(f.Flat)
+	if !f.Rate.IsNil() {
+		fee = fee.Add(f.Rate.MulInt(math.NewIntFromUint64(amount)).Ceil().TruncateInt())
+	}
+	if minFee := math.NewIntFromUint64(f.Min); fee.LT(minFee) {
+		fee = minFee
+	}
+	if f.Max > 0 {
+		fee = math.MinInt(fee, math.NewIntFromUint64(f.Max))
+	}
+	if !fee.IsUint64() {
+		return 0, fmt.Errorf("transfer fee of %d points overflows", amount)
+	}
+	return fee.Uint64(), nil
+}
+
+// validateEpochIdentifier checks that a set epoch identifier param could name
+// an x/epochs epoch. An empty identifier disables what the param schedules.
+func validateEpochIdentifier(role, identifier string) error {
+	if len(identifier) > MaxEpochIdentifierLength {
+		return fmt.Errorf("%s epoch identifier is longer than %d bytes", role, MaxEpochIdentifierLength)
+	}
+	if strings.IndexFunc(identifier, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("%s epoch identifier %q cannot contain spaces", role, identifier)
+	}
+	return nil
+}
 
+// validateAddresses checks that addresses are valid and unique.
+func validateAddresses(role string, addresses []string) error {
+	seen := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid %s address %q: %w", role, address, err)
+		}
+		if _, ok := seen[address]; ok {
+			return fmt.Errorf("duplicated %s address %s", role, address)
+		}
+		seen[address] = struct{}{}
+	}
 	return nil
 }
@@ -4,19 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +32,44 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// issuer_epoch_duration is the length of the epoch issuer caps apply to.
+	IssuerEpochDuration time.Duration `protobuf:"bytes,1,opt,name=issuer_epoch_duration,json=issuerEpochDuration,proto3,stdduration" json:"issuer_epoch_duration"`
+	// settlers are the accounts allowed to approve and reject settlements,
+	// in addition to the module authority.
+	Settlers []string `protobuf:"bytes,2,rep,name=settlers,proto3" json:"settlers,omitempty"`
+	// settlement_denom is the bank denom approved settlements are paid out in.
+	// Payouts are disabled while it is empty.
+	SettlementDenom string `protobuf:"bytes,3,opt,name=settlement_denom,json=settlementDenom,proto3" json:"settlement_denom,omitempty"`
+	// settlement_rate is the amount of settlement_denom paid out per point.
+	SettlementRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=settlement_rate,json=settlementRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"settlement_rate"`
+	// point_expiry is how long issued points stay valid unless an issuance
+	// overrides it. Zero means points never expire.
+	PointExpiry time.Duration `protobuf:"bytes,5,opt,name=point_expiry,json=pointExpiry,proto3,stdduration" json:"point_expiry"`
+	// velocity_limits are the rolling-window limits of accounts without a
+	// velocity override.
+	VelocityLimits VelocityLimits `protobuf:"bytes,6,opt,name=velocity_limits,json=velocityLimits,proto3" json:"velocity_limits"`
+	// compliance_officers are the accounts allowed to freeze and unfreeze
+	// accounts, in addition to the module authority.
+	ComplianceOfficers []string `protobuf:"bytes,7,rep,name=compliance_officers,json=complianceOfficers,proto3" json:"compliance_officers,omitempty"`
+	// metadata_limits bound the memo, order reference and metadata users
+	// attach to transactions.
+	MetadataLimits MetadataLimits `protobuf:"bytes,8,opt,name=metadata_limits,json=metadataLimits,proto3" json:"metadata_limits"`
+	// fee_payment sets how transaction fees paid in points are converted.
+	FeePayment FeePayment `protobuf:"bytes,9,opt,name=fee_payment,json=feePayment,proto3" json:"fee_payment"`
+	// transaction_retention sets how long transaction records are kept before
+	// they are pruned into per-account checkpoints.
+	TransactionRetention TransactionRetention `protobuf:"bytes,10,opt,name=transaction_retention,json=transactionRetention,proto3" json:"transaction_retention"`
+	// settlement_batch_epoch is the x/epochs identifier at the end of whose
+	// epochs pending settlements are grouped into one batch per requester.
+	// Batching is disabled while it is empty.
+	SettlementBatchEpoch string `protobuf:"bytes,11,opt,name=settlement_batch_epoch,json=settlementBatchEpoch,proto3" json:"settlement_batch_epoch,omitempty"`
+	// transfer_fee sets the fee charged on point transfers, credited to the
+	// points treasury.
+	TransferFee TransferFee `protobuf:"bytes,12,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee"`
+	// balance_snapshot_epoch is the x/epochs identifier at the end of whose
+	// epochs the balances changed since the last snapshot are recorded.
+	// Snapshots are disabled while it is empty.
+	BalanceSnapshotEpoch string `protobuf:"bytes,13,opt,name=balance_snapshot_epoch,json=balanceSnapshotEpoch,proto3" json:"balance_snapshot_epoch,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,24 +105,417 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetIssuerEpochDuration() time.Duration {
+	if m != nil {
+		return m.IssuerEpochDuration
+	}
+	return 0
+}
+
+func (m *Params) GetSettlers() []string {
+	if m != nil {
+		return m.Settlers
+	}
+	return nil
+}
+
+func (m *Params) GetSettlementDenom() string {
+	if m != nil {
+		return m.SettlementDenom
+	}
+	return ""
+}
+
+func (m *Params) GetPointExpiry() time.Duration {
+	if m != nil {
+		return m.PointExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetVelocityLimits() VelocityLimits {
+	if m != nil {
+		return m.VelocityLimits
+	}
+	return VelocityLimits{}
+}
+
+func (m *Params) GetComplianceOfficers() []string {
+	if m != nil {
+		return m.ComplianceOfficers
+	}
+	return nil
+}
+
+func (m *Params) GetMetadataLimits() MetadataLimits {
+	if m != nil {
+		return m.MetadataLimits
+	}
+	return MetadataLimits{}
+}
+
+func (m *Params) GetFeePayment() FeePayment {
+	if m != nil {
+		return m.FeePayment
+	}
+	return FeePayment{}
+}
+
+func (m *Params) GetTransactionRetention() TransactionRetention {
+	if m != nil {
+		return m.TransactionRetention
+	}
+	return TransactionRetention{}
+}
+
+func (m *Params) GetSettlementBatchEpoch() string {
+	if m != nil {
+		return m.SettlementBatchEpoch
+	}
+	return ""
+}
+
+func (m *Params) GetTransferFee() TransferFee {
+	if m != nil {
+		return m.TransferFee
+	}
+	return TransferFee{}
+}
+
+func (m *Params) GetBalanceSnapshotEpoch() string {
+	if m != nil {
+		return m.BalanceSnapshotEpoch
+	}
+	return ""
+}
+
+// MetadataLimits bound the data attached to transactions. Lengths are in
+// bytes. A zero limit disables that check.
+type MetadataLimits struct {
+	MaxMemoLength           uint32 `protobuf:"varint,1,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty"`
+	MaxOrderReferenceLength uint32 `protobuf:"varint,2,opt,name=max_order_reference_length,json=maxOrderReferenceLength,proto3" json:"max_order_reference_length,omitempty"`
+	MaxEntries              uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	MaxKeyLength            uint32 `protobuf:"varint,4,opt,name=max_key_length,json=maxKeyLength,proto3" json:"max_key_length,omitempty"`
+	MaxValueLength          uint32 `protobuf:"varint,5,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+}
+
+func (m *MetadataLimits) Reset()         { *m = MetadataLimits{} }
+func (m *MetadataLimits) String() string { return proto.CompactTextString(m) }
+func (*MetadataLimits) ProtoMessage()    {}
+func (*MetadataLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e6c5804d9836ef1, []int{1}
+}
+func (m *MetadataLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataLimits.Merge(m, src)
+}
+func (m *MetadataLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataLimits proto.InternalMessageInfo
+
+func (m *MetadataLimits) GetMaxMemoLength() uint32 {
+	if m != nil {
+		return m.MaxMemoLength
+	}
+	return 0
+}
+
+func (m *MetadataLimits) GetMaxOrderReferenceLength() uint32 {
+	if m != nil {
+		return m.MaxOrderReferenceLength
+	}
+	return 0
+}
+
+func (m *MetadataLimits) GetMaxEntries() uint32 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
+func (m *MetadataLimits) GetMaxKeyLength() uint32 {
+	if m != nil {
+		return m.MaxKeyLength
+	}
+	return 0
+}
+
+func (m *MetadataLimits) GetMaxValueLength() uint32 {
+	if m != nil {
+		return m.MaxValueLength
+	}
+	return 0
+}
+
+// FeePayment sets the conversion of transaction fees paid in points. Paying
+// fees in points is disabled while denom is empty.
+type FeePayment struct {
+	// denom is the fee denom that may be paid in points.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// points_per_unit is the number of points charged per unit of denom.
+	PointsPerUnit cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=points_per_unit,json=pointsPerUnit,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"points_per_unit"`
+}
+
+func (m *FeePayment) Reset()         { *m = FeePayment{} }
+func (m *FeePayment) String() string { return proto.CompactTextString(m) }
+func (*FeePayment) ProtoMessage()    {}
+func (*FeePayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e6c5804d9836ef1, []int{2}
+}
+func (m *FeePayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayment.Merge(m, src)
+}
+func (m *FeePayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePayment proto.InternalMessageInfo
+
+func (m *FeePayment) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// TransactionRetention sets which transaction records are pruned. A
+// transaction is pruned once it is past either limit; zero disables a limit.
+type TransactionRetention struct {
+	// max_age prunes transactions recorded longer than this ago.
+	MaxAge time.Duration `protobuf:"bytes,1,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age"`
+	// max_count keeps only the newest max_count transactions of the chain,
+	// counted across all programs by transaction id.
+	MaxCount uint64 `protobuf:"varint,2,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+}
+
+func (m *TransactionRetention) Reset()         { *m = TransactionRetention{} }
+func (m *TransactionRetention) String() string { return proto.CompactTextString(m) }
+func (*TransactionRetention) ProtoMessage()    {}
+func (*TransactionRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e6c5804d9836ef1, []int{3}
+}
+func (m *TransactionRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransactionRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionRetention.Merge(m, src)
+}
+func (m *TransactionRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionRetention proto.InternalMessageInfo
+
+func (m *TransactionRetention) GetMaxAge() time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *TransactionRetention) GetMaxCount() uint64 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
+}
+
+// TransferFee is the fee charged to the sender of a point transfer, on top
+// of the amount transferred: rate of the amount, rounded up, plus flat,
+// bounded by min and, unless zero, max. Transfers are free while all of
+// rate, flat and min are zero.
+type TransferFee struct {
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	Flat uint64                      `protobuf:"varint,2,opt,name=flat,proto3" json:"flat,omitempty"`
+	Min  uint64                      `protobuf:"varint,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max  uint64                      `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+	// exempt are the addresses whose transfers, sent or received, are free.
+	Exempt []string `protobuf:"bytes,5,rep,name=exempt,proto3" json:"exempt,omitempty"`
+}
+
+func (m *TransferFee) Reset()         { *m = TransferFee{} }
+func (m *TransferFee) String() string { return proto.CompactTextString(m) }
+func (*TransferFee) ProtoMessage()    {}
+func (*TransferFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e6c5804d9836ef1, []int{4}
+}
+func (m *TransferFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFee.Merge(m, src)
+}
+func (m *TransferFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFee proto.InternalMessageInfo
+
+func (m *TransferFee) GetFlat() uint64 {
+	if m != nil {
+		return m.Flat
+	}
+	return 0
+}
+
+func (m *TransferFee) GetMin() uint64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *TransferFee) GetMax() uint64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *TransferFee) GetExempt() []string {
+	if m != nil {
+		return m.Exempt
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "scontract.points.v1.Params")
+	proto.RegisterType((*MetadataLimits)(nil), "scontract.points.v1.MetadataLimits")
+	proto.RegisterType((*FeePayment)(nil), "scontract.points.v1.FeePayment")
+	proto.RegisterType((*TransactionRetention)(nil), "scontract.points.v1.TransactionRetention")
+	proto.RegisterType((*TransferFee)(nil), "scontract.points.v1.TransferFee")
 }
 
 func init() { proto.RegisterFile("scontract/points/v1/params.proto", fileDescriptor_3e6c5804d9836ef1) }
 
 var fileDescriptor_3e6c5804d9836ef1 = []byte{
-	// 160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x4e, 0xce, 0xcf,
-	0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0xd1, 0x2f, 0xc8, 0xcf, 0xcc, 0x2b, 0x29, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xab,
-	0xd0, 0x83, 0xa8, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93,
-	0x10, 0x75, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0xd2,
-	0xe1, 0x62, 0x0b, 0x00, 0x9b, 0x66, 0xa5, 0xf4, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3, 0x0d, 0x5a,
-	0x92, 0x08, 0x2b, 0x2b, 0x60, 0x96, 0x42, 0xd4, 0x38, 0x19, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x04, 0x16, 0x4d, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x8b, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x61, 0x4f, 0xa1, 0x48, 0xca, 0x00, 0x00, 0x00,
+	// 902 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0x8e, 0x13, 0x8f, 0xe3, 0x24, 0x4c, 0xdc, 0xb2, 0x49, 0x25, 0xdb, 0x32, 0x08,
+	0xa5, 0x95, 0xd8, 0x55, 0x0b, 0xe2, 0x50, 0x4e, 0x35, 0x49, 0x0f, 0x24, 0xa5, 0xd1, 0x02, 0x45,
+	0x42, 0x88, 0xd5, 0x64, 0xfd, 0xd6, 0x5e, 0x75, 0x67, 0x67, 0x35, 0x3b, 0xb6, 0xd6, 0x12, 0x3f,
+	0x00, 0x71, 0xe2, 0xc8, 0x91, 0x23, 0x37, 0x7a, 0xe0, 0x47, 0xf4, 0x58, 0x71, 0x40, 0x88, 0x43,
+	0x41, 0xc9, 0xa1, 0x1c, 0xf8, 0x11, 0x68, 0xde, 0xcc, 0xc6, 0x8e, 0x64, 0x90, 0x0a, 0x97, 0xd5,
+	0xbe, 0xf7, 0xbe, 0xf7, 0xcd, 0x37, 0xdf, 0xbc, 0x19, 0xd2, 0x2b, 0x22, 0x91, 0x29, 0xc9, 0x22,
+	0xe5, 0xe7, 0x22, 0xc9, 0x54, 0xe1, 0x4f, 0xef, 0xf8, 0x39, 0x93, 0x8c, 0x17, 0x5e, 0x2e, 0x85,
+	0x12, 0x74, 0xf7, 0x12, 0xe1, 0x19, 0x84, 0x37, 0xbd, 0xb3, 0xff, 0x1a, 0xe3, 0x49, 0x26, 0x7c,
+	0xfc, 0x1a, 0xdc, 0xfe, 0x5e, 0x24, 0x0a, 0x2e, 0x8a, 0x10, 0x23, 0xdf, 0x04, 0xb6, 0xd4, 0x1e,
+	0x89, 0x91, 0x30, 0x79, 0xfd, 0x67, 0xb3, 0x9d, 0x91, 0x10, 0xa3, 0x14, 0x7c, 0x8c, 0xce, 0x26,
+	0xb1, 0x3f, 0x9c, 0x48, 0xa6, 0x12, 0x91, 0xd9, 0x7a, 0x7f, 0x99, 0xb4, 0x29, 0xa4, 0x22, 0x4a,
+	0xd4, 0xcc, 0x60, 0xfa, 0xbf, 0xac, 0x93, 0xfa, 0x29, 0xaa, 0xa5, 0x5f, 0x90, 0xeb, 0x49, 0x51,
+	0x4c, 0x40, 0x86, 0x90, 0x8b, 0x68, 0x1c, 0x56, 0x6c, 0xae, 0xd3, 0x73, 0x0e, 0x9a, 0x77, 0xf7,
+	0x3c, 0xb3, 0x9c, 0x57, 0x2d, 0xe7, 0x1d, 0x5a, 0xc0, 0xa0, 0xf5, 0xec, 0x45, 0x77, 0xe5, 0xbb,
+	0xdf, 0xbb, 0xce, 0x0f, 0x2f, 0x9f, 0xde, 0x76, 0x82, 0x5d, 0x43, 0x73, 0xa4, 0x59, 0x2a, 0x0c,
+	0xdd, 0x27, 0x1b, 0x05, 0x28, 0x95, 0x82, 0x2c, 0xdc, 0x6b, 0xbd, 0xd5, 0x83, 0x46, 0x70, 0x19,
+	0xd3, 0x5b, 0x64, 0xc7, 0xfc, 0x73, 0xc8, 0x54, 0x38, 0x84, 0x4c, 0x70, 0x77, 0xb5, 0xe7, 0x1c,
+	0x34, 0x82, 0xed, 0x79, 0xfe, 0x50, 0xa7, 0x69, 0x48, 0x16, 0x52, 0xa1, 0x64, 0x0a, 0xdc, 0x9a,
+	0x46, 0x0e, 0xde, 0xd3, 0x1a, 0x7e, 0x7b, 0xd1, 0xbd, 0x69, 0x8c, 0x2b, 0x86, 0x4f, 0xbc, 0x44,
+	0xf8, 0x9c, 0xa9, 0xb1, 0x77, 0x02, 0x23, 0x16, 0xcd, 0x0e, 0x21, 0xfa, 0xf9, 0xa7, 0xb7, 0x89,
+	0xf5, 0xf5, 0x10, 0x22, 0x23, 0x76, 0x6b, 0x4e, 0x17, 0x30, 0x05, 0xf4, 0x98, 0x6c, 0xa2, 0x59,
+	0x21, 0x94, 0x79, 0x22, 0x67, 0xee, 0xda, 0x2b, 0x6e, 0xbe, 0x89, 0xdd, 0x47, 0xd8, 0x4c, 0x3f,
+	0x23, 0xdb, 0x95, 0xdf, 0x61, 0x9a, 0xf0, 0x44, 0x15, 0x6e, 0x1d, 0xf9, 0xde, 0xf0, 0x96, 0x0c,
+	0x85, 0xf7, 0xd8, 0x62, 0x4f, 0x10, 0x3a, 0x68, 0x68, 0x66, 0xab, 0x72, 0x7a, 0xa5, 0x44, 0x7d,
+	0xb2, 0x1b, 0x09, 0x9e, 0xa7, 0x09, 0xcb, 0x22, 0x08, 0x45, 0x1c, 0x27, 0x91, 0x36, 0x76, 0x1d,
+	0x8d, 0xa5, 0xf3, 0xd2, 0x23, 0x5b, 0xd1, 0x4a, 0x38, 0x28, 0x36, 0x64, 0x8a, 0x55, 0x4a, 0x36,
+	0xfe, 0x45, 0xc9, 0x43, 0x8b, 0x5d, 0xa2, 0x84, 0x5f, 0x29, 0xd1, 0x63, 0xd2, 0x8c, 0x01, 0xc2,
+	0x9c, 0xcd, 0xb4, 0x85, 0x6e, 0x03, 0x49, 0xbb, 0x4b, 0x49, 0x1f, 0x00, 0x9c, 0x1a, 0xd8, 0x22,
+	0x21, 0x89, 0x2f, 0xd3, 0x34, 0x21, 0xd7, 0x95, 0x64, 0x59, 0xc1, 0x22, 0x6d, 0x6d, 0x28, 0x41,
+	0x41, 0x86, 0x23, 0x48, 0x90, 0xf6, 0xd6, 0x52, 0xda, 0x4f, 0xe6, 0x1d, 0x41, 0xd5, 0xb0, 0xb8,
+	0x40, 0x5b, 0x2d, 0x01, 0xd0, 0x77, 0xc9, 0x8d, 0x85, 0x41, 0x3a, 0x63, 0x2a, 0x1a, 0x9b, 0xb9,
+	0x77, 0x9b, 0x38, 0x79, 0xed, 0x79, 0x75, 0xa0, 0x8b, 0x38, 0xcd, 0xf4, 0x23, 0xb2, 0x89, 0x6c,
+	0x31, 0xc8, 0x30, 0x06, 0x70, 0x37, 0x51, 0x57, 0xef, 0x9f, 0x75, 0xc5, 0x20, 0x1f, 0x00, 0x2c,
+	0xca, 0x69, 0xaa, 0x79, 0x5e, 0xab, 0x38, 0x63, 0x29, 0x1e, 0x62, 0x91, 0xb1, 0xbc, 0x18, 0x0b,
+	0x65, 0x55, 0xb4, 0x8c, 0x0a, 0x5b, 0xfd, 0xd8, 0x16, 0x51, 0xc5, 0xbd, 0xfe, 0x9f, 0xdf, 0x77,
+	0x9d, 0x6f, 0x5e, 0x3e, 0xbd, 0xbd, 0x37, 0xbf, 0xe1, 0x65, 0x75, 0xc7, 0xcd, 0x6d, 0xee, 0xff,
+	0xe5, 0x90, 0xad, 0xab, 0xa7, 0x48, 0xdf, 0x22, 0xdb, 0x9c, 0x95, 0x21, 0x07, 0x2e, 0xc2, 0x14,
+	0xb2, 0x91, 0x1a, 0xe3, 0xd5, 0x6e, 0x05, 0x2d, 0xce, 0xca, 0x87, 0xc0, 0xc5, 0x09, 0x26, 0xe9,
+	0xfb, 0x64, 0x5f, 0xe3, 0x84, 0x1c, 0x82, 0x0c, 0x25, 0xc4, 0x20, 0x41, 0x0b, 0xb4, 0x2d, 0xd7,
+	0xb0, 0xe5, 0x75, 0xce, 0xca, 0x47, 0x1a, 0x10, 0x54, 0x75, 0xdb, 0xdc, 0x25, 0x4d, 0xdd, 0x0c,
+	0x99, 0x92, 0x09, 0x14, 0x78, 0x8d, 0x5b, 0x01, 0xe1, 0xac, 0x3c, 0x32, 0x19, 0xfa, 0x26, 0xd9,
+	0xd2, 0x80, 0x27, 0x30, 0xab, 0x18, 0x6b, 0x88, 0xd9, 0xe4, 0xac, 0x3c, 0x86, 0x99, 0xa5, 0x39,
+	0x20, 0x3b, 0x1a, 0x35, 0x65, 0xe9, 0xe4, 0x72, 0xe5, 0x35, 0xc4, 0xe9, 0xee, 0xc7, 0x3a, 0x6d,
+	0x90, 0xf7, 0x6a, 0xda, 0x8c, 0xfe, 0xd7, 0x0e, 0x21, 0xf3, 0xf9, 0xa2, 0x6d, 0xb2, 0x66, 0x9e,
+	0x11, 0x07, 0x6d, 0x34, 0x01, 0xfd, 0x92, 0x6c, 0x1b, 0x93, 0xc2, 0x1c, 0x64, 0x38, 0xc9, 0x12,
+	0x85, 0xbb, 0xf9, 0xef, 0x8f, 0x47, 0xcb, 0xd0, 0x9d, 0x82, 0xfc, 0x34, 0x4b, 0x94, 0x95, 0xf2,
+	0x15, 0x69, 0x2f, 0x1b, 0x49, 0x7a, 0x9f, 0xac, 0xeb, 0x2d, 0xb1, 0x11, 0xbc, 0xf2, 0x8b, 0x5a,
+	0xe7, 0xac, 0xbc, 0x3f, 0x02, 0x7a, 0x93, 0x34, 0x34, 0x45, 0x24, 0x26, 0x99, 0x91, 0x5e, 0x0b,
+	0x36, 0x38, 0x2b, 0x3f, 0xd0, 0xb1, 0x5d, 0xfd, 0x47, 0x87, 0x34, 0x17, 0x26, 0x8f, 0x7e, 0x48,
+	0x6a, 0xf8, 0x4a, 0x3a, 0xff, 0x6b, 0xa3, 0xc8, 0x41, 0x29, 0xa9, 0xc5, 0x29, 0xab, 0x56, 0xc6,
+	0x7f, 0xba, 0x43, 0x56, 0x79, 0x92, 0xe1, 0x39, 0xd7, 0x02, 0xfd, 0x8b, 0x19, 0x56, 0xe2, 0xa9,
+	0xea, 0x0c, 0x2b, 0xe9, 0x0d, 0x52, 0x87, 0x12, 0x78, 0xae, 0xdc, 0x35, 0x7c, 0xa0, 0x6c, 0x64,
+	0x14, 0x0f, 0xee, 0x3e, 0x3b, 0xef, 0x38, 0xcf, 0xcf, 0x3b, 0xce, 0x1f, 0xe7, 0x1d, 0xe7, 0xdb,
+	0x8b, 0xce, 0xca, 0xf3, 0x8b, 0xce, 0xca, 0xaf, 0x17, 0x9d, 0x95, 0xcf, 0xdd, 0x25, 0xe3, 0xad,
+	0x66, 0x39, 0x14, 0x67, 0x75, 0xb4, 0xec, 0x9d, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x97, 0xfd,
+	0x5f, 0xaa, 0x7e, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -100,58 +537,1389 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.IssuerEpochDuration != that1.IssuerEpochDuration {
+		return false
+	}
+	if len(this.Settlers) != len(that1.Settlers) {
+		return false
+	}
+	for i := range this.Settlers {
+		if this.Settlers[i] != that1.Settlers[i] {
+			return false
+		}
+	}
+	if this.SettlementDenom != that1.SettlementDenom {
+		return false
+	}
+	if !this.SettlementRate.Equal(that1.SettlementRate) {
+		return false
+	}
+	if this.PointExpiry != that1.PointExpiry {
+		return false
+	}
+	if !this.VelocityLimits.Equal(&that1.VelocityLimits) {
+		return false
+	}
+	if len(this.ComplianceOfficers) != len(that1.ComplianceOfficers) {
+		return false
+	}
+	for i := range this.ComplianceOfficers {
+		if this.ComplianceOfficers[i] != that1.ComplianceOfficers[i] {
+			return false
+		}
+	}
+	if !this.MetadataLimits.Equal(&that1.MetadataLimits) {
+		return false
+	}
+	if !this.FeePayment.Equal(&that1.FeePayment) {
+		return false
+	}
+	if !this.TransactionRetention.Equal(&that1.TransactionRetention) {
+		return false
+	}
+	if this.SettlementBatchEpoch != that1.SettlementBatchEpoch {
+		return false
+	}
+	if !this.TransferFee.Equal(&that1.TransferFee) {
+		return false
+	}
+	if this.BalanceSnapshotEpoch != that1.BalanceSnapshotEpoch {
+		return false
+	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MetadataLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*MetadataLimits)
+	if !ok {
+		that2, ok := that.(MetadataLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	if this.MaxMemoLength != that1.MaxMemoLength {
+		return false
+	}
+	if this.MaxOrderReferenceLength != that1.MaxOrderReferenceLength {
+		return false
+	}
+	if this.MaxEntries != that1.MaxEntries {
+		return false
+	}
+	if this.MaxKeyLength != that1.MaxKeyLength {
+		return false
+	}
+	if this.MaxValueLength != that1.MaxValueLength {
+		return false
+	}
+	return true
 }
+func (this *FeePayment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	that1, ok := that.(*FeePayment)
+	if !ok {
+		that2, ok := that.(FeePayment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.PointsPerUnit.Equal(that1.PointsPerUnit) {
+		return false
+	}
+	return true
+}
+func (this *TransactionRetention) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionRetention)
+	if !ok {
+		that2, ok := that.(TransactionRetention)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxAge != that1.MaxAge {
+		return false
+	}
+	if this.MaxCount != that1.MaxCount {
+		return false
+	}
+	return true
+}
+func (this *TransferFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferFee)
+	if !ok {
+		that2, ok := that.(TransferFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if this.Flat != that1.Flat {
+		return false
+	}
+	if this.Min != that1.Min {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	if len(this.Exempt) != len(that1.Exempt) {
+		return false
+	}
+	for i := range this.Exempt {
+		if this.Exempt[i] != that1.Exempt[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BalanceSnapshotEpoch) > 0 {
+		i -= len(m.BalanceSnapshotEpoch)
+		copy(dAtA[i:], m.BalanceSnapshotEpoch)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BalanceSnapshotEpoch)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size, err := m.TransferFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.SettlementBatchEpoch) > 0 {
+		i -= len(m.SettlementBatchEpoch)
+		copy(dAtA[i:], m.SettlementBatchEpoch)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SettlementBatchEpoch)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size, err := m.TransactionRetention.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.FeePayment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.MetadataLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ComplianceOfficers) > 0 {
+		for iNdEx := len(m.ComplianceOfficers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ComplianceOfficers[iNdEx])
+			copy(dAtA[i:], m.ComplianceOfficers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ComplianceOfficers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.VelocityLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PointExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PointExpiry):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SettlementRate.Size()
+		i -= size
+		if _, err := m.SettlementRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SettlementDenom) > 0 {
+		i -= len(m.SettlementDenom)
+		copy(dAtA[i:], m.SettlementDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SettlementDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Settlers) > 0 {
+		for iNdEx := len(m.Settlers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Settlers[iNdEx])
+			copy(dAtA[i:], m.Settlers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Settlers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IssuerEpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IssuerEpochDuration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxValueLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxValueLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxKeyLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxKeyLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxOrderReferenceLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOrderReferenceLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxMemoLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMemoLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PointsPerUnit.Size()
+		i -= size
+		if _, err := m.PointsPerUnit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAge):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exempt) > 0 {
+		for iNdEx := len(m.Exempt) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exempt[iNdEx])
+			copy(dAtA[i:], m.Exempt[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Exempt[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Max != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Max))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Min != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Min))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Flat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Flat))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IssuerEpochDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Settlers) > 0 {
+		for _, s := range m.Settlers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.SettlementDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.SettlementRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PointExpiry)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.VelocityLimits.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.ComplianceOfficers) > 0 {
+		for _, s := range m.ComplianceOfficers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MetadataLimits.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeePayment.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TransactionRetention.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.SettlementBatchEpoch)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.TransferFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.BalanceSnapshotEpoch)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *MetadataLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMemoLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxMemoLength))
+	}
+	if m.MaxOrderReferenceLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxOrderReferenceLength))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovParams(uint64(m.MaxEntries))
+	}
+	if m.MaxKeyLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxKeyLength))
+	}
+	if m.MaxValueLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxValueLength))
+	}
+	return n
+}
+
+func (m *FeePayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.PointsPerUnit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *TransactionRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAge)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxCount != 0 {
+		n += 1 + sovParams(uint64(m.MaxCount))
+	}
+	return n
+}
+
+func (m *TransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.Flat != 0 {
+		n += 1 + sovParams(uint64(m.Flat))
+	}
+	if m.Min != 0 {
+		n += 1 + sovParams(uint64(m.Min))
+	}
+	if m.Max != 0 {
+		n += 1 + sovParams(uint64(m.Max))
+	}
+	if len(m.Exempt) > 0 {
+		for _, s := range m.Exempt {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IssuerEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlers = append(m.Settlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PointExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VelocityLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceOfficers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceOfficers = append(m.ComplianceOfficers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MetadataLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransactionRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementBatchEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementBatchEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceSnapshotEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceSnapshotEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+			}
+			m.MaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderReferenceLength", wireType)
+			}
+			m.MaxOrderReferenceLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrderReferenceLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeyLength", wireType)
+			}
+			m.MaxKeyLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxKeyLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
+			}
+			m.MaxValueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValueLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *FeePayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsPerUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PointsPerUnit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TransactionRetention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -174,12 +1942,237 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactionRetention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactionRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
+			}
+			m.MaxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flat", wireType)
+			}
+			m.Flat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			m.Min = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Min |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exempt = append(m.Exempt, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
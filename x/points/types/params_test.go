@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/types"
+)
+
+func TestTransferFee(t *testing.T) {
+	exempt := sample.AccAddress()
+	fee := types.TransferFee{Rate: sdkmath.LegacyNewDecWithPrec(25, 3), Flat: 2, Min: 5, Max: 50, Exempt: []string{exempt}}
+	require.NoError(t, fee.Validate())
+
+	tests := []struct {
+		amount uint64
+		want   uint64
+	}{
+		{amount: 0, want: 5},
+		{amount: 200, want: 7},
+		{amount: 201, want: 8},
+		{amount: 10_000, want: 50},
+	}
+	for _, tc := range tests {
+		got, err := fee.Fee(tc.amount, "a", "b")
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got, "amount %d", tc.amount)
+	}
+
+	got, err := fee.Fee(1000, exempt, "b")
+	require.NoError(t, err)
+	require.Zero(t, got)
+
+	got, err = types.DefaultTransferFee.Fee(1000, "a", "b")
+	require.NoError(t, err)
+	require.Zero(t, got)
+
+	_, err = types.TransferFee{Rate: sdkmath.LegacyNewDecWithPrec(5, 1), Flat: math.MaxUint64}.Fee(10, "a", "b")
+	require.Error(t, err)
+
+	for _, invalid := range []types.TransferFee{
+		{Rate: sdkmath.LegacyNewDec(-1)},
+		{Rate: sdkmath.LegacyOneDec()},
+		{Rate: sdkmath.LegacyZeroDec(), Min: 10, Max: 5},
+		{Rate: sdkmath.LegacyZeroDec(), Exempt: []string{exempt, exempt}},
+	} {
+		require.Error(t, invalid.Validate())
+	}
+}
+
+func TestVelocityLimitsValidate(t *testing.T) {
+	for _, valid := range []types.VelocityLimits{
+		{},
+		{DailySpend: 10, WeeklySpend: 10, DailyTransfer: 5, WeeklyTransfer: 20},
+		// zero limits are unlimited, so either window may be left unset
+		{DailySpend: 10, WeeklyTransfer: 20},
+		{WeeklySpend: 10, DailyTransfer: 20, DailyIssuePerRecipient: 100},
+	} {
+		require.NoError(t, valid.Validate())
+	}
+
+	for _, invalid := range []types.VelocityLimits{
+		{DailySpend: 11, WeeklySpend: 10},
+		{DailyTransfer: 21, WeeklyTransfer: 20},
+	} {
+		require.Error(t, invalid.Validate())
+		params := types.DefaultParams()
+		params.VelocityLimits = invalid
+		require.Error(t, params.Validate())
+	}
+}
+
+func TestParamsEpochIdentifiers(t *testing.T) {
+	tests := []struct {
+		desc string
+		set  func(*types.Params, string)
+	}{
+		{
+			desc: "settlement batch",
+			set:  func(p *types.Params, identifier string) { p.SettlementBatchEpoch = identifier },
+		},
+		{
+			desc: "balance snapshot",
+			set:  func(p *types.Params, identifier string) { p.BalanceSnapshotEpoch = identifier },
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			for _, identifier := range []string{"", "week", "day-1"} {
+				params := types.DefaultParams()
+				tc.set(&params, identifier)
+				require.NoError(t, params.Validate(), identifier)
+			}
+			for _, identifier := range []string{" week", "a week", "week\n", strings.Repeat("w", types.MaxEpochIdentifierLength+1)} {
+				params := types.DefaultParams()
+				tc.set(&params, identifier)
+				require.Error(t, params.Validate(), identifier)
+			}
+		})
+	}
+}
+
+func TestParamsMetadataLimits(t *testing.T) {
+	params := types.DefaultParams()
+	params.MetadataLimits = types.MetadataLimits{}
+	require.NoError(t, params.Validate())
+	params.MetadataLimits = types.MetadataLimits{MaxMemoLength: types.MaxMetadataLimit, MaxValueLength: types.MaxMetadataLimit}
+	require.NoError(t, params.Validate())
+
+	for _, invalid := range []types.MetadataLimits{
+		{MaxMemoLength: types.MaxMetadataLimit + 1},
+		{MaxOrderReferenceLength: types.MaxMetadataLimit + 1},
+		{MaxEntries: types.MaxMetadataLimit + 1},
+		{MaxKeyLength: types.MaxMetadataLimit + 1},
+		{MaxValueLength: types.MaxMetadataLimit + 1},
+	} {
+		params.MetadataLimits = invalid
+		require.Error(t, params.Validate())
+	}
+}
@@ -24,9 +24,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // PointBalance defines the PointBalance message.
 type PointBalance struct {
-	Index   string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Balance uint64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Index     string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Balance   uint64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	ProgramId string `protobuf:"bytes,4,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 }
 
 func (m *PointBalance) Reset()         { *m = PointBalance{} }
@@ -83,6 +84,13 @@ func (m *PointBalance) GetBalance() uint64 {
 	return 0
 }
 
+func (m *PointBalance) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PointBalance)(nil), "scontract.points.v1.PointBalance")
 }
@@ -92,18 +100,20 @@ func init() {
 }
 
 var fileDescriptor_afd3fa7ee915713f = []byte{
-	// 173 bytes of a gzipped FileDescriptorProto
+	// 196 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2f, 0x4e, 0xce, 0xcf,
 	0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0xd1, 0x2f, 0xc8, 0xcf, 0xcc, 0x2b, 0x29, 0xd6, 0x2f, 0x33, 0x84,
 	0xb0, 0xe2, 0x93, 0x12, 0x73, 0x12, 0xf3, 0x92, 0x53, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85,
-	0x84, 0xe1, 0x0a, 0xf5, 0x20, 0x0a, 0xf5, 0xca, 0x0c, 0x95, 0x22, 0xb8, 0x78, 0x02, 0x40, 0x1c,
+	0x84, 0xe1, 0x0a, 0xf5, 0x20, 0x0a, 0xf5, 0xca, 0x0c, 0x95, 0xca, 0xb9, 0x78, 0x02, 0x40, 0x1c,
 	0x27, 0x88, 0x52, 0x21, 0x11, 0x2e, 0xd6, 0xcc, 0xbc, 0x94, 0xd4, 0x0a, 0x09, 0x46, 0x05, 0x46,
 	0x0d, 0xce, 0x20, 0x08, 0x47, 0x48, 0x82, 0x8b, 0x3d, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x58,
 	0x82, 0x09, 0x2c, 0x0e, 0xe3, 0x82, 0x64, 0xa0, 0xb6, 0x48, 0x30, 0x2b, 0x30, 0x6a, 0xb0, 0x04,
-	0xc1, 0xb8, 0x4e, 0x46, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x81,
-	0x70, 0x71, 0x05, 0xcc, 0xcd, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x97, 0x1a, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x06, 0xec, 0x3d, 0xd4, 0x00, 0x00, 0x00,
+	0xc1, 0xb8, 0x42, 0xb2, 0x5c, 0x5c, 0x05, 0x45, 0xf9, 0xe9, 0x45, 0x89, 0xb9, 0xf1, 0x99, 0x29,
+	0x12, 0x2c, 0x60, 0x6d, 0x9c, 0x50, 0x11, 0xcf, 0x14, 0x27, 0xa3, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
+	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
+	0x6e, 0x3c, 0x96, 0x63, 0x88, 0x92, 0x40, 0x78, 0xa8, 0x02, 0xe6, 0xa5, 0x92, 0xca, 0x82, 0xd4,
+	0xe2, 0x24, 0x36, 0xb0, 0x47, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x88, 0xc8, 0x30,
+	0xf3, 0x00, 0x00, 0x00,
 }
 
 func (m *PointBalance) Marshal() (dAtA []byte, err error) {
@@ -126,6 +136,13 @@ func (m *PointBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintPointBalance(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Balance != 0 {
 		i = encodeVarintPointBalance(dAtA, i, uint64(m.Balance))
 		i--
@@ -176,6 +193,10 @@ func (m *PointBalance) Size() (n int) {
 	if m.Balance != 0 {
 		n += 1 + sovPointBalance(uint64(m.Balance))
 	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovPointBalance(uint64(l))
+	}
 	return n
 }
 
@@ -297,6 +318,38 @@ func (m *PointBalance) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPointBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPointBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPointBalance(dAtA[iNdEx:])
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"math/bits"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs basic validation of the grant schedule. Addresses are
+// only checked for presence; the keeper decodes them with its address codec.
+func (g PointGrant) Validate() error {
+	if g.ProgramId == "" {
+		return errorsmod.Wrap(ErrInvalidGrant, "program id cannot be empty")
+	}
+	if g.Issuer == "" || g.Beneficiary == "" {
+		return errorsmod.Wrap(ErrInvalidGrant, "issuer and beneficiary cannot be empty")
+	}
+	if g.EpochIdentifier == "" {
+		return errorsmod.Wrap(ErrInvalidGrant, "epoch identifier cannot be empty")
+	}
+	if g.Periods == 0 || g.AmountPerPeriod == 0 {
+		return errorsmod.Wrap(ErrInvalidGrant, "periods and amount per period must be positive")
+	}
+	if _, ok := g.Total(); !ok {
+		return errorsmod.Wrapf(ErrInvalidGrant, "%d periods of %d points overflow", g.Periods, g.AmountPerPeriod)
+	}
+	if g.ReleasedPeriods > g.Periods {
+		return errorsmod.Wrapf(ErrInvalidGrant, "released %d of %d periods", g.ReleasedPeriods, g.Periods)
+	}
+	if _, ok := PointGrantStatus_name[int32(g.Status)]; !ok || g.Status == PointGrantStatusUnspecified {
+		return errorsmod.Wrapf(ErrInvalidGrant, "invalid status %s", g.Status)
+	}
+	return nil
+}
+
+// Total returns the amount of all the tranches of the grant. It returns
+// false if it overflows.
+func (g PointGrant) Total() (uint64, bool) {
+	hi, total := bits.Mul64(g.Periods, g.AmountPerPeriod)
+	return total, hi == 0
+}
+
+// Unreleased returns the amount of the tranches not credited yet.
+func (g PointGrant) Unreleased() uint64 {
+	return (g.Periods - g.ReleasedPeriods) * g.AmountPerPeriod
+}
+
+// DuePeriods returns the number of tranches unlocked once epochNumber of the
+// grant's epoch identifier has ended.
+func (g PointGrant) DuePeriods(epochNumber int64) uint64 {
+	if epochNumber <= g.StartEpoch {
+		return 0
+	}
+	return min(uint64(epochNumber-g.StartEpoch), g.Periods)
+}
@@ -0,0 +1,914 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scontract/points/v1/point_grant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PointGrantStatus is the state of a point grant. Active grants release
+// tranches until they are completed or revoked.
+type PointGrantStatus int32
+
+const (
+	PointGrantStatusUnspecified PointGrantStatus = 0
+	PointGrantStatusActive      PointGrantStatus = 1
+	PointGrantStatusCompleted   PointGrantStatus = 2
+	PointGrantStatusRevoked     PointGrantStatus = 3
+)
+
+var PointGrantStatus_name = map[int32]string{
+	0: "POINT_GRANT_STATUS_UNSPECIFIED",
+	1: "POINT_GRANT_STATUS_ACTIVE",
+	2: "POINT_GRANT_STATUS_COMPLETED",
+	3: "POINT_GRANT_STATUS_REVOKED",
+}
+
+var PointGrantStatus_value = map[string]int32{
+	"POINT_GRANT_STATUS_UNSPECIFIED": 0,
+	"POINT_GRANT_STATUS_ACTIVE":      1,
+	"POINT_GRANT_STATUS_COMPLETED":   2,
+	"POINT_GRANT_STATUS_REVOKED":     3,
+}
+
+func (x PointGrantStatus) String() string {
+	return proto.EnumName(PointGrantStatus_name, int32(x))
+}
+
+func (PointGrantStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_28f6aec665ed7b6f, []int{0}
+}
+
+// PointGrant is a grant of points to a beneficiary that unlocks in equal
+// tranches, one at the end of each epoch of an x/epochs identifier.
+type PointGrant struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProgramId string `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// issuer is the program issuer that created the grant.
+	Issuer      string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Beneficiary string `protobuf:"bytes,4,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// epoch_identifier is the x/epochs identifier whose epochs release the
+	// tranches.
+	EpochIdentifier string `protobuf:"bytes,5,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// periods is the number of tranches.
+	Periods         uint64 `protobuf:"varint,6,opt,name=periods,proto3" json:"periods,omitempty"`
+	AmountPerPeriod uint64 `protobuf:"varint,7,opt,name=amount_per_period,json=amountPerPeriod,proto3" json:"amount_per_period,omitempty"`
+	// revocable grants may be revoked by their issuer.
+	Revocable bool `protobuf:"varint,8,opt,name=revocable,proto3" json:"revocable,omitempty"`
+	// start_epoch is the epoch the grant was created in. The first tranche
+	// unlocks at the end of the epoch after it.
+	StartEpoch int64 `protobuf:"varint,9,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// released_periods is the number of tranches credited so far.
+	ReleasedPeriods uint64 `protobuf:"varint,10,opt,name=released_periods,json=releasedPeriods,proto3" json:"released_periods,omitempty"`
+	// reason is recorded as the memo of the tranche transactions.
+	Reason    string `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt int64  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// revoked_at is the unix time the grant was revoked, zero if it was not.
+	RevokedAt int64            `protobuf:"varint,13,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	Status    PointGrantStatus `protobuf:"varint,14,opt,name=status,proto3,enum=scontract.points.v1.PointGrantStatus" json:"status,omitempty"`
+}
+
+func (m *PointGrant) Reset()         { *m = PointGrant{} }
+func (m *PointGrant) String() string { return proto.CompactTextString(m) }
+func (*PointGrant) ProtoMessage()    {}
+func (*PointGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28f6aec665ed7b6f, []int{0}
+}
+func (m *PointGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointGrant.Merge(m, src)
+}
+func (m *PointGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointGrant proto.InternalMessageInfo
+
+func (m *PointGrant) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PointGrant) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *PointGrant) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *PointGrant) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *PointGrant) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *PointGrant) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *PointGrant) GetAmountPerPeriod() uint64 {
+	if m != nil {
+		return m.AmountPerPeriod
+	}
+	return 0
+}
+
+func (m *PointGrant) GetRevocable() bool {
+	if m != nil {
+		return m.Revocable
+	}
+	return false
+}
+
+func (m *PointGrant) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *PointGrant) GetReleasedPeriods() uint64 {
+	if m != nil {
+		return m.ReleasedPeriods
+	}
+	return 0
+}
+
+func (m *PointGrant) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PointGrant) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *PointGrant) GetRevokedAt() int64 {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return 0
+}
+
+func (m *PointGrant) GetStatus() PointGrantStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PointGrantStatusUnspecified
+}
+
+func init() {
+	proto.RegisterEnum("scontract.points.v1.PointGrantStatus", PointGrantStatus_name, PointGrantStatus_value)
+	proto.RegisterType((*PointGrant)(nil), "scontract.points.v1.PointGrant")
+}
+
+func init() {
+	proto.RegisterFile("scontract/points/v1/point_grant.proto", fileDescriptor_28f6aec665ed7b6f)
+}
+
+var fileDescriptor_28f6aec665ed7b6f = []byte{
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x76, 0x74, 0xab, 0x07, 0x5b, 0x30, 0x68, 0x78, 0xd9, 0x96, 0x45, 0x48, 0x93,
+	0xca, 0x0e, 0x9d, 0x36, 0x4e, 0x08, 0x21, 0x14, 0xba, 0x30, 0x45, 0xc0, 0x1a, 0xa5, 0xe9, 0x0e,
+	0x5c, 0x22, 0x37, 0xf1, 0x8a, 0x45, 0x1b, 0x47, 0xb6, 0x5b, 0xb1, 0x6f, 0x80, 0x7a, 0xe2, 0xc4,
+	0xad, 0x27, 0xbe, 0x0c, 0xc7, 0x1d, 0x39, 0xa2, 0x56, 0x7c, 0x0f, 0x14, 0x27, 0x5d, 0x51, 0xe9,
+	0xcd, 0xfe, 0xfd, 0xff, 0x7f, 0xfb, 0xe9, 0x3d, 0x3d, 0x70, 0x24, 0x22, 0x96, 0x48, 0x8e, 0x23,
+	0x79, 0x92, 0x32, 0x9a, 0x48, 0x71, 0x32, 0x3a, 0xcd, 0x4f, 0x61, 0x8f, 0xe3, 0x44, 0x36, 0x52,
+	0xce, 0x24, 0x83, 0x8f, 0xee, 0x6c, 0x8d, 0xdc, 0xd6, 0x18, 0x9d, 0x1a, 0x8f, 0x7b, 0xac, 0xc7,
+	0x94, 0x7e, 0x92, 0x9d, 0x72, 0xeb, 0xd3, 0x3f, 0x15, 0x00, 0xbc, 0xcc, 0x73, 0x91, 0xe5, 0xe1,
+	0x16, 0x28, 0xd3, 0x18, 0x69, 0x96, 0x56, 0x5f, 0xf3, 0xcb, 0x34, 0x86, 0x07, 0x00, 0xa4, 0x9c,
+	0xf5, 0x38, 0x1e, 0x84, 0x34, 0x46, 0x65, 0x4b, 0xab, 0xd7, 0xfc, 0x5a, 0x41, 0xdc, 0x18, 0xee,
+	0x80, 0x2a, 0x15, 0x62, 0x48, 0x38, 0xaa, 0x28, 0xa9, 0xb8, 0x41, 0x0b, 0x6c, 0x76, 0x49, 0x42,
+	0xae, 0x69, 0x44, 0x31, 0xbf, 0x41, 0x6b, 0x4a, 0xfc, 0x17, 0xc1, 0x67, 0x40, 0x27, 0x29, 0x8b,
+	0x3e, 0x85, 0x34, 0x26, 0x89, 0xa4, 0xd7, 0x94, 0x70, 0x74, 0x4f, 0xd9, 0xb6, 0x15, 0x77, 0xef,
+	0x30, 0x44, 0x60, 0x3d, 0x25, 0x9c, 0xb2, 0x58, 0xa0, 0xaa, 0x2a, 0x6c, 0x7e, 0x85, 0xc7, 0xe0,
+	0x21, 0x1e, 0xb0, 0x61, 0x22, 0xc3, 0x94, 0xf0, 0x30, 0xa7, 0x68, 0x5d, 0x79, 0xb6, 0x73, 0xc1,
+	0x23, 0xdc, 0x53, 0x18, 0xee, 0x83, 0x1a, 0x27, 0x23, 0x16, 0xe1, 0x6e, 0x9f, 0xa0, 0x0d, 0x4b,
+	0xab, 0x6f, 0xf8, 0x0b, 0x00, 0x0f, 0xc1, 0xa6, 0x90, 0x98, 0xcb, 0x50, 0x7d, 0x8e, 0x6a, 0x96,
+	0x56, 0xaf, 0xf8, 0x40, 0x21, 0x27, 0x23, 0x59, 0xbd, 0x9c, 0xf4, 0x09, 0x16, 0x24, 0x0e, 0xe7,
+	0xd5, 0x80, 0xfc, 0xa7, 0x39, 0xf7, 0x8a, 0xaa, 0x76, 0x40, 0x95, 0x13, 0x2c, 0x58, 0x82, 0x36,
+	0xf3, 0xa6, 0xe4, 0xb7, 0xac, 0x97, 0x11, 0x27, 0x58, 0x92, 0x38, 0xc4, 0x12, 0xdd, 0x57, 0x5f,
+	0xd4, 0x0a, 0x62, 0xcb, 0x4c, 0xce, 0xea, 0xf9, 0x9c, 0xcb, 0x0f, 0x72, 0xb9, 0x20, 0xb6, 0x84,
+	0xaf, 0x40, 0x55, 0x48, 0x2c, 0x87, 0x02, 0x6d, 0x59, 0x5a, 0x7d, 0xeb, 0xec, 0xa8, 0xb1, 0x62,
+	0xc8, 0x8d, 0xc5, 0x28, 0xdb, 0xca, 0xec, 0x17, 0xa1, 0xe3, 0xef, 0x65, 0xa0, 0x2f, 0x8b, 0xb0,
+	0x09, 0x4c, 0xaf, 0xe5, 0x5e, 0x06, 0xe1, 0x85, 0x6f, 0x5f, 0x06, 0x61, 0x3b, 0xb0, 0x83, 0x4e,
+	0x3b, 0xec, 0x5c, 0xb6, 0x3d, 0xa7, 0xe9, 0xbe, 0x75, 0x9d, 0x73, 0xbd, 0x64, 0x1c, 0x8e, 0x27,
+	0xd6, 0xde, 0x72, 0xb2, 0x93, 0x88, 0x94, 0x44, 0xd9, 0x78, 0x62, 0xf8, 0x02, 0xec, 0xae, 0x78,
+	0xc4, 0x6e, 0x06, 0xee, 0x95, 0xa3, 0x6b, 0x86, 0x31, 0x9e, 0x58, 0x3b, 0xcb, 0x79, 0x3b, 0x92,
+	0x74, 0x44, 0xe0, 0x6b, 0xb0, 0xbf, 0x22, 0xda, 0x6c, 0x7d, 0xf0, 0xde, 0x3b, 0x81, 0x73, 0xae,
+	0x97, 0x8d, 0x83, 0xf1, 0xc4, 0xda, 0x5d, 0x4e, 0x37, 0xd9, 0x20, 0xed, 0x13, 0x49, 0x62, 0xf8,
+	0x12, 0x18, 0x2b, 0x1e, 0xf0, 0x9d, 0xab, 0xd6, 0x3b, 0xe7, 0x5c, 0xaf, 0x18, 0x7b, 0xe3, 0x89,
+	0xf5, 0xe4, 0xbf, 0x9e, 0xe4, 0x3d, 0x35, 0xd6, 0xbe, 0xfe, 0x30, 0x4b, 0x6f, 0xce, 0x7e, 0x4e,
+	0x4d, 0xed, 0x76, 0x6a, 0x6a, 0xbf, 0xa7, 0xa6, 0xf6, 0x6d, 0x66, 0x96, 0x6e, 0x67, 0x66, 0xe9,
+	0xd7, 0xcc, 0x2c, 0x7d, 0x44, 0x8b, 0x65, 0xfb, 0x32, 0x5f, 0x37, 0x79, 0x93, 0x12, 0xd1, 0xad,
+	0xaa, 0xdd, 0x79, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x29, 0xbc, 0x89, 0x82, 0x8f, 0x03, 0x00,
+	0x00,
+}
+
+func (m *PointGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PointGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintPointGrant(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.RevokedAt != 0 {
+		i = encodeVarintPointGrant(dAtA, i, uint64(m.RevokedAt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintPointGrant(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPointGrant(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ReleasedPeriods != 0 {
+		i = encodeVarintPointGrant(dAtA, i, uint64(m.ReleasedPeriods))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintPointGrant(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Revocable {
+		i--
+		if m.Revocable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AmountPerPeriod != 0 {
+		i = encodeVarintPointGrant(dAtA, i, uint64(m.AmountPerPeriod))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Periods != 0 {
+		i = encodeVarintPointGrant(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintPointGrant(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintPointGrant(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintPointGrant(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintPointGrant(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPointGrant(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPointGrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPointGrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PointGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPointGrant(uint64(m.Id))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovPointGrant(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovPointGrant(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovPointGrant(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovPointGrant(uint64(l))
+	}
+	if m.Periods != 0 {
+		n += 1 + sovPointGrant(uint64(m.Periods))
+	}
+	if m.AmountPerPeriod != 0 {
+		n += 1 + sovPointGrant(uint64(m.AmountPerPeriod))
+	}
+	if m.Revocable {
+		n += 2
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovPointGrant(uint64(m.StartEpoch))
+	}
+	if m.ReleasedPeriods != 0 {
+		n += 1 + sovPointGrant(uint64(m.ReleasedPeriods))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPointGrant(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovPointGrant(uint64(m.CreatedAt))
+	}
+	if m.RevokedAt != 0 {
+		n += 1 + sovPointGrant(uint64(m.RevokedAt))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPointGrant(uint64(m.Status))
+	}
+	return n
+}
+
+func sovPointGrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPointGrant(x uint64) (n int) {
+	return sovPointGrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PointGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPointGrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerPeriod", wireType)
+			}
+			m.AmountPerPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmountPerPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revocable = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedPeriods", wireType)
+			}
+			m.ReleasedPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleasedPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			m.RevokedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PointGrantStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPointGrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPointGrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPointGrant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPointGrant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPointGrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPointGrant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPointGrant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPointGrant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPointGrant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPointGrant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPointGrant = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"scontract/x/points/types"
+)
+
+func TestPointGrantDuePeriods(t *testing.T) {
+	grant := types.PointGrant{Periods: 3, AmountPerPeriod: 10, StartEpoch: 5}
+
+	require.Zero(t, grant.DuePeriods(4))
+	require.Zero(t, grant.DuePeriods(5))
+	require.EqualValues(t, 1, grant.DuePeriods(6))
+	require.EqualValues(t, 3, grant.DuePeriods(8))
+	require.EqualValues(t, 3, grant.DuePeriods(100))
+
+	grant.ReleasedPeriods = 1
+	require.EqualValues(t, 20, grant.Unreleased())
+}
+
+func TestPointGrantValidate(t *testing.T) {
+	valid := types.PointGrant{ProgramId: "p", Issuer: "a", Beneficiary: "b", EpochIdentifier: "week", Periods: 2, AmountPerPeriod: 10, Status: types.PointGrantStatusActive}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		desc   string
+		modify func(*types.PointGrant)
+	}{
+		{desc: "empty epoch identifier", modify: func(g *types.PointGrant) { g.EpochIdentifier = "" }},
+		{desc: "no periods", modify: func(g *types.PointGrant) { g.Periods = 0 }},
+		{desc: "total overflow", modify: func(g *types.PointGrant) { g.AmountPerPeriod = math.MaxUint64 }},
+		{desc: "released past periods", modify: func(g *types.PointGrant) { g.ReleasedPeriods = 3 }},
+		{desc: "unspecified status", modify: func(g *types.PointGrant) { g.Status = types.PointGrantStatusUnspecified }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			grant := valid
+			tc.modify(&grant)
+			require.ErrorIs(t, grant.Validate(), types.ErrInvalidGrant)
+		})
+	}
+}
@@ -0,0 +1,616 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scontract/points/v1/point_supply.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PointSupply holds the running supply counters of a point program.
+type PointSupply struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// issued is the total amount of points issued.
+	Issued uint64 `protobuf:"varint,2,opt,name=issued,proto3" json:"issued,omitempty"`
+	// spent is the total amount of points spent at merchants. Spent points are
+	// credited to the merchant and stay in circulation.
+	Spent uint64 `protobuf:"varint,3,opt,name=spent,proto3" json:"spent,omitempty"`
+	// transferred is the total amount of points transferred between accounts.
+	Transferred uint64 `protobuf:"varint,4,opt,name=transferred,proto3" json:"transferred,omitempty"`
+	// settled is the amount of points taken out of circulation by settlement
+	// requests, net of the points refunded by rejected or cancelled ones.
+	Settled uint64 `protobuf:"varint,5,opt,name=settled,proto3" json:"settled,omitempty"`
+	// expired is the total amount of points that expired.
+	Expired uint64 `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	// tokenized is the amount of points currently held as bank coins of the
+	// program denom. It always equals the bank supply of that denom.
+	Tokenized uint64 `protobuf:"varint,7,opt,name=tokenized,proto3" json:"tokenized,omitempty"`
+	// refunded is the total amount of spent points merchants refunded.
+	Refunded uint64 `protobuf:"varint,8,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// fees_paid is the total amount of points burned paying transaction fees.
+	FeesPaid uint64 `protobuf:"varint,9,opt,name=fees_paid,json=feesPaid,proto3" json:"fees_paid,omitempty"`
+}
+
+func (m *PointSupply) Reset()         { *m = PointSupply{} }
+func (m *PointSupply) String() string { return proto.CompactTextString(m) }
+func (*PointSupply) ProtoMessage()    {}
+func (*PointSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ded62388b42816e, []int{0}
+}
+func (m *PointSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointSupply.Merge(m, src)
+}
+func (m *PointSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointSupply proto.InternalMessageInfo
+
+func (m *PointSupply) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *PointSupply) GetIssued() uint64 {
+	if m != nil {
+		return m.Issued
+	}
+	return 0
+}
+
+func (m *PointSupply) GetSpent() uint64 {
+	if m != nil {
+		return m.Spent
+	}
+	return 0
+}
+
+func (m *PointSupply) GetTransferred() uint64 {
+	if m != nil {
+		return m.Transferred
+	}
+	return 0
+}
+
+func (m *PointSupply) GetSettled() uint64 {
+	if m != nil {
+		return m.Settled
+	}
+	return 0
+}
+
+func (m *PointSupply) GetExpired() uint64 {
+	if m != nil {
+		return m.Expired
+	}
+	return 0
+}
+
+func (m *PointSupply) GetTokenized() uint64 {
+	if m != nil {
+		return m.Tokenized
+	}
+	return 0
+}
+
+func (m *PointSupply) GetRefunded() uint64 {
+	if m != nil {
+		return m.Refunded
+	}
+	return 0
+}
+
+func (m *PointSupply) GetFeesPaid() uint64 {
+	if m != nil {
+		return m.FeesPaid
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PointSupply)(nil), "scontract.points.v1.PointSupply")
+}
+
+func init() {
+	proto.RegisterFile("scontract/points/v1/point_supply.proto", fileDescriptor_9ded62388b42816e)
+}
+
+var fileDescriptor_9ded62388b42816e = []byte{
+	// 279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x46, 0xeb, 0xd2, 0xbf, 0xdc, 0x6e, 0x06, 0x21, 0x8b, 0x1f, 0x2b, 0x62, 0x40, 0x9d, 0x52,
+	0x15, 0xde, 0x80, 0x8d, 0xad, 0x2a, 0x1b, 0x4b, 0x14, 0xea, 0x1b, 0x64, 0x51, 0x6c, 0xcb, 0x76,
+	0xaa, 0x96, 0x17, 0x60, 0xe5, 0xb1, 0x18, 0x3b, 0x32, 0xa2, 0xe4, 0x45, 0x50, 0x9c, 0xb4, 0x65,
+	0xf3, 0x39, 0xe7, 0x7a, 0xf9, 0xe0, 0xd6, 0x2d, 0xb5, 0xf2, 0x36, 0x5b, 0xfa, 0xa9, 0xd1, 0x52,
+	0x79, 0x37, 0x5d, 0xcf, 0x9a, 0x57, 0xea, 0x0a, 0x63, 0x56, 0xdb, 0xc4, 0x58, 0xed, 0x35, 0x3d,
+	0x3d, 0xdc, 0x25, 0xcd, 0x5d, 0xb2, 0x9e, 0xdd, 0x7c, 0x76, 0x61, 0x3c, 0xaf, 0xe9, 0x29, 0x9c,
+	0xd2, 0x6b, 0x00, 0x63, 0xf5, 0xab, 0xcd, 0xde, 0x53, 0x29, 0x18, 0x89, 0xc9, 0x24, 0x5a, 0x44,
+	0xad, 0x79, 0x14, 0xf4, 0x1c, 0x06, 0xd2, 0xb9, 0x02, 0x05, 0xeb, 0xc6, 0x64, 0xd2, 0x5b, 0xb4,
+	0x44, 0xcf, 0xa0, 0xef, 0x0c, 0x2a, 0xcf, 0x4e, 0x82, 0x6e, 0x80, 0xc6, 0x30, 0xf6, 0x36, 0x53,
+	0x2e, 0x47, 0x6b, 0x51, 0xb0, 0x5e, 0x68, 0xff, 0x15, 0x65, 0x30, 0x74, 0xe8, 0xfd, 0x0a, 0x05,
+	0xeb, 0x87, 0xba, 0xc7, 0xba, 0xe0, 0xc6, 0xc8, 0xfa, 0xdf, 0xa0, 0x29, 0x2d, 0xd2, 0x2b, 0x88,
+	0xbc, 0x7e, 0x43, 0x25, 0x3f, 0x50, 0xb0, 0x61, 0x68, 0x47, 0x41, 0x2f, 0x60, 0x64, 0x31, 0x2f,
+	0x94, 0x40, 0xc1, 0x46, 0x21, 0x1e, 0x98, 0x5e, 0x42, 0x94, 0x23, 0xba, 0xd4, 0x64, 0x52, 0xb0,
+	0xa8, 0x89, 0xb5, 0x98, 0x67, 0x52, 0x3c, 0xdc, 0x7d, 0x97, 0x9c, 0xec, 0x4a, 0x4e, 0x7e, 0x4b,
+	0x4e, 0xbe, 0x2a, 0xde, 0xd9, 0x55, 0xbc, 0xf3, 0x53, 0xf1, 0xce, 0x33, 0x3b, 0x0e, 0xbc, 0xd9,
+	0x4f, 0xec, 0xb7, 0x06, 0xdd, 0xcb, 0x20, 0x2c, 0x7b, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x44,
+	0x1d, 0xc3, 0x1d, 0x83, 0x01, 0x00, 0x00,
+}
+
+func (m *PointSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PointSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeesPaid != 0 {
+		i = encodeVarintPointSupply(dAtA, i, uint64(m.FeesPaid))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Refunded != 0 {
+		i = encodeVarintPointSupply(dAtA, i, uint64(m.Refunded))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Tokenized != 0 {
+		i = encodeVarintPointSupply(dAtA, i, uint64(m.Tokenized))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Expired != 0 {
+		i = encodeVarintPointSupply(dAtA, i, uint64(m.Expired))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Settled != 0 {
+		i = encodeVarintPointSupply(dAtA, i, uint64(m.Settled))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Transferred != 0 {
+		i = encodeVarintPointSupply(dAtA, i, uint64(m.Transferred))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Spent != 0 {
+		i = encodeVarintPointSupply(dAtA, i, uint64(m.Spent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Issued != 0 {
+		i = encodeVarintPointSupply(dAtA, i, uint64(m.Issued))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintPointSupply(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPointSupply(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPointSupply(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PointSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovPointSupply(uint64(l))
+	}
+	if m.Issued != 0 {
+		n += 1 + sovPointSupply(uint64(m.Issued))
+	}
+	if m.Spent != 0 {
+		n += 1 + sovPointSupply(uint64(m.Spent))
+	}
+	if m.Transferred != 0 {
+		n += 1 + sovPointSupply(uint64(m.Transferred))
+	}
+	if m.Settled != 0 {
+		n += 1 + sovPointSupply(uint64(m.Settled))
+	}
+	if m.Expired != 0 {
+		n += 1 + sovPointSupply(uint64(m.Expired))
+	}
+	if m.Tokenized != 0 {
+		n += 1 + sovPointSupply(uint64(m.Tokenized))
+	}
+	if m.Refunded != 0 {
+		n += 1 + sovPointSupply(uint64(m.Refunded))
+	}
+	if m.FeesPaid != 0 {
+		n += 1 + sovPointSupply(uint64(m.FeesPaid))
+	}
+	return n
+}
+
+func sovPointSupply(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPointSupply(x uint64) (n int) {
+	return sovPointSupply(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PointSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPointSupply
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPointSupply
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPointSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+			m.Issued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Issued |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			m.Spent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Spent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferred", wireType)
+			}
+			m.Transferred = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transferred |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			m.Settled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Settled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			m.Expired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expired |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenized", wireType)
+			}
+			m.Tokenized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tokenized |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			m.Refunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+			}
+			m.FeesPaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeesPaid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPointSupply(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPointSupply
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPointSupply(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPointSupply
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPointSupply
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPointSupply
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPointSupply
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPointSupply
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPointSupply        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPointSupply          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPointSupply = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultProgramID is the id of the program the balances, transactions and
+// settlements from before programs existed were migrated into.
+const DefaultProgramID = "default"
+
+// MaxProgramDecimals is the largest number of decimals a program may use.
+const MaxProgramDecimals = 18
+
+var programIDRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// ValidateProgramID returns an error if id is not a valid program id: 1 to 32
+// lowercase letters, digits, '-' or '_', starting with a letter or digit.
+func ValidateProgramID(id string) error {
+	if !programIDRegexp.MatchString(id) {
+		return errorsmod.Wrapf(ErrInvalidProgram, "invalid program id %q", id)
+	}
+	return nil
+}
+
+// Validate performs basic validation of the program.
+func (p PointProgram) Validate() error {
+	if err := ValidateProgramID(p.Id); err != nil {
+		return err
+	}
+	if p.Name == "" {
+		return errorsmod.Wrap(ErrInvalidProgram, "program name cannot be empty")
+	}
+	if p.Decimals > MaxProgramDecimals {
+		return errorsmod.Wrapf(ErrInvalidProgram, "decimals %d exceed %d", p.Decimals, MaxProgramDecimals)
+	}
+	seen := make(map[string]struct{}, len(p.Issuers))
+	for _, issuer := range p.Issuers {
+		if _, ok := seen[issuer]; ok {
+			return errorsmod.Wrapf(ErrInvalidProgram, "duplicate issuer %s", issuer)
+		}
+		seen[issuer] = struct{}{}
+	}
+	return nil
+}
+
+// IsIssuer reports whether address may issue the program's points.
+func (p PointProgram) IsIssuer(address string) bool {
+	for _, issuer := range p.Issuers {
+		if issuer == address {
+			return true
+		}
+	}
+	return false
+}
+
+// programOwnerKey keys genesis entries owned by an account within a program.
+func programOwnerKey(programID, owner string) string {
+	return fmt.Sprintf("%s/%s", programID, owner)
+}
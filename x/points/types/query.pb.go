@@ -31,6 +31,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TransactionDirection selects transactions by the side an address is on.
+type TransactionDirection int32
+
+const (
+	// TRANSACTION_DIRECTION_UNSPECIFIED matches transactions the address sent
+	// or received.
+	TransactionDirectionUnspecified TransactionDirection = 0
+	// TRANSACTION_DIRECTION_SENT matches transactions the address sent.
+	TransactionDirectionSent TransactionDirection = 1
+	// TRANSACTION_DIRECTION_RECEIVED matches transactions the address received.
+	TransactionDirectionReceived TransactionDirection = 2
+)
+
+var TransactionDirection_name = map[int32]string{
+	0: "TRANSACTION_DIRECTION_UNSPECIFIED",
+	1: "TRANSACTION_DIRECTION_SENT",
+	2: "TRANSACTION_DIRECTION_RECEIVED",
+}
+
+var TransactionDirection_value = map[string]int32{
+	"TRANSACTION_DIRECTION_UNSPECIFIED": 0,
+	"TRANSACTION_DIRECTION_SENT":        1,
+	"TRANSACTION_DIRECTION_RECEIVED":    2,
+}
+
+func (x TransactionDirection) String() string {
+	return proto.EnumName(TransactionDirection_name, int32(x))
+}
+
+func (TransactionDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -116,7 +149,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 
 // QueryGetPointBalanceRequest defines the QueryGetPointBalanceRequest message.
 type QueryGetPointBalanceRequest struct {
-	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Index     string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	ProgramId string `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 }
 
 func (m *QueryGetPointBalanceRequest) Reset()         { *m = QueryGetPointBalanceRequest{} }
@@ -159,6 +193,13 @@ func (m *QueryGetPointBalanceRequest) GetIndex() string {
 	return ""
 }
 
+func (m *QueryGetPointBalanceRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
 // QueryGetPointBalanceResponse defines the QueryGetPointBalanceResponse message.
 type QueryGetPointBalanceResponse struct {
 	PointBalance PointBalance `protobuf:"bytes,1,opt,name=point_balance,json=pointBalance,proto3" json:"point_balance"`
@@ -207,6 +248,7 @@ func (m *QueryGetPointBalanceResponse) GetPointBalance() PointBalance {
 // QueryAllPointBalanceRequest defines the QueryAllPointBalanceRequest message.
 type QueryAllPointBalanceRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProgramId  string             `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 }
 
 func (m *QueryAllPointBalanceRequest) Reset()         { *m = QueryAllPointBalanceRequest{} }
@@ -249,6 +291,13 @@ func (m *QueryAllPointBalanceRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllPointBalanceRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
 // QueryAllPointBalanceResponse defines the QueryAllPointBalanceResponse message.
 type QueryAllPointBalanceResponse struct {
 	PointBalance []PointBalance      `protobuf:"bytes,1,rep,name=point_balance,json=pointBalance,proto3" json:"point_balance"`
@@ -304,7 +353,8 @@ func (m *QueryAllPointBalanceResponse) GetPagination() *query.PageResponse {
 
 // QueryGetTransactionRequest defines the QueryGetTransactionRequest message.
 type QueryGetTransactionRequest struct {
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProgramId string `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 }
 
 func (m *QueryGetTransactionRequest) Reset()         { *m = QueryGetTransactionRequest{} }
@@ -347,6 +397,13 @@ func (m *QueryGetTransactionRequest) GetId() uint64 {
 	return 0
 }
 
+func (m *QueryGetTransactionRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
 // QueryGetTransactionResponse defines the QueryGetTransactionResponse message.
 type QueryGetTransactionResponse struct {
 	Transaction Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction"`
@@ -395,6 +452,9 @@ func (m *QueryGetTransactionResponse) GetTransaction() Transaction {
 // QueryAllTransactionRequest defines the QueryAllTransactionRequest message.
 type QueryAllTransactionRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProgramId  string             `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// order_reference, if set, only lists the transactions recorded with it.
+	OrderReference string `protobuf:"bytes,3,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
 }
 
 func (m *QueryAllTransactionRequest) Reset()         { *m = QueryAllTransactionRequest{} }
@@ -437,6 +497,20 @@ func (m *QueryAllTransactionRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllTransactionRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *QueryAllTransactionRequest) GetOrderReference() string {
+	if m != nil {
+		return m.OrderReference
+	}
+	return ""
+}
+
 // QueryAllTransactionResponse defines the QueryAllTransactionResponse message.
 type QueryAllTransactionResponse struct {
 	Transaction []Transaction       `protobuf:"bytes,1,rep,name=transaction,proto3" json:"transaction"`
@@ -492,7 +566,8 @@ func (m *QueryAllTransactionResponse) GetPagination() *query.PageResponse {
 
 // QueryGetSettlementRequest defines the QueryGetSettlementRequest message.
 type QueryGetSettlementRequest struct {
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProgramId string `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 }
 
 func (m *QueryGetSettlementRequest) Reset()         { *m = QueryGetSettlementRequest{} }
@@ -535,6 +610,13 @@ func (m *QueryGetSettlementRequest) GetId() uint64 {
 	return 0
 }
 
+func (m *QueryGetSettlementRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
 // QueryGetSettlementResponse defines the QueryGetSettlementResponse message.
 type QueryGetSettlementResponse struct {
 	Settlement Settlement `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement"`
@@ -583,6 +665,7 @@ func (m *QueryGetSettlementResponse) GetSettlement() Settlement {
 // QueryAllSettlementRequest defines the QueryAllSettlementRequest message.
 type QueryAllSettlementRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProgramId  string             `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 }
 
 func (m *QueryAllSettlementRequest) Reset()         { *m = QueryAllSettlementRequest{} }
@@ -625,6 +708,13 @@ func (m *QueryAllSettlementRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllSettlementRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
 // QueryAllSettlementResponse defines the QueryAllSettlementResponse message.
 type QueryAllSettlementResponse struct {
 	Settlement []Settlement        `protobuf:"bytes,1,rep,name=settlement,proto3" json:"settlement"`
@@ -678,1086 +768,14651 @@ func (m *QueryAllSettlementResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "scontract.points.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "scontract.points.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetPointBalanceRequest)(nil), "scontract.points.v1.QueryGetPointBalanceRequest")
-	proto.RegisterType((*QueryGetPointBalanceResponse)(nil), "scontract.points.v1.QueryGetPointBalanceResponse")
-	proto.RegisterType((*QueryAllPointBalanceRequest)(nil), "scontract.points.v1.QueryAllPointBalanceRequest")
-	proto.RegisterType((*QueryAllPointBalanceResponse)(nil), "scontract.points.v1.QueryAllPointBalanceResponse")
-	proto.RegisterType((*QueryGetTransactionRequest)(nil), "scontract.points.v1.QueryGetTransactionRequest")
-	proto.RegisterType((*QueryGetTransactionResponse)(nil), "scontract.points.v1.QueryGetTransactionResponse")
-	proto.RegisterType((*QueryAllTransactionRequest)(nil), "scontract.points.v1.QueryAllTransactionRequest")
-	proto.RegisterType((*QueryAllTransactionResponse)(nil), "scontract.points.v1.QueryAllTransactionResponse")
-	proto.RegisterType((*QueryGetSettlementRequest)(nil), "scontract.points.v1.QueryGetSettlementRequest")
-	proto.RegisterType((*QueryGetSettlementResponse)(nil), "scontract.points.v1.QueryGetSettlementResponse")
-	proto.RegisterType((*QueryAllSettlementRequest)(nil), "scontract.points.v1.QueryAllSettlementRequest")
-	proto.RegisterType((*QueryAllSettlementResponse)(nil), "scontract.points.v1.QueryAllSettlementResponse")
+// QueryGetIssuerRequest defines the QueryGetIssuerRequest message.
+type QueryGetIssuerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("scontract/points/v1/query.proto", fileDescriptor_fdd817e68478f511) }
-
-var fileDescriptor_fdd817e68478f511 = []byte{
-	// 779 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4f, 0xd4, 0x4e,
-	0x18, 0xc7, 0x77, 0x96, 0x3f, 0xf9, 0x31, 0xfc, 0x00, 0x1d, 0x38, 0x60, 0xc1, 0xdd, 0xa5, 0x02,
-	0x4b, 0x56, 0xec, 0xb0, 0xcb, 0xdd, 0x84, 0x4d, 0x14, 0x0f, 0x1c, 0x70, 0xd5, 0x8b, 0x17, 0x33,
-	0xdb, 0x9d, 0x34, 0x4d, 0xba, 0x9d, 0xb2, 0x1d, 0x08, 0x84, 0x90, 0x18, 0x5f, 0x81, 0x89, 0x37,
-	0x0f, 0x1e, 0xf4, 0x62, 0x8c, 0x07, 0x2e, 0xbe, 0x07, 0x8e, 0x24, 0x5e, 0x3c, 0x19, 0x05, 0x13,
-	0xdf, 0x86, 0xe9, 0x74, 0x96, 0xb6, 0xee, 0xec, 0xb6, 0x92, 0xbd, 0x90, 0xd2, 0xfd, 0x7e, 0x3b,
-	0x9f, 0xef, 0xf3, 0x3c, 0x79, 0x5a, 0x58, 0xf4, 0x4d, 0xe6, 0xf2, 0x0e, 0x31, 0x39, 0xf6, 0x98,
-	0xed, 0x72, 0x1f, 0x1f, 0x54, 0xf1, 0xde, 0x3e, 0xed, 0x1c, 0x19, 0x5e, 0x87, 0x71, 0x86, 0x66,
-	0xaf, 0x04, 0x46, 0x28, 0x30, 0x0e, 0xaa, 0xda, 0x4d, 0xd2, 0xb6, 0x5d, 0x86, 0xc5, 0xdf, 0x50,
-	0xa7, 0x55, 0x4c, 0xe6, 0xb7, 0x99, 0x8f, 0x9b, 0xc4, 0xa7, 0xe1, 0x03, 0xf0, 0x41, 0xb5, 0x49,
-	0x39, 0xa9, 0x62, 0x8f, 0x58, 0xb6, 0x4b, 0xb8, 0xcd, 0x5c, 0xa9, 0x9d, 0xb3, 0x98, 0xc5, 0xc4,
-	0x25, 0x0e, 0xae, 0xe4, 0xdd, 0x45, 0x8b, 0x31, 0xcb, 0xa1, 0x98, 0x78, 0x36, 0x26, 0xae, 0xcb,
-	0xb8, 0xb0, 0xf8, 0xf2, 0xd7, 0x92, 0x0a, 0xd4, 0x23, 0x1d, 0xd2, 0xee, 0x2a, 0xca, 0x4a, 0x45,
-	0x70, 0xf5, 0xa2, 0x49, 0x1c, 0xe2, 0x9a, 0x54, 0x0a, 0x97, 0x55, 0x42, 0x9f, 0x72, 0xee, 0xd0,
-	0x36, 0x75, 0xb9, 0x54, 0xad, 0xa8, 0x54, 0xbc, 0x43, 0x5c, 0x9f, 0x98, 0x51, 0x16, 0x7d, 0x0e,
-	0xa2, 0xc7, 0x41, 0xda, 0x5d, 0x81, 0xd2, 0xa0, 0x7b, 0xfb, 0xd4, 0xe7, 0xfa, 0x33, 0x38, 0x9b,
-	0xb8, 0xeb, 0x7b, 0xcc, 0xf5, 0x29, 0xba, 0x0f, 0xc7, 0x43, 0xe4, 0x79, 0x50, 0x02, 0x6b, 0x93,
-	0xb5, 0x05, 0x43, 0x51, 0x5d, 0x23, 0x34, 0xd5, 0x27, 0xce, 0xbe, 0x17, 0x73, 0x1f, 0x7f, 0x9f,
-	0x56, 0x40, 0x43, 0xba, 0xf4, 0x4d, 0xb8, 0x20, 0x1e, 0xbb, 0x4d, 0xf9, 0x6e, 0x20, 0xaf, 0x87,
-	0xb9, 0xe4, 0xa9, 0x68, 0x0e, 0x8e, 0xd9, 0x6e, 0x8b, 0x1e, 0x8a, 0xa7, 0x4f, 0x34, 0xc2, 0x7f,
-	0x74, 0x07, 0x2e, 0xaa, 0x4d, 0x12, 0x6a, 0x07, 0x4e, 0x25, 0xaa, 0x24, 0xd9, 0x96, 0xd4, 0x6c,
-	0xb1, 0x27, 0xd4, 0x47, 0x03, 0xc2, 0xc6, 0xff, 0x5e, 0xec, 0x9e, 0x4e, 0x25, 0xe2, 0x96, 0xe3,
-	0xa8, 0x10, 0x1f, 0x42, 0x18, 0x8d, 0x83, 0x3c, 0x69, 0xd5, 0x08, 0x67, 0xc7, 0x08, 0x66, 0xc7,
-	0x08, 0x87, 0x4f, 0xce, 0x8e, 0xb1, 0x4b, 0xac, 0xae, 0xb7, 0x11, 0x73, 0xea, 0x5f, 0x80, 0x4c,
-	0xd5, 0x73, 0x4e, 0xff, 0x54, 0x23, 0xd7, 0x4e, 0x85, 0xb6, 0x13, 0xd8, 0x79, 0x81, 0x5d, 0x4e,
-	0xc5, 0x0e, 0x51, 0x12, 0xdc, 0xeb, 0x50, 0xeb, 0x36, 0xe3, 0x69, 0x34, 0x4b, 0xdd, 0xea, 0x4c,
-	0xc3, 0xbc, 0xdd, 0x12, 0x55, 0x19, 0x6d, 0xe4, 0xed, 0x96, 0x6e, 0x45, 0xfd, 0x4e, 0xa8, 0x65,
-	0xc6, 0x47, 0x70, 0x32, 0x36, 0x90, 0xb2, 0x9a, 0x25, 0x65, 0xc2, 0x98, 0x5d, 0x06, 0x8c, 0x5b,
-	0xf5, 0x96, 0xc4, 0xda, 0x72, 0x1c, 0x05, 0xd6, 0xb0, 0x9a, 0x76, 0x0a, 0xa2, 0xe1, 0xc8, 0x94,
-	0x67, 0xe4, 0x9a, 0x79, 0x86, 0xd7, 0xaf, 0xbb, 0xf0, 0x56, 0xb7, 0x03, 0x4f, 0xae, 0x36, 0x44,
-	0xbf, 0x76, 0x99, 0x51, 0x73, 0xe3, 0x62, 0x99, 0xee, 0x01, 0x84, 0xd1, 0x92, 0x91, 0x55, 0x2c,
-	0x2a, 0xc3, 0x45, 0x66, 0x99, 0x2d, 0x66, 0xd4, 0x4d, 0x49, 0xb4, 0xe5, 0x38, 0xbd, 0x44, 0xc3,
-	0xea, 0xd4, 0x67, 0x10, 0x0d, 0x44, 0x86, 0x28, 0x23, 0xd7, 0x8a, 0x32, 0xb4, 0x2e, 0xd5, 0x7e,
-	0xfe, 0x07, 0xc7, 0x04, 0x2e, 0x7a, 0x09, 0xe0, 0x78, 0xb8, 0x3f, 0x51, 0x59, 0x09, 0xd4, 0xbb,
-	0xac, 0xb5, 0xb5, 0x74, 0x61, 0x78, 0xa6, 0x7e, 0xe7, 0xd5, 0xd7, 0x5f, 0x6f, 0xf2, 0xb7, 0xd1,
-	0x02, 0xee, 0xff, 0x36, 0x42, 0x9f, 0x00, 0x9c, 0xf9, 0x6b, 0xd7, 0xa2, 0x8d, 0xfe, 0x47, 0xa8,
-	0x77, 0xb9, 0x56, 0xfd, 0x07, 0x87, 0xa4, 0xab, 0x09, 0xba, 0x75, 0x54, 0xc1, 0xa9, 0x6f, 0x42,
-	0x7c, 0x2c, 0xde, 0x0d, 0x27, 0xe8, 0x03, 0x80, 0x37, 0x76, 0x6c, 0x3f, 0x33, 0xad, 0x7a, 0xad,
-	0x0f, 0xa2, 0xed, 0xb3, 0xa0, 0xf5, 0x8a, 0xa0, 0x5d, 0x46, 0x7a, 0x3a, 0x2d, 0x7a, 0x0f, 0xe0,
-	0x74, 0x72, 0x07, 0x22, 0x3c, 0xb0, 0x3e, 0xbd, 0x4b, 0x4c, 0xdb, 0xc8, 0x6e, 0x90, 0x84, 0xf7,
-	0x04, 0x61, 0x19, 0xad, 0xe0, 0x94, 0x4f, 0x01, 0x7c, 0x6c, 0xb7, 0x4e, 0xd0, 0x3b, 0x00, 0x67,
-	0x82, 0x52, 0x66, 0xa4, 0x54, 0xae, 0x5a, 0x6d, 0x23, 0xbb, 0x41, 0x52, 0xae, 0x09, 0x4a, 0x1d,
-	0x95, 0xd2, 0x28, 0x03, 0xc0, 0xa9, 0xc4, 0x6a, 0x42, 0xc6, 0xc0, 0x9a, 0xf4, 0xac, 0x17, 0x0d,
-	0x67, 0xd6, 0x4b, 0xb8, 0x75, 0x01, 0xb7, 0x8a, 0x96, 0xf1, 0xe0, 0x6f, 0xae, 0xb0, 0x82, 0x6f,
-	0x01, 0x9c, 0x0e, 0x2a, 0x98, 0x8d, 0x50, 0xb5, 0x00, 0x35, 0x9c, 0x59, 0x2f, 0x09, 0xcb, 0x82,
-	0x70, 0x09, 0x15, 0x53, 0x08, 0xeb, 0xb5, 0xb3, 0x8b, 0x02, 0x38, 0xbf, 0x28, 0x80, 0x1f, 0x17,
-	0x05, 0xf0, 0xfa, 0xb2, 0x90, 0x3b, 0xbf, 0x2c, 0xe4, 0xbe, 0x5d, 0x16, 0x72, 0xcf, 0xe7, 0x23,
-	0xe7, 0x61, 0xd7, 0xcb, 0x8f, 0x3c, 0xea, 0x37, 0xc7, 0xc5, 0x37, 0xe2, 0xe6, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x28, 0x7b, 0xb3, 0xf5, 0x66, 0x0b, 0x00, 0x00,
+func (m *QueryGetIssuerRequest) Reset()         { *m = QueryGetIssuerRequest{} }
+func (m *QueryGetIssuerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetIssuerRequest) ProtoMessage()    {}
+func (*QueryGetIssuerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{14}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListPointBalance Queries a list of PointBalance items.
-	GetPointBalance(ctx context.Context, in *QueryGetPointBalanceRequest, opts ...grpc.CallOption) (*QueryGetPointBalanceResponse, error)
-	// ListPointBalance defines the ListPointBalance RPC.
-	ListPointBalance(ctx context.Context, in *QueryAllPointBalanceRequest, opts ...grpc.CallOption) (*QueryAllPointBalanceResponse, error)
-	// ListTransaction Queries a list of Transaction items.
-	GetTransaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error)
-	// ListTransaction defines the ListTransaction RPC.
-	ListTransaction(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error)
-	// ListSettlement Queries a list of Settlement items.
-	GetSettlement(ctx context.Context, in *QueryGetSettlementRequest, opts ...grpc.CallOption) (*QueryGetSettlementResponse, error)
-	// ListSettlement defines the ListSettlement RPC.
-	ListSettlement(ctx context.Context, in *QueryAllSettlementRequest, opts ...grpc.CallOption) (*QueryAllSettlementResponse, error)
+func (m *QueryGetIssuerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetIssuerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetIssuerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetIssuerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetIssuerRequest.Merge(m, src)
+}
+func (m *QueryGetIssuerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetIssuerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetIssuerRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetIssuerRequest proto.InternalMessageInfo
+
+func (m *QueryGetIssuerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetPointBalance(ctx context.Context, in *QueryGetPointBalanceRequest, opts ...grpc.CallOption) (*QueryGetPointBalanceResponse, error) {
-	out := new(QueryGetPointBalanceResponse)
-	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetPointBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetIssuerResponse defines the QueryGetIssuerResponse message.
+type QueryGetIssuerResponse struct {
+	Issuer Issuer `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer"`
 }
 
-func (c *queryClient) ListPointBalance(ctx context.Context, in *QueryAllPointBalanceRequest, opts ...grpc.CallOption) (*QueryAllPointBalanceResponse, error) {
-	out := new(QueryAllPointBalanceResponse)
-	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListPointBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetIssuerResponse) Reset()         { *m = QueryGetIssuerResponse{} }
+func (m *QueryGetIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetIssuerResponse) ProtoMessage()    {}
+func (*QueryGetIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{15}
+}
+func (m *QueryGetIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetIssuerResponse.Merge(m, src)
+}
+func (m *QueryGetIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetIssuerResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTransaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error) {
-	out := new(QueryGetTransactionResponse)
-	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetIssuerResponse proto.InternalMessageInfo
+
+func (m *QueryGetIssuerResponse) GetIssuer() Issuer {
+	if m != nil {
+		return m.Issuer
 	}
-	return out, nil
+	return Issuer{}
 }
 
-func (c *queryClient) ListTransaction(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error) {
-	out := new(QueryAllTransactionResponse)
-	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAllIssuerRequest defines the QueryAllIssuerRequest message.
+type QueryAllIssuerRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetSettlement(ctx context.Context, in *QueryGetSettlementRequest, opts ...grpc.CallOption) (*QueryGetSettlementResponse, error) {
-	out := new(QueryGetSettlementResponse)
-	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetSettlement", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllIssuerRequest) Reset()         { *m = QueryAllIssuerRequest{} }
+func (m *QueryAllIssuerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllIssuerRequest) ProtoMessage()    {}
+func (*QueryAllIssuerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{16}
+}
+func (m *QueryAllIssuerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllIssuerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllIssuerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllIssuerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllIssuerRequest.Merge(m, src)
+}
+func (m *QueryAllIssuerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllIssuerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllIssuerRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListSettlement(ctx context.Context, in *QueryAllSettlementRequest, opts ...grpc.CallOption) (*QueryAllSettlementResponse, error) {
-	out := new(QueryAllSettlementResponse)
-	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListSettlement", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllIssuerRequest proto.InternalMessageInfo
+
+func (m *QueryAllIssuerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListPointBalance Queries a list of PointBalance items.
-	GetPointBalance(context.Context, *QueryGetPointBalanceRequest) (*QueryGetPointBalanceResponse, error)
-	// ListPointBalance defines the ListPointBalance RPC.
-	ListPointBalance(context.Context, *QueryAllPointBalanceRequest) (*QueryAllPointBalanceResponse, error)
-	// ListTransaction Queries a list of Transaction items.
-	GetTransaction(context.Context, *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error)
-	// ListTransaction defines the ListTransaction RPC.
-	ListTransaction(context.Context, *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error)
-	// ListSettlement Queries a list of Settlement items.
-	GetSettlement(context.Context, *QueryGetSettlementRequest) (*QueryGetSettlementResponse, error)
-	// ListSettlement defines the ListSettlement RPC.
-	ListSettlement(context.Context, *QueryAllSettlementRequest) (*QueryAllSettlementResponse, error)
+// QueryAllIssuerResponse defines the QueryAllIssuerResponse message.
+type QueryAllIssuerResponse struct {
+	Issuer     []Issuer            `protobuf:"bytes,1,rep,name=issuer,proto3" json:"issuer"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllIssuerResponse) Reset()         { *m = QueryAllIssuerResponse{} }
+func (m *QueryAllIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllIssuerResponse) ProtoMessage()    {}
+func (*QueryAllIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{17}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetPointBalance(ctx context.Context, req *QueryGetPointBalanceRequest) (*QueryGetPointBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPointBalance not implemented")
+func (m *QueryAllIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListPointBalance(ctx context.Context, req *QueryAllPointBalanceRequest) (*QueryAllPointBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPointBalance not implemented")
+func (m *QueryAllIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllIssuerResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetTransaction(ctx context.Context, req *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+func (m *QueryAllIssuerResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ListTransaction(ctx context.Context, req *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTransaction not implemented")
+func (m *QueryAllIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllIssuerResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetSettlement(ctx context.Context, req *QueryGetSettlementRequest) (*QueryGetSettlementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSettlement not implemented")
+
+var xxx_messageInfo_QueryAllIssuerResponse proto.InternalMessageInfo
+
+func (m *QueryAllIssuerResponse) GetIssuer() []Issuer {
+	if m != nil {
+		return m.Issuer
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ListSettlement(ctx context.Context, req *QueryAllSettlementRequest) (*QueryAllSettlementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSettlement not implemented")
+
+func (m *QueryAllIssuerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryIssuerAllowanceRequest defines the QueryIssuerAllowanceRequest message.
+type QueryIssuerAllowanceRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/scontract.points.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryIssuerAllowanceRequest) Reset()         { *m = QueryIssuerAllowanceRequest{} }
+func (m *QueryIssuerAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuerAllowanceRequest) ProtoMessage()    {}
+func (*QueryIssuerAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{18}
+}
+func (m *QueryIssuerAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuerAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuerAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryIssuerAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuerAllowanceRequest.Merge(m, src)
+}
+func (m *QueryIssuerAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuerAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuerAllowanceRequest.DiscardUnknown(m)
 }
 
-func _Query_GetPointBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPointBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPointBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/scontract.points.v1.Query/GetPointBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPointBalance(ctx, req.(*QueryGetPointBalanceRequest))
+var xxx_messageInfo_QueryIssuerAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryIssuerAllowanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListPointBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPointBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPointBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/scontract.points.v1.Query/ListPointBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPointBalance(ctx, req.(*QueryAllPointBalanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryIssuerAllowanceResponse defines the QueryIssuerAllowanceResponse message.
+type QueryIssuerAllowanceResponse struct {
+	// epoch_number is the current issuance epoch.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// epoch_cap is the issuer's cap per epoch, zero when uncapped.
+	EpochCap uint64 `protobuf:"varint,2,opt,name=epoch_cap,json=epochCap,proto3" json:"epoch_cap,omitempty"`
+	// issued is the amount already issued in the current epoch.
+	Issued uint64 `protobuf:"varint,3,opt,name=issued,proto3" json:"issued,omitempty"`
+	// remaining is the amount that may still be issued in the current epoch.
+	// It is meaningless when unlimited is set.
+	Remaining uint64 `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// unlimited is true when the issuer has no epoch cap.
+	Unlimited bool `protobuf:"varint,5,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
 }
 
-func _Query_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTransactionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/scontract.points.v1.Query/GetTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTransaction(ctx, req.(*QueryGetTransactionRequest))
+func (m *QueryIssuerAllowanceResponse) Reset()         { *m = QueryIssuerAllowanceResponse{} }
+func (m *QueryIssuerAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuerAllowanceResponse) ProtoMessage()    {}
+func (*QueryIssuerAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{19}
+}
+func (m *QueryIssuerAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuerAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuerAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryIssuerAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuerAllowanceResponse.Merge(m, src)
+}
+func (m *QueryIssuerAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuerAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuerAllowanceResponse.DiscardUnknown(m)
 }
 
-func _Query_ListTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllTransactionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/scontract.points.v1.Query/ListTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListTransaction(ctx, req.(*QueryAllTransactionRequest))
+var xxx_messageInfo_QueryIssuerAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryIssuerAllowanceResponse) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetSettlementRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetSettlement(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/scontract.points.v1.Query/GetSettlement",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetSettlement(ctx, req.(*QueryGetSettlementRequest))
+func (m *QueryIssuerAllowanceResponse) GetEpochCap() uint64 {
+	if m != nil {
+		return m.EpochCap
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ListSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllSettlementRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListSettlement(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/scontract.points.v1.Query/ListSettlement",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListSettlement(ctx, req.(*QueryAllSettlementRequest))
+func (m *QueryIssuerAllowanceResponse) GetIssued() uint64 {
+	if m != nil {
+		return m.Issued
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "scontract.points.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetPointBalance",
-			Handler:    _Query_GetPointBalance_Handler,
-		},
-		{
-			MethodName: "ListPointBalance",
-			Handler:    _Query_ListPointBalance_Handler,
-		},
-		{
-			MethodName: "GetTransaction",
-			Handler:    _Query_GetTransaction_Handler,
-		},
-		{
-			MethodName: "ListTransaction",
-			Handler:    _Query_ListTransaction_Handler,
-		},
-		{
-			MethodName: "GetSettlement",
-			Handler:    _Query_GetSettlement_Handler,
-		},
-		{
-			MethodName: "ListSettlement",
-			Handler:    _Query_ListSettlement_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "scontract/points/v1/query.proto",
+func (m *QueryIssuerAllowanceResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIssuerAllowanceResponse) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTransactionsByAddressRequest defines the QueryTransactionsByAddressRequest message.
+type QueryTransactionsByAddressRequest struct {
+	Address   string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Direction TransactionDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=scontract.points.v1.TransactionDirection" json:"direction,omitempty"`
+	// tx_type, if set, only matches transactions of that type.
+	TxType TransactionType `protobuf:"varint,3,opt,name=tx_type,json=txType,proto3,enum=scontract.points.v1.TransactionType" json:"tx_type,omitempty"`
+	// start_time, if set, only matches transactions at or after this unix time.
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time, if set, only matches transactions before this unix time.
+	EndTime    int64              `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProgramId  string             `protobuf:"bytes,7,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryTransactionsByAddressRequest) Reset()         { *m = QueryTransactionsByAddressRequest{} }
+func (m *QueryTransactionsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransactionsByAddressRequest) ProtoMessage()    {}
+func (*QueryTransactionsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{20}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTransactionsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransactionsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransactionsByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTransactionsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransactionsByAddressRequest.Merge(m, src)
+}
+func (m *QueryTransactionsByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransactionsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransactionsByAddressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryTransactionsByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryTransactionsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetPointBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTransactionsByAddressRequest) GetDirection() TransactionDirection {
+	if m != nil {
+		return m.Direction
 	}
-	return dAtA[:n], nil
+	return TransactionDirectionUnspecified
 }
 
-func (m *QueryGetPointBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTransactionsByAddressRequest) GetTxType() TransactionType {
+	if m != nil {
+		return m.TxType
+	}
+	return TransactionTypeUnspecified
 }
 
-func (m *QueryGetPointBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryTransactionsByAddressRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetPointBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTransactionsByAddressRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetPointBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *QueryGetPointBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PointBalance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTransactionsByAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllPointBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTransactionsByAddressRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPointBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTransactionsByAddressResponse defines the QueryTransactionsByAddressResponse message.
+type QueryTransactionsByAddressResponse struct {
+	Transaction []Transaction       `protobuf:"bytes,1,rep,name=transaction,proto3" json:"transaction"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// checkpoint rolls up the transactions of the address that were pruned
+	// and are no longer listed.
+	Checkpoint TransactionCheckpoint `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint"`
 }
 
-func (m *QueryAllPointBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTransactionsByAddressResponse) Reset()         { *m = QueryTransactionsByAddressResponse{} }
+func (m *QueryTransactionsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransactionsByAddressResponse) ProtoMessage()    {}
+func (*QueryTransactionsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{21}
+}
+func (m *QueryTransactionsByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransactionsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransactionsByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTransactionsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransactionsByAddressResponse.Merge(m, src)
+}
+func (m *QueryTransactionsByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransactionsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransactionsByAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPointBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransactionsByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryTransactionsByAddressResponse) GetTransaction() []Transaction {
+	if m != nil {
+		return m.Transaction
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllPointBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTransactionsByAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllPointBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PointBalance) > 0 {
-		for iNdEx := len(m.PointBalance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PointBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryTransactionsByAddressResponse) GetCheckpoint() TransactionCheckpoint {
+	if m != nil {
+		return m.Checkpoint
 	}
-	return len(dAtA) - i, nil
+	return TransactionCheckpoint{}
 }
 
-func (m *QueryGetTransactionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QuerySettlementsByRequesterRequest defines the QuerySettlementsByRequesterRequest message.
+type QuerySettlementsByRequesterRequest struct {
+	Requester  string             `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProgramId  string             `protobuf:"bytes,3,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+}
+
+func (m *QuerySettlementsByRequesterRequest) Reset()         { *m = QuerySettlementsByRequesterRequest{} }
+func (m *QuerySettlementsByRequesterRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementsByRequesterRequest) ProtoMessage()    {}
+func (*QuerySettlementsByRequesterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{22}
+}
+func (m *QuerySettlementsByRequesterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementsByRequesterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementsByRequesterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySettlementsByRequesterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementsByRequesterRequest.Merge(m, src)
+}
+func (m *QuerySettlementsByRequesterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementsByRequesterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementsByRequesterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySettlementsByRequesterRequest proto.InternalMessageInfo
+
+func (m *QuerySettlementsByRequesterRequest) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
 }
 
-func (m *QueryGetTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySettlementsByRequesterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetTransactionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySettlementsByRequesterRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySettlementsByRequesterResponse defines the QuerySettlementsByRequesterResponse message.
+type QuerySettlementsByRequesterResponse struct {
+	Settlement []Settlement        `protobuf:"bytes,1,rep,name=settlement,proto3" json:"settlement"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Transaction.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySettlementsByRequesterResponse) Reset()         { *m = QuerySettlementsByRequesterResponse{} }
+func (m *QuerySettlementsByRequesterResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementsByRequesterResponse) ProtoMessage()    {}
+func (*QuerySettlementsByRequesterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{23}
+}
+func (m *QuerySettlementsByRequesterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementsByRequesterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementsByRequesterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllTransactionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySettlementsByRequesterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementsByRequesterResponse.Merge(m, src)
 }
-
-func (m *QueryAllTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySettlementsByRequesterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementsByRequesterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementsByRequesterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuerySettlementsByRequesterResponse proto.InternalMessageInfo
+
+func (m *QuerySettlementsByRequesterResponse) GetSettlement() []Settlement {
+	if m != nil {
+		return m.Settlement
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllTransactionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySettlementsByRequesterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySettlementsByStatusRequest defines the QuerySettlementsByStatusRequest message.
+type QuerySettlementsByStatusRequest struct {
+	Status     SettlementStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=scontract.points.v1.SettlementStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProgramId  string             `protobuf:"bytes,3,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 }
 
-func (m *QueryAllTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Transaction) > 0 {
-		for iNdEx := len(m.Transaction) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Transaction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySettlementsByStatusRequest) Reset()         { *m = QuerySettlementsByStatusRequest{} }
+func (m *QuerySettlementsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementsByStatusRequest) ProtoMessage()    {}
+func (*QuerySettlementsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{24}
+}
+func (m *QuerySettlementsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetSettlementRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySettlementsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementsByStatusRequest.Merge(m, src)
+}
+func (m *QuerySettlementsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementsByStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetSettlementRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySettlementsByStatusRequest proto.InternalMessageInfo
+
+func (m *QuerySettlementsByStatusRequest) GetStatus() SettlementStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SettlementStatusUnspecified
 }
 
-func (m *QueryGetSettlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySettlementsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetSettlementResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySettlementsByStatusRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetSettlementResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySettlementsByStatusResponse defines the QuerySettlementsByStatusResponse message.
+type QuerySettlementsByStatusResponse struct {
+	Settlement []Settlement        `protobuf:"bytes,1,rep,name=settlement,proto3" json:"settlement"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetSettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySettlementsByStatusResponse) Reset()         { *m = QuerySettlementsByStatusResponse{} }
+func (m *QuerySettlementsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementsByStatusResponse) ProtoMessage()    {}
+func (*QuerySettlementsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{25}
+}
+func (m *QuerySettlementsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllSettlementRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySettlementsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementsByStatusResponse.Merge(m, src)
 }
-
-func (m *QueryAllSettlementRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySettlementsByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementsByStatusResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllSettlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuerySettlementsByStatusResponse proto.InternalMessageInfo
+
+func (m *QuerySettlementsByStatusResponse) GetSettlement() []Settlement {
+	if m != nil {
+		return m.Settlement
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllSettlementResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySettlementsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllSettlementResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySettlementSummaryRequest defines the QuerySettlementSummaryRequest message.
+type QuerySettlementSummaryRequest struct {
+	// requester, if set, limits the summary to the settlements of one address.
+	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	ProgramId string `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 }
 
-func (m *QueryAllSettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Settlement) > 0 {
-		for iNdEx := len(m.Settlement) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Settlement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySettlementSummaryRequest) Reset()         { *m = QuerySettlementSummaryRequest{} }
+func (m *QuerySettlementSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementSummaryRequest) ProtoMessage()    {}
+func (*QuerySettlementSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{26}
+}
+func (m *QuerySettlementSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QuerySettlementSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementSummaryRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QuerySettlementSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementSummaryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySettlementSummaryRequest proto.InternalMessageInfo
+
+func (m *QuerySettlementSummaryRequest) GetRequester() string {
+	if m != nil {
+		return m.Requester
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryGetPointBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySettlementSummaryRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetPointBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PointBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QuerySettlementSummaryResponse defines the QuerySettlementSummaryResponse message.
+type QuerySettlementSummaryResponse struct {
+	// pending_count is the number of pending settlements.
+	PendingCount uint64 `protobuf:"varint,1,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	// pending_amount is the total amount of points held by pending settlements.
+	PendingAmount uint64 `protobuf:"varint,2,opt,name=pending_amount,json=pendingAmount,proto3" json:"pending_amount,omitempty"`
 }
 
-func (m *QueryAllPointBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySettlementSummaryResponse) Reset()         { *m = QuerySettlementSummaryResponse{} }
+func (m *QuerySettlementSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementSummaryResponse) ProtoMessage()    {}
+func (*QuerySettlementSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{27}
+}
+func (m *QuerySettlementSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySettlementSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementSummaryResponse.Merge(m, src)
+}
+func (m *QuerySettlementSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettlementSummaryResponse proto.InternalMessageInfo
+
+func (m *QuerySettlementSummaryResponse) GetPendingCount() uint64 {
+	if m != nil {
+		return m.PendingCount
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAllPointBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySettlementSummaryResponse) GetPendingAmount() uint64 {
+	if m != nil {
+		return m.PendingAmount
 	}
-	var l int
-	_ = l
-	if len(m.PointBalance) > 0 {
-		for _, e := range m.PointBalance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QueryUpcomingExpirationsRequest defines the QueryUpcomingExpirationsRequest message.
+type QueryUpcomingExpirationsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// until, if set, only matches lots expiring at or before this unix time.
+	Until     int64  `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
+	ProgramId string `protobuf:"bytes,3,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+}
+
+func (m *QueryUpcomingExpirationsRequest) Reset()         { *m = QueryUpcomingExpirationsRequest{} }
+func (m *QueryUpcomingExpirationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingExpirationsRequest) ProtoMessage()    {}
+func (*QueryUpcomingExpirationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{28}
+}
+func (m *QueryUpcomingExpirationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingExpirationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingExpirationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryUpcomingExpirationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingExpirationsRequest.Merge(m, src)
+}
+func (m *QueryUpcomingExpirationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingExpirationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingExpirationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingExpirationsRequest proto.InternalMessageInfo
+
+func (m *QueryUpcomingExpirationsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUpcomingExpirationsRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	return 0
+}
+
+func (m *QueryUpcomingExpirationsRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryUpcomingExpirationsResponse defines the QueryUpcomingExpirationsResponse message.
+type QueryUpcomingExpirationsResponse struct {
+	Lots []PointLot `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots"`
+	// total is the number of points in lots.
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *QueryUpcomingExpirationsResponse) Reset()         { *m = QueryUpcomingExpirationsResponse{} }
+func (m *QueryUpcomingExpirationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingExpirationsResponse) ProtoMessage()    {}
+func (*QueryUpcomingExpirationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{29}
+}
+func (m *QueryUpcomingExpirationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingExpirationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingExpirationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Transaction.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryUpcomingExpirationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingExpirationsResponse.Merge(m, src)
+}
+func (m *QueryUpcomingExpirationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingExpirationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingExpirationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryUpcomingExpirationsResponse proto.InternalMessageInfo
+
+func (m *QueryUpcomingExpirationsResponse) GetLots() []PointLot {
+	if m != nil {
+		return m.Lots
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryUpcomingExpirationsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAllTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryGetProgramRequest defines the QueryGetProgramRequest message.
+type QueryGetProgramRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetProgramRequest) Reset()         { *m = QueryGetProgramRequest{} }
+func (m *QueryGetProgramRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProgramRequest) ProtoMessage()    {}
+func (*QueryGetProgramRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{30}
+}
+func (m *QueryGetProgramRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProgramRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProgramRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Transaction) > 0 {
-		for _, e := range m.Transaction {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryGetProgramRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProgramRequest.Merge(m, src)
+}
+func (m *QueryGetProgramRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProgramRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProgramRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProgramRequest proto.InternalMessageInfo
+
+func (m *QueryGetProgramRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryGetProgramResponse defines the QueryGetProgramResponse message.
+type QueryGetProgramResponse struct {
+	Program PointProgram `protobuf:"bytes,1,opt,name=program,proto3" json:"program"`
+}
+
+func (m *QueryGetProgramResponse) Reset()         { *m = QueryGetProgramResponse{} }
+func (m *QueryGetProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProgramResponse) ProtoMessage()    {}
+func (*QueryGetProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{31}
+}
+func (m *QueryGetProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProgramResponse.Merge(m, src)
+}
+func (m *QueryGetProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProgramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProgramResponse proto.InternalMessageInfo
+
+func (m *QueryGetProgramResponse) GetProgram() PointProgram {
+	if m != nil {
+		return m.Program
+	}
+	return PointProgram{}
+}
+
+// QueryAllProgramRequest defines the QueryAllProgramRequest message.
+type QueryAllProgramRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllProgramRequest) Reset()         { *m = QueryAllProgramRequest{} }
+func (m *QueryAllProgramRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProgramRequest) ProtoMessage()    {}
+func (*QueryAllProgramRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{32}
+}
+func (m *QueryAllProgramRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProgramRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProgramRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllProgramRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProgramRequest.Merge(m, src)
+}
+func (m *QueryAllProgramRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProgramRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProgramRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllProgramRequest proto.InternalMessageInfo
+
+func (m *QueryAllProgramRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllProgramResponse defines the QueryAllProgramResponse message.
+type QueryAllProgramResponse struct {
+	Program    []PointProgram      `protobuf:"bytes,1,rep,name=program,proto3" json:"program"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllProgramResponse) Reset()         { *m = QueryAllProgramResponse{} }
+func (m *QueryAllProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProgramResponse) ProtoMessage()    {}
+func (*QueryAllProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{33}
+}
+func (m *QueryAllProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProgramResponse.Merge(m, src)
+}
+func (m *QueryAllProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProgramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllProgramResponse proto.InternalMessageInfo
+
+func (m *QueryAllProgramResponse) GetProgram() []PointProgram {
+	if m != nil {
+		return m.Program
+	}
+	return nil
+}
+
+func (m *QueryAllProgramResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetMerchantRequest defines the QueryGetMerchantRequest message.
+type QueryGetMerchantRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetMerchantRequest) Reset()         { *m = QueryGetMerchantRequest{} }
+func (m *QueryGetMerchantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMerchantRequest) ProtoMessage()    {}
+func (*QueryGetMerchantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{34}
+}
+func (m *QueryGetMerchantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMerchantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMerchantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMerchantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMerchantRequest.Merge(m, src)
+}
+func (m *QueryGetMerchantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMerchantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMerchantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMerchantRequest proto.InternalMessageInfo
+
+func (m *QueryGetMerchantRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetMerchantResponse defines the QueryGetMerchantResponse message.
+type QueryGetMerchantResponse struct {
+	Merchant Merchant `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant"`
+}
+
+func (m *QueryGetMerchantResponse) Reset()         { *m = QueryGetMerchantResponse{} }
+func (m *QueryGetMerchantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMerchantResponse) ProtoMessage()    {}
+func (*QueryGetMerchantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{35}
+}
+func (m *QueryGetMerchantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMerchantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMerchantResponse.Merge(m, src)
+}
+func (m *QueryGetMerchantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMerchantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMerchantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMerchantResponse proto.InternalMessageInfo
+
+func (m *QueryGetMerchantResponse) GetMerchant() Merchant {
+	if m != nil {
+		return m.Merchant
+	}
+	return Merchant{}
+}
+
+// QueryAllMerchantRequest defines the QueryAllMerchantRequest message.
+type QueryAllMerchantRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMerchantRequest) Reset()         { *m = QueryAllMerchantRequest{} }
+func (m *QueryAllMerchantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMerchantRequest) ProtoMessage()    {}
+func (*QueryAllMerchantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{36}
+}
+func (m *QueryAllMerchantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMerchantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMerchantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMerchantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMerchantRequest.Merge(m, src)
+}
+func (m *QueryAllMerchantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMerchantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMerchantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMerchantRequest proto.InternalMessageInfo
+
+func (m *QueryAllMerchantRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllMerchantResponse defines the QueryAllMerchantResponse message.
+type QueryAllMerchantResponse struct {
+	Merchant   []Merchant          `protobuf:"bytes,1,rep,name=merchant,proto3" json:"merchant"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMerchantResponse) Reset()         { *m = QueryAllMerchantResponse{} }
+func (m *QueryAllMerchantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMerchantResponse) ProtoMessage()    {}
+func (*QueryAllMerchantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{37}
+}
+func (m *QueryAllMerchantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMerchantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMerchantResponse.Merge(m, src)
+}
+func (m *QueryAllMerchantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMerchantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMerchantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMerchantResponse proto.InternalMessageInfo
+
+func (m *QueryAllMerchantResponse) GetMerchant() []Merchant {
+	if m != nil {
+		return m.Merchant
+	}
+	return nil
+}
+
+func (m *QueryAllMerchantResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySupplyRequest defines the QuerySupplyRequest message.
+type QuerySupplyRequest struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+}
+
+func (m *QuerySupplyRequest) Reset()         { *m = QuerySupplyRequest{} }
+func (m *QuerySupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyRequest) ProtoMessage()    {}
+func (*QuerySupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{38}
+}
+func (m *QuerySupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyRequest.Merge(m, src)
+}
+func (m *QuerySupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+// QuerySupplyResponse defines the QuerySupplyResponse message.
+type QuerySupplyResponse struct {
+	Supply PointSupply `protobuf:"bytes,1,opt,name=supply,proto3" json:"supply"`
+	// circulating is the amount of points held in balances:
+	// issued - settled - expired.
+	Circulating uint64 `protobuf:"varint,2,opt,name=circulating,proto3" json:"circulating,omitempty"`
+}
+
+func (m *QuerySupplyResponse) Reset()         { *m = QuerySupplyResponse{} }
+func (m *QuerySupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyResponse) ProtoMessage()    {}
+func (*QuerySupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{39}
+}
+func (m *QuerySupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyResponse.Merge(m, src)
+}
+func (m *QuerySupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyResponse) GetSupply() PointSupply {
+	if m != nil {
+		return m.Supply
+	}
+	return PointSupply{}
+}
+
+func (m *QuerySupplyResponse) GetCirculating() uint64 {
+	if m != nil {
+		return m.Circulating
+	}
+	return 0
+}
+
+// QueryVelocityHeadroomRequest defines the QueryVelocityHeadroomRequest message.
+type QueryVelocityHeadroomRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// recipient, when set, adds the headroom of address issuing to recipient.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *QueryVelocityHeadroomRequest) Reset()         { *m = QueryVelocityHeadroomRequest{} }
+func (m *QueryVelocityHeadroomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVelocityHeadroomRequest) ProtoMessage()    {}
+func (*QueryVelocityHeadroomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{40}
+}
+func (m *QueryVelocityHeadroomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVelocityHeadroomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVelocityHeadroomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVelocityHeadroomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVelocityHeadroomRequest.Merge(m, src)
+}
+func (m *QueryVelocityHeadroomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVelocityHeadroomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVelocityHeadroomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVelocityHeadroomRequest proto.InternalMessageInfo
+
+func (m *QueryVelocityHeadroomRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryVelocityHeadroomRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// VelocityHeadroom is the state of one rolling window.
+type VelocityHeadroom struct {
+	// limit is the most that may be moved in the window, zero when unlimited.
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// used is the amount moved in the window.
+	Used uint64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// remaining is the amount that may still be moved in the window.
+	// It is meaningless when unlimited is set.
+	Remaining uint64 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// unlimited is true when the window has no limit.
+	Unlimited bool `protobuf:"varint,4,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+}
+
+func (m *VelocityHeadroom) Reset()         { *m = VelocityHeadroom{} }
+func (m *VelocityHeadroom) String() string { return proto.CompactTextString(m) }
+func (*VelocityHeadroom) ProtoMessage()    {}
+func (*VelocityHeadroom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{41}
+}
+func (m *VelocityHeadroom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VelocityHeadroom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VelocityHeadroom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VelocityHeadroom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VelocityHeadroom.Merge(m, src)
+}
+func (m *VelocityHeadroom) XXX_Size() int {
+	return m.Size()
+}
+func (m *VelocityHeadroom) XXX_DiscardUnknown() {
+	xxx_messageInfo_VelocityHeadroom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VelocityHeadroom proto.InternalMessageInfo
+
+func (m *VelocityHeadroom) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *VelocityHeadroom) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func (m *VelocityHeadroom) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func (m *VelocityHeadroom) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
+	}
+	return false
+}
+
+// QueryVelocityHeadroomResponse defines the QueryVelocityHeadroomResponse message.
+type QueryVelocityHeadroomResponse struct {
+	// limits are the limits that apply to the address.
+	Limits VelocityLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits"`
+	// overridden is true when the limits come from a velocity override.
+	Overridden     bool             `protobuf:"varint,2,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	DailySpend     VelocityHeadroom `protobuf:"bytes,3,opt,name=daily_spend,json=dailySpend,proto3" json:"daily_spend"`
+	WeeklySpend    VelocityHeadroom `protobuf:"bytes,4,opt,name=weekly_spend,json=weeklySpend,proto3" json:"weekly_spend"`
+	DailyTransfer  VelocityHeadroom `protobuf:"bytes,5,opt,name=daily_transfer,json=dailyTransfer,proto3" json:"daily_transfer"`
+	WeeklyTransfer VelocityHeadroom `protobuf:"bytes,6,opt,name=weekly_transfer,json=weeklyTransfer,proto3" json:"weekly_transfer"`
+	// daily_issue is only set when the request has a recipient.
+	DailyIssue *VelocityHeadroom `protobuf:"bytes,7,opt,name=daily_issue,json=dailyIssue,proto3" json:"daily_issue,omitempty"`
+}
+
+func (m *QueryVelocityHeadroomResponse) Reset()         { *m = QueryVelocityHeadroomResponse{} }
+func (m *QueryVelocityHeadroomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVelocityHeadroomResponse) ProtoMessage()    {}
+func (*QueryVelocityHeadroomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{42}
+}
+func (m *QueryVelocityHeadroomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVelocityHeadroomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVelocityHeadroomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVelocityHeadroomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVelocityHeadroomResponse.Merge(m, src)
+}
+func (m *QueryVelocityHeadroomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVelocityHeadroomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVelocityHeadroomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVelocityHeadroomResponse proto.InternalMessageInfo
+
+func (m *QueryVelocityHeadroomResponse) GetLimits() VelocityLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return VelocityLimits{}
+}
+
+func (m *QueryVelocityHeadroomResponse) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
+	}
+	return false
+}
+
+func (m *QueryVelocityHeadroomResponse) GetDailySpend() VelocityHeadroom {
+	if m != nil {
+		return m.DailySpend
+	}
+	return VelocityHeadroom{}
+}
+
+func (m *QueryVelocityHeadroomResponse) GetWeeklySpend() VelocityHeadroom {
+	if m != nil {
+		return m.WeeklySpend
+	}
+	return VelocityHeadroom{}
+}
+
+func (m *QueryVelocityHeadroomResponse) GetDailyTransfer() VelocityHeadroom {
+	if m != nil {
+		return m.DailyTransfer
+	}
+	return VelocityHeadroom{}
+}
+
+func (m *QueryVelocityHeadroomResponse) GetWeeklyTransfer() VelocityHeadroom {
+	if m != nil {
+		return m.WeeklyTransfer
+	}
+	return VelocityHeadroom{}
+}
+
+func (m *QueryVelocityHeadroomResponse) GetDailyIssue() *VelocityHeadroom {
+	if m != nil {
+		return m.DailyIssue
+	}
+	return nil
+}
+
+// QueryGetFrozenAccountRequest defines the QueryGetFrozenAccountRequest message.
+type QueryGetFrozenAccountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetFrozenAccountRequest) Reset()         { *m = QueryGetFrozenAccountRequest{} }
+func (m *QueryGetFrozenAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFrozenAccountRequest) ProtoMessage()    {}
+func (*QueryGetFrozenAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{43}
+}
+func (m *QueryGetFrozenAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFrozenAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFrozenAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFrozenAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFrozenAccountRequest.Merge(m, src)
+}
+func (m *QueryGetFrozenAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFrozenAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFrozenAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFrozenAccountRequest proto.InternalMessageInfo
+
+func (m *QueryGetFrozenAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryGetFrozenAccountResponse defines the QueryGetFrozenAccountResponse message.
+type QueryGetFrozenAccountResponse struct {
+	FrozenAccount FrozenAccount `protobuf:"bytes,1,opt,name=frozen_account,json=frozenAccount,proto3" json:"frozen_account"`
+}
+
+func (m *QueryGetFrozenAccountResponse) Reset()         { *m = QueryGetFrozenAccountResponse{} }
+func (m *QueryGetFrozenAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFrozenAccountResponse) ProtoMessage()    {}
+func (*QueryGetFrozenAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{44}
+}
+func (m *QueryGetFrozenAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFrozenAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFrozenAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFrozenAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFrozenAccountResponse.Merge(m, src)
+}
+func (m *QueryGetFrozenAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFrozenAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFrozenAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFrozenAccountResponse proto.InternalMessageInfo
+
+func (m *QueryGetFrozenAccountResponse) GetFrozenAccount() FrozenAccount {
+	if m != nil {
+		return m.FrozenAccount
+	}
+	return FrozenAccount{}
+}
+
+// QueryAllFrozenAccountRequest defines the QueryAllFrozenAccountRequest message.
+type QueryAllFrozenAccountRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllFrozenAccountRequest) Reset()         { *m = QueryAllFrozenAccountRequest{} }
+func (m *QueryAllFrozenAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFrozenAccountRequest) ProtoMessage()    {}
+func (*QueryAllFrozenAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{45}
+}
+func (m *QueryAllFrozenAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFrozenAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFrozenAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllFrozenAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFrozenAccountRequest.Merge(m, src)
+}
+func (m *QueryAllFrozenAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFrozenAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFrozenAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllFrozenAccountRequest proto.InternalMessageInfo
+
+func (m *QueryAllFrozenAccountRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllFrozenAccountResponse defines the QueryAllFrozenAccountResponse message.
+type QueryAllFrozenAccountResponse struct {
+	FrozenAccount []FrozenAccount     `protobuf:"bytes,1,rep,name=frozen_account,json=frozenAccount,proto3" json:"frozen_account"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllFrozenAccountResponse) Reset()         { *m = QueryAllFrozenAccountResponse{} }
+func (m *QueryAllFrozenAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFrozenAccountResponse) ProtoMessage()    {}
+func (*QueryAllFrozenAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{46}
+}
+func (m *QueryAllFrozenAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFrozenAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFrozenAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllFrozenAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFrozenAccountResponse.Merge(m, src)
+}
+func (m *QueryAllFrozenAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFrozenAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFrozenAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllFrozenAccountResponse proto.InternalMessageInfo
+
+func (m *QueryAllFrozenAccountResponse) GetFrozenAccount() []FrozenAccount {
+	if m != nil {
+		return m.FrozenAccount
+	}
+	return nil
+}
+
+func (m *QueryAllFrozenAccountResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetPointGrantRequest defines the QueryGetPointGrantRequest message.
+type QueryGetPointGrantRequest struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetPointGrantRequest) Reset()         { *m = QueryGetPointGrantRequest{} }
+func (m *QueryGetPointGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPointGrantRequest) ProtoMessage()    {}
+func (*QueryGetPointGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{47}
+}
+func (m *QueryGetPointGrantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPointGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPointGrantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPointGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPointGrantRequest.Merge(m, src)
+}
+func (m *QueryGetPointGrantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPointGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPointGrantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPointGrantRequest proto.InternalMessageInfo
+
+func (m *QueryGetPointGrantRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *QueryGetPointGrantRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetPointGrantResponse defines the QueryGetPointGrantResponse message.
+type QueryGetPointGrantResponse struct {
+	PointGrant PointGrant `protobuf:"bytes,1,opt,name=point_grant,json=pointGrant,proto3" json:"point_grant"`
+}
+
+func (m *QueryGetPointGrantResponse) Reset()         { *m = QueryGetPointGrantResponse{} }
+func (m *QueryGetPointGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPointGrantResponse) ProtoMessage()    {}
+func (*QueryGetPointGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{48}
+}
+func (m *QueryGetPointGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPointGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPointGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPointGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPointGrantResponse.Merge(m, src)
+}
+func (m *QueryGetPointGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPointGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPointGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPointGrantResponse proto.InternalMessageInfo
+
+func (m *QueryGetPointGrantResponse) GetPointGrant() PointGrant {
+	if m != nil {
+		return m.PointGrant
+	}
+	return PointGrant{}
+}
+
+// QueryActiveGrantsRequest defines the QueryActiveGrantsRequest message.
+type QueryActiveGrantsRequest struct {
+	ProgramId   string             `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Beneficiary string             `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActiveGrantsRequest) Reset()         { *m = QueryActiveGrantsRequest{} }
+func (m *QueryActiveGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveGrantsRequest) ProtoMessage()    {}
+func (*QueryActiveGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{49}
+}
+func (m *QueryActiveGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveGrantsRequest.Merge(m, src)
+}
+func (m *QueryActiveGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryActiveGrantsRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *QueryActiveGrantsRequest) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *QueryActiveGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryActiveGrantsResponse defines the QueryActiveGrantsResponse message.
+type QueryActiveGrantsResponse struct {
+	PointGrant []PointGrant        `protobuf:"bytes,1,rep,name=point_grant,json=pointGrant,proto3" json:"point_grant"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActiveGrantsResponse) Reset()         { *m = QueryActiveGrantsResponse{} }
+func (m *QueryActiveGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveGrantsResponse) ProtoMessage()    {}
+func (*QueryActiveGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{50}
+}
+func (m *QueryActiveGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveGrantsResponse.Merge(m, src)
+}
+func (m *QueryActiveGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryActiveGrantsResponse) GetPointGrant() []PointGrant {
+	if m != nil {
+		return m.PointGrant
+	}
+	return nil
+}
+
+func (m *QueryActiveGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetSettlementBatchRequest defines the QueryGetSettlementBatchRequest message.
+type QueryGetSettlementBatchRequest struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetSettlementBatchRequest) Reset()         { *m = QueryGetSettlementBatchRequest{} }
+func (m *QueryGetSettlementBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSettlementBatchRequest) ProtoMessage()    {}
+func (*QueryGetSettlementBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{51}
+}
+func (m *QueryGetSettlementBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSettlementBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSettlementBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetSettlementBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSettlementBatchRequest.Merge(m, src)
+}
+func (m *QueryGetSettlementBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSettlementBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSettlementBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSettlementBatchRequest proto.InternalMessageInfo
+
+func (m *QueryGetSettlementBatchRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *QueryGetSettlementBatchRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetSettlementBatchResponse defines the QueryGetSettlementBatchResponse message.
+type QueryGetSettlementBatchResponse struct {
+	SettlementBatch SettlementBatch `protobuf:"bytes,1,opt,name=settlement_batch,json=settlementBatch,proto3" json:"settlement_batch"`
+	// settlements are the batched settlements, in the order of settlement_ids.
+	Settlements []Settlement `protobuf:"bytes,2,rep,name=settlements,proto3" json:"settlements"`
+}
+
+func (m *QueryGetSettlementBatchResponse) Reset()         { *m = QueryGetSettlementBatchResponse{} }
+func (m *QueryGetSettlementBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSettlementBatchResponse) ProtoMessage()    {}
+func (*QueryGetSettlementBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{52}
+}
+func (m *QueryGetSettlementBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSettlementBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSettlementBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetSettlementBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSettlementBatchResponse.Merge(m, src)
+}
+func (m *QueryGetSettlementBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSettlementBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSettlementBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSettlementBatchResponse proto.InternalMessageInfo
+
+func (m *QueryGetSettlementBatchResponse) GetSettlementBatch() SettlementBatch {
+	if m != nil {
+		return m.SettlementBatch
+	}
+	return SettlementBatch{}
+}
+
+func (m *QueryGetSettlementBatchResponse) GetSettlements() []Settlement {
+	if m != nil {
+		return m.Settlements
+	}
+	return nil
+}
+
+// QuerySettlementBatchesByStatusRequest defines the QuerySettlementBatchesByStatusRequest message.
+type QuerySettlementBatchesByStatusRequest struct {
+	ProgramId  string                `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Status     SettlementBatchStatus `protobuf:"varint,2,opt,name=status,proto3,enum=scontract.points.v1.SettlementBatchStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySettlementBatchesByStatusRequest) Reset()         { *m = QuerySettlementBatchesByStatusRequest{} }
+func (m *QuerySettlementBatchesByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementBatchesByStatusRequest) ProtoMessage()    {}
+func (*QuerySettlementBatchesByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{53}
+}
+func (m *QuerySettlementBatchesByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementBatchesByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementBatchesByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettlementBatchesByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementBatchesByStatusRequest.Merge(m, src)
+}
+func (m *QuerySettlementBatchesByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementBatchesByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementBatchesByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettlementBatchesByStatusRequest proto.InternalMessageInfo
+
+func (m *QuerySettlementBatchesByStatusRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *QuerySettlementBatchesByStatusRequest) GetStatus() SettlementBatchStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SettlementBatchStatusUnspecified
+}
+
+func (m *QuerySettlementBatchesByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySettlementBatchesByStatusResponse defines the QuerySettlementBatchesByStatusResponse message.
+type QuerySettlementBatchesByStatusResponse struct {
+	SettlementBatch []SettlementBatch   `protobuf:"bytes,1,rep,name=settlement_batch,json=settlementBatch,proto3" json:"settlement_batch"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySettlementBatchesByStatusResponse) Reset() {
+	*m = QuerySettlementBatchesByStatusResponse{}
+}
+func (m *QuerySettlementBatchesByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementBatchesByStatusResponse) ProtoMessage()    {}
+func (*QuerySettlementBatchesByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{54}
+}
+func (m *QuerySettlementBatchesByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementBatchesByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementBatchesByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettlementBatchesByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementBatchesByStatusResponse.Merge(m, src)
+}
+func (m *QuerySettlementBatchesByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementBatchesByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementBatchesByStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettlementBatchesByStatusResponse proto.InternalMessageInfo
+
+func (m *QuerySettlementBatchesByStatusResponse) GetSettlementBatch() []SettlementBatch {
+	if m != nil {
+		return m.SettlementBatch
+	}
+	return nil
+}
+
+func (m *QuerySettlementBatchesByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTreasuryBalanceRequest defines the QueryTreasuryBalanceRequest message.
+type QueryTreasuryBalanceRequest struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+}
+
+func (m *QueryTreasuryBalanceRequest) Reset()         { *m = QueryTreasuryBalanceRequest{} }
+func (m *QueryTreasuryBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryBalanceRequest) ProtoMessage()    {}
+func (*QueryTreasuryBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{55}
+}
+func (m *QueryTreasuryBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryBalanceRequest.Merge(m, src)
+}
+func (m *QueryTreasuryBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryBalanceRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+// QueryTreasuryBalanceResponse defines the QueryTreasuryBalanceResponse message.
+type QueryTreasuryBalanceResponse struct {
+	// address is the address of the points treasury module account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance uint64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *QueryTreasuryBalanceResponse) Reset()         { *m = QueryTreasuryBalanceResponse{} }
+func (m *QueryTreasuryBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryBalanceResponse) ProtoMessage()    {}
+func (*QueryTreasuryBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{56}
+}
+func (m *QueryTreasuryBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryBalanceResponse.Merge(m, src)
+}
+func (m *QueryTreasuryBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryBalanceResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryTreasuryBalanceResponse) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+// QueryGetBalanceSnapshotRequest defines the QueryGetBalanceSnapshotRequest message.
+type QueryGetBalanceSnapshotRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetBalanceSnapshotRequest) Reset()         { *m = QueryGetBalanceSnapshotRequest{} }
+func (m *QueryGetBalanceSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBalanceSnapshotRequest) ProtoMessage()    {}
+func (*QueryGetBalanceSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{57}
+}
+func (m *QueryGetBalanceSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBalanceSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBalanceSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBalanceSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBalanceSnapshotRequest.Merge(m, src)
+}
+func (m *QueryGetBalanceSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBalanceSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBalanceSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBalanceSnapshotRequest proto.InternalMessageInfo
+
+func (m *QueryGetBalanceSnapshotRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetBalanceSnapshotResponse defines the QueryGetBalanceSnapshotResponse message.
+type QueryGetBalanceSnapshotResponse struct {
+	BalanceSnapshot BalanceSnapshot `protobuf:"bytes,1,opt,name=balance_snapshot,json=balanceSnapshot,proto3" json:"balance_snapshot"`
+}
+
+func (m *QueryGetBalanceSnapshotResponse) Reset()         { *m = QueryGetBalanceSnapshotResponse{} }
+func (m *QueryGetBalanceSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBalanceSnapshotResponse) ProtoMessage()    {}
+func (*QueryGetBalanceSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{58}
+}
+func (m *QueryGetBalanceSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBalanceSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBalanceSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBalanceSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBalanceSnapshotResponse.Merge(m, src)
+}
+func (m *QueryGetBalanceSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBalanceSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBalanceSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBalanceSnapshotResponse proto.InternalMessageInfo
+
+func (m *QueryGetBalanceSnapshotResponse) GetBalanceSnapshot() BalanceSnapshot {
+	if m != nil {
+		return m.BalanceSnapshot
+	}
+	return BalanceSnapshot{}
+}
+
+// QueryAllBalanceSnapshotRequest defines the QueryAllBalanceSnapshotRequest message.
+type QueryAllBalanceSnapshotRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBalanceSnapshotRequest) Reset()         { *m = QueryAllBalanceSnapshotRequest{} }
+func (m *QueryAllBalanceSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBalanceSnapshotRequest) ProtoMessage()    {}
+func (*QueryAllBalanceSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{59}
+}
+func (m *QueryAllBalanceSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBalanceSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBalanceSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBalanceSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBalanceSnapshotRequest.Merge(m, src)
+}
+func (m *QueryAllBalanceSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBalanceSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBalanceSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBalanceSnapshotRequest proto.InternalMessageInfo
+
+func (m *QueryAllBalanceSnapshotRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllBalanceSnapshotResponse defines the QueryAllBalanceSnapshotResponse message.
+type QueryAllBalanceSnapshotResponse struct {
+	BalanceSnapshot []BalanceSnapshot   `protobuf:"bytes,1,rep,name=balance_snapshot,json=balanceSnapshot,proto3" json:"balance_snapshot"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBalanceSnapshotResponse) Reset()         { *m = QueryAllBalanceSnapshotResponse{} }
+func (m *QueryAllBalanceSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBalanceSnapshotResponse) ProtoMessage()    {}
+func (*QueryAllBalanceSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{60}
+}
+func (m *QueryAllBalanceSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBalanceSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBalanceSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBalanceSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBalanceSnapshotResponse.Merge(m, src)
+}
+func (m *QueryAllBalanceSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBalanceSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBalanceSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBalanceSnapshotResponse proto.InternalMessageInfo
+
+func (m *QueryAllBalanceSnapshotResponse) GetBalanceSnapshot() []BalanceSnapshot {
+	if m != nil {
+		return m.BalanceSnapshot
+	}
+	return nil
+}
+
+func (m *QueryAllBalanceSnapshotResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBalanceAtRequest defines the QueryBalanceAtRequest message. The
+// balance is taken as of the snapshot with id snapshot_id, unless timestamp
+// is set.
+type QueryBalanceAtRequest struct {
+	ProgramId  string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	SnapshotId uint64 `protobuf:"varint,3,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// timestamp, in unix seconds, asks for the balance once every change made
+	// at or before that block time is applied. It takes precedence over
+	// snapshot_id.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *QueryBalanceAtRequest) Reset()         { *m = QueryBalanceAtRequest{} }
+func (m *QueryBalanceAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceAtRequest) ProtoMessage()    {}
+func (*QueryBalanceAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{61}
+}
+func (m *QueryBalanceAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalanceAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceAtRequest.Merge(m, src)
+}
+func (m *QueryBalanceAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalanceAtRequest proto.InternalMessageInfo
+
+func (m *QueryBalanceAtRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *QueryBalanceAtRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryBalanceAtRequest) GetSnapshotId() uint64 {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return 0
+}
+
+func (m *QueryBalanceAtRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// QueryBalanceAtResponse defines the QueryBalanceAtResponse message.
+type QueryBalanceAtResponse struct {
+	Balance uint64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// from_snapshot is set when the balance was reconstructed from a
+	// snapshot, whose id is snapshot_id. Otherwise it was replayed from the
+	// start of the transaction log.
+	FromSnapshot bool   `protobuf:"varint,2,opt,name=from_snapshot,json=fromSnapshot,proto3" json:"from_snapshot,omitempty"`
+	SnapshotId   uint64 `protobuf:"varint,3,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+}
+
+func (m *QueryBalanceAtResponse) Reset()         { *m = QueryBalanceAtResponse{} }
+func (m *QueryBalanceAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceAtResponse) ProtoMessage()    {}
+func (*QueryBalanceAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{62}
+}
+func (m *QueryBalanceAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalanceAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceAtResponse.Merge(m, src)
+}
+func (m *QueryBalanceAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalanceAtResponse proto.InternalMessageInfo
+
+func (m *QueryBalanceAtResponse) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *QueryBalanceAtResponse) GetFromSnapshot() bool {
+	if m != nil {
+		return m.FromSnapshot
+	}
+	return false
+}
+
+func (m *QueryBalanceAtResponse) GetSnapshotId() uint64 {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return 0
+}
+
+// QueryStatementRequest defines the QueryStatementRequest message.
+type QueryStatementRequest struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// from is the unix time the statement starts at, inclusive.
+	From int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	// to, if set, is the unix time the statement ends at, exclusive.
+	To int64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	// pagination pages through the lines, oldest first. Reverse is not
+	// supported.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStatementRequest) Reset()         { *m = QueryStatementRequest{} }
+func (m *QueryStatementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStatementRequest) ProtoMessage()    {}
+func (*QueryStatementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{63}
+}
+func (m *QueryStatementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatementRequest.Merge(m, src)
+}
+func (m *QueryStatementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatementRequest proto.InternalMessageInfo
+
+func (m *QueryStatementRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *QueryStatementRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryStatementRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *QueryStatementRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *QueryStatementRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStatementResponse defines the QueryStatementResponse message.
+type QueryStatementResponse struct {
+	// opening_balance is the balance before from.
+	OpeningBalance uint64          `protobuf:"varint,1,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	Lines          []StatementLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines"`
+	// totals sums the transactions of the whole range by type, in type order.
+	Totals []StatementTotal `protobuf:"bytes,3,rep,name=totals,proto3" json:"totals"`
+	// settlement_requested and settlement_refunded are the points settlement
+	// requests took out of the balance, and rejected or cancelled settlements
+	// put back, in the range. Settlements are not transactions.
+	SettlementRequested uint64 `protobuf:"varint,4,opt,name=settlement_requested,json=settlementRequested,proto3" json:"settlement_requested,omitempty"`
+	SettlementRefunded  uint64 `protobuf:"varint,5,opt,name=settlement_refunded,json=settlementRefunded,proto3" json:"settlement_refunded,omitempty"`
+	// closing_balance is the balance at the end of the range.
+	ClosingBalance uint64              `protobuf:"varint,6,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	Pagination     *query.PageResponse `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStatementResponse) Reset()         { *m = QueryStatementResponse{} }
+func (m *QueryStatementResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatementResponse) ProtoMessage()    {}
+func (*QueryStatementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{64}
+}
+func (m *QueryStatementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatementResponse.Merge(m, src)
+}
+func (m *QueryStatementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatementResponse proto.InternalMessageInfo
+
+func (m *QueryStatementResponse) GetOpeningBalance() uint64 {
+	if m != nil {
+		return m.OpeningBalance
+	}
+	return 0
+}
+
+func (m *QueryStatementResponse) GetLines() []StatementLine {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func (m *QueryStatementResponse) GetTotals() []StatementTotal {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
+func (m *QueryStatementResponse) GetSettlementRequested() uint64 {
+	if m != nil {
+		return m.SettlementRequested
+	}
+	return 0
+}
+
+func (m *QueryStatementResponse) GetSettlementRefunded() uint64 {
+	if m != nil {
+		return m.SettlementRefunded
+	}
+	return 0
+}
+
+func (m *QueryStatementResponse) GetClosingBalance() uint64 {
+	if m != nil {
+		return m.ClosingBalance
+	}
+	return 0
+}
+
+func (m *QueryStatementResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// StatementLine is a transaction of a statement.
+type StatementLine struct {
+	Transaction Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction"`
+	// credit is the amount the account received and debit the amount it sent,
+	// transfer fee included.
+	Credit uint64 `protobuf:"varint,2,opt,name=credit,proto3" json:"credit,omitempty"`
+	Debit  uint64 `protobuf:"varint,3,opt,name=debit,proto3" json:"debit,omitempty"`
+	// balance is the running balance after the transaction. Settlements made
+	// in the block of a transaction are counted after it when requested and
+	// before it when refunded.
+	Balance uint64 `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *StatementLine) Reset()         { *m = StatementLine{} }
+func (m *StatementLine) String() string { return proto.CompactTextString(m) }
+func (*StatementLine) ProtoMessage()    {}
+func (*StatementLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{65}
+}
+func (m *StatementLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatementLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatementLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatementLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatementLine.Merge(m, src)
+}
+func (m *StatementLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatementLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatementLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatementLine proto.InternalMessageInfo
+
+func (m *StatementLine) GetTransaction() Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return Transaction{}
+}
+
+func (m *StatementLine) GetCredit() uint64 {
+	if m != nil {
+		return m.Credit
+	}
+	return 0
+}
+
+func (m *StatementLine) GetDebit() uint64 {
+	if m != nil {
+		return m.Debit
+	}
+	return 0
+}
+
+func (m *StatementLine) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+// StatementTotal sums the transactions of a type in a statement.
+type StatementTotal struct {
+	TxType TransactionType `protobuf:"varint,1,opt,name=tx_type,json=txType,proto3,enum=scontract.points.v1.TransactionType" json:"tx_type,omitempty"`
+	Count  uint64          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Credit uint64          `protobuf:"varint,3,opt,name=credit,proto3" json:"credit,omitempty"`
+	Debit  uint64          `protobuf:"varint,4,opt,name=debit,proto3" json:"debit,omitempty"`
+}
+
+func (m *StatementTotal) Reset()         { *m = StatementTotal{} }
+func (m *StatementTotal) String() string { return proto.CompactTextString(m) }
+func (*StatementTotal) ProtoMessage()    {}
+func (*StatementTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdd817e68478f511, []int{66}
+}
+func (m *StatementTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatementTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatementTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatementTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatementTotal.Merge(m, src)
+}
+func (m *StatementTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatementTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatementTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatementTotal proto.InternalMessageInfo
+
+func (m *StatementTotal) GetTxType() TransactionType {
+	if m != nil {
+		return m.TxType
+	}
+	return TransactionTypeUnspecified
+}
+
+func (m *StatementTotal) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *StatementTotal) GetCredit() uint64 {
+	if m != nil {
+		return m.Credit
+	}
+	return 0
+}
+
+func (m *StatementTotal) GetDebit() uint64 {
+	if m != nil {
+		return m.Debit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("scontract.points.v1.TransactionDirection", TransactionDirection_name, TransactionDirection_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "scontract.points.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "scontract.points.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetPointBalanceRequest)(nil), "scontract.points.v1.QueryGetPointBalanceRequest")
+	proto.RegisterType((*QueryGetPointBalanceResponse)(nil), "scontract.points.v1.QueryGetPointBalanceResponse")
+	proto.RegisterType((*QueryAllPointBalanceRequest)(nil), "scontract.points.v1.QueryAllPointBalanceRequest")
+	proto.RegisterType((*QueryAllPointBalanceResponse)(nil), "scontract.points.v1.QueryAllPointBalanceResponse")
+	proto.RegisterType((*QueryGetTransactionRequest)(nil), "scontract.points.v1.QueryGetTransactionRequest")
+	proto.RegisterType((*QueryGetTransactionResponse)(nil), "scontract.points.v1.QueryGetTransactionResponse")
+	proto.RegisterType((*QueryAllTransactionRequest)(nil), "scontract.points.v1.QueryAllTransactionRequest")
+	proto.RegisterType((*QueryAllTransactionResponse)(nil), "scontract.points.v1.QueryAllTransactionResponse")
+	proto.RegisterType((*QueryGetSettlementRequest)(nil), "scontract.points.v1.QueryGetSettlementRequest")
+	proto.RegisterType((*QueryGetSettlementResponse)(nil), "scontract.points.v1.QueryGetSettlementResponse")
+	proto.RegisterType((*QueryAllSettlementRequest)(nil), "scontract.points.v1.QueryAllSettlementRequest")
+	proto.RegisterType((*QueryAllSettlementResponse)(nil), "scontract.points.v1.QueryAllSettlementResponse")
+	proto.RegisterType((*QueryGetIssuerRequest)(nil), "scontract.points.v1.QueryGetIssuerRequest")
+	proto.RegisterType((*QueryGetIssuerResponse)(nil), "scontract.points.v1.QueryGetIssuerResponse")
+	proto.RegisterType((*QueryAllIssuerRequest)(nil), "scontract.points.v1.QueryAllIssuerRequest")
+	proto.RegisterType((*QueryAllIssuerResponse)(nil), "scontract.points.v1.QueryAllIssuerResponse")
+	proto.RegisterType((*QueryIssuerAllowanceRequest)(nil), "scontract.points.v1.QueryIssuerAllowanceRequest")
+	proto.RegisterType((*QueryIssuerAllowanceResponse)(nil), "scontract.points.v1.QueryIssuerAllowanceResponse")
+	proto.RegisterType((*QueryTransactionsByAddressRequest)(nil), "scontract.points.v1.QueryTransactionsByAddressRequest")
+	proto.RegisterType((*QueryTransactionsByAddressResponse)(nil), "scontract.points.v1.QueryTransactionsByAddressResponse")
+	proto.RegisterType((*QuerySettlementsByRequesterRequest)(nil), "scontract.points.v1.QuerySettlementsByRequesterRequest")
+	proto.RegisterType((*QuerySettlementsByRequesterResponse)(nil), "scontract.points.v1.QuerySettlementsByRequesterResponse")
+	proto.RegisterType((*QuerySettlementsByStatusRequest)(nil), "scontract.points.v1.QuerySettlementsByStatusRequest")
+	proto.RegisterType((*QuerySettlementsByStatusResponse)(nil), "scontract.points.v1.QuerySettlementsByStatusResponse")
+	proto.RegisterType((*QuerySettlementSummaryRequest)(nil), "scontract.points.v1.QuerySettlementSummaryRequest")
+	proto.RegisterType((*QuerySettlementSummaryResponse)(nil), "scontract.points.v1.QuerySettlementSummaryResponse")
+	proto.RegisterType((*QueryUpcomingExpirationsRequest)(nil), "scontract.points.v1.QueryUpcomingExpirationsRequest")
+	proto.RegisterType((*QueryUpcomingExpirationsResponse)(nil), "scontract.points.v1.QueryUpcomingExpirationsResponse")
+	proto.RegisterType((*QueryGetProgramRequest)(nil), "scontract.points.v1.QueryGetProgramRequest")
+	proto.RegisterType((*QueryGetProgramResponse)(nil), "scontract.points.v1.QueryGetProgramResponse")
+	proto.RegisterType((*QueryAllProgramRequest)(nil), "scontract.points.v1.QueryAllProgramRequest")
+	proto.RegisterType((*QueryAllProgramResponse)(nil), "scontract.points.v1.QueryAllProgramResponse")
+	proto.RegisterType((*QueryGetMerchantRequest)(nil), "scontract.points.v1.QueryGetMerchantRequest")
+	proto.RegisterType((*QueryGetMerchantResponse)(nil), "scontract.points.v1.QueryGetMerchantResponse")
+	proto.RegisterType((*QueryAllMerchantRequest)(nil), "scontract.points.v1.QueryAllMerchantRequest")
+	proto.RegisterType((*QueryAllMerchantResponse)(nil), "scontract.points.v1.QueryAllMerchantResponse")
+	proto.RegisterType((*QuerySupplyRequest)(nil), "scontract.points.v1.QuerySupplyRequest")
+	proto.RegisterType((*QuerySupplyResponse)(nil), "scontract.points.v1.QuerySupplyResponse")
+	proto.RegisterType((*QueryVelocityHeadroomRequest)(nil), "scontract.points.v1.QueryVelocityHeadroomRequest")
+	proto.RegisterType((*VelocityHeadroom)(nil), "scontract.points.v1.VelocityHeadroom")
+	proto.RegisterType((*QueryVelocityHeadroomResponse)(nil), "scontract.points.v1.QueryVelocityHeadroomResponse")
+	proto.RegisterType((*QueryGetFrozenAccountRequest)(nil), "scontract.points.v1.QueryGetFrozenAccountRequest")
+	proto.RegisterType((*QueryGetFrozenAccountResponse)(nil), "scontract.points.v1.QueryGetFrozenAccountResponse")
+	proto.RegisterType((*QueryAllFrozenAccountRequest)(nil), "scontract.points.v1.QueryAllFrozenAccountRequest")
+	proto.RegisterType((*QueryAllFrozenAccountResponse)(nil), "scontract.points.v1.QueryAllFrozenAccountResponse")
+	proto.RegisterType((*QueryGetPointGrantRequest)(nil), "scontract.points.v1.QueryGetPointGrantRequest")
+	proto.RegisterType((*QueryGetPointGrantResponse)(nil), "scontract.points.v1.QueryGetPointGrantResponse")
+	proto.RegisterType((*QueryActiveGrantsRequest)(nil), "scontract.points.v1.QueryActiveGrantsRequest")
+	proto.RegisterType((*QueryActiveGrantsResponse)(nil), "scontract.points.v1.QueryActiveGrantsResponse")
+	proto.RegisterType((*QueryGetSettlementBatchRequest)(nil), "scontract.points.v1.QueryGetSettlementBatchRequest")
+	proto.RegisterType((*QueryGetSettlementBatchResponse)(nil), "scontract.points.v1.QueryGetSettlementBatchResponse")
+	proto.RegisterType((*QuerySettlementBatchesByStatusRequest)(nil), "scontract.points.v1.QuerySettlementBatchesByStatusRequest")
+	proto.RegisterType((*QuerySettlementBatchesByStatusResponse)(nil), "scontract.points.v1.QuerySettlementBatchesByStatusResponse")
+	proto.RegisterType((*QueryTreasuryBalanceRequest)(nil), "scontract.points.v1.QueryTreasuryBalanceRequest")
+	proto.RegisterType((*QueryTreasuryBalanceResponse)(nil), "scontract.points.v1.QueryTreasuryBalanceResponse")
+	proto.RegisterType((*QueryGetBalanceSnapshotRequest)(nil), "scontract.points.v1.QueryGetBalanceSnapshotRequest")
+	proto.RegisterType((*QueryGetBalanceSnapshotResponse)(nil), "scontract.points.v1.QueryGetBalanceSnapshotResponse")
+	proto.RegisterType((*QueryAllBalanceSnapshotRequest)(nil), "scontract.points.v1.QueryAllBalanceSnapshotRequest")
+	proto.RegisterType((*QueryAllBalanceSnapshotResponse)(nil), "scontract.points.v1.QueryAllBalanceSnapshotResponse")
+	proto.RegisterType((*QueryBalanceAtRequest)(nil), "scontract.points.v1.QueryBalanceAtRequest")
+	proto.RegisterType((*QueryBalanceAtResponse)(nil), "scontract.points.v1.QueryBalanceAtResponse")
+	proto.RegisterType((*QueryStatementRequest)(nil), "scontract.points.v1.QueryStatementRequest")
+	proto.RegisterType((*QueryStatementResponse)(nil), "scontract.points.v1.QueryStatementResponse")
+	proto.RegisterType((*StatementLine)(nil), "scontract.points.v1.StatementLine")
+	proto.RegisterType((*StatementTotal)(nil), "scontract.points.v1.StatementTotal")
+}
+
+func init() { proto.RegisterFile("scontract/points/v1/query.proto", fileDescriptor_fdd817e68478f511) }
+
+var fileDescriptor_fdd817e68478f511 = []byte{
+	// 3336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdf, 0x6f, 0x1c, 0x57,
+	0xf5, 0xcf, 0x78, 0xd7, 0x76, 0x7c, 0x9d, 0xd8, 0xee, 0xb5, 0xbf, 0xf9, 0x3a, 0x9b, 0xc4, 0x76,
+	0xc6, 0x71, 0x93, 0xba, 0x8d, 0x37, 0x76, 0xd2, 0xa6, 0x3f, 0xd2, 0x56, 0xeb, 0xf8, 0x47, 0x9c,
+	0xba, 0x8e, 0xbb, 0x76, 0xfa, 0x00, 0x95, 0x96, 0xf1, 0xcc, 0xb5, 0x3d, 0xea, 0xee, 0xcc, 0x74,
+	0x66, 0xd6, 0xb5, 0x89, 0x22, 0xa1, 0x8a, 0x07, 0xc8, 0x03, 0xa0, 0x56, 0x50, 0x09, 0xd1, 0x07,
+	0x54, 0x10, 0x20, 0x55, 0xb4, 0x4a, 0x41, 0x80, 0x84, 0x84, 0xca, 0x0b, 0x79, 0xac, 0xe8, 0x0b,
+	0x4f, 0x15, 0x6a, 0x11, 0xf0, 0xc0, 0x1f, 0x81, 0xe6, 0xde, 0x33, 0xbf, 0x67, 0xee, 0xcc, 0xae,
+	0x97, 0x2a, 0x2f, 0xc9, 0xee, 0xdd, 0x7b, 0xce, 0xfd, 0x7c, 0xce, 0x3d, 0xf7, 0xdc, 0x1f, 0xe7,
+	0x18, 0x8d, 0x5b, 0xb2, 0xae, 0xd9, 0xa6, 0x24, 0xdb, 0x65, 0x43, 0x57, 0x35, 0xdb, 0x2a, 0xef,
+	0xcd, 0x96, 0xdf, 0x68, 0x12, 0xf3, 0x60, 0xc6, 0x30, 0x75, 0x5b, 0xc7, 0xc3, 0x5e, 0x87, 0x19,
+	0xd6, 0x61, 0x66, 0x6f, 0xb6, 0xf4, 0x88, 0xd4, 0x50, 0x35, 0xbd, 0x4c, 0xff, 0x65, 0xfd, 0x4a,
+	0xd3, 0xb2, 0x6e, 0x35, 0x74, 0xab, 0xbc, 0x25, 0x59, 0x84, 0x29, 0x28, 0xef, 0xcd, 0x6e, 0x11,
+	0x5b, 0x9a, 0x2d, 0x1b, 0xd2, 0x8e, 0xaa, 0x49, 0xb6, 0xaa, 0x6b, 0xd0, 0x77, 0x64, 0x47, 0xdf,
+	0xd1, 0xe9, 0xc7, 0xb2, 0xf3, 0x09, 0x5a, 0x4f, 0xef, 0xe8, 0xfa, 0x4e, 0x9d, 0x94, 0x25, 0x43,
+	0x2d, 0x4b, 0x9a, 0xa6, 0xdb, 0x54, 0xc4, 0x72, 0xf5, 0x27, 0x01, 0xdd, 0x92, 0xea, 0x92, 0x26,
+	0x93, 0x9a, 0xa5, 0x49, 0x86, 0xb5, 0xab, 0xdb, 0xd0, 0xf7, 0x42, 0x52, 0xdf, 0x6d, 0x53, 0xff,
+	0x26, 0xd1, 0x6a, 0x92, 0x2c, 0xeb, 0x4d, 0xcd, 0xed, 0x39, 0x91, 0xd4, 0x53, 0xb5, 0xac, 0x26,
+	0x31, 0xa1, 0x87, 0x98, 0xd4, 0xa3, 0x41, 0x4c, 0x79, 0x57, 0xe2, 0x6b, 0x31, 0x24, 0x53, 0x6a,
+	0xb8, 0xe8, 0xcf, 0x27, 0xf6, 0x70, 0x3e, 0xd5, 0x80, 0x03, 0x74, 0x9c, 0x4a, 0xef, 0xb8, 0x63,
+	0xfa, 0x23, 0x4e, 0xa6, 0x77, 0xab, 0x7b, 0x66, 0xe0, 0x0c, 0x6a, 0x98, 0xfa, 0x8e, 0x29, 0x35,
+	0xa0, 0xe3, 0xa3, 0xe9, 0x1d, 0xad, 0xa6, 0x61, 0xd4, 0xc1, 0x17, 0x4a, 0xe7, 0x92, 0xfa, 0x59,
+	0xc4, 0xb6, 0xeb, 0xa4, 0x41, 0x3c, 0x6c, 0xd3, 0xfc, 0x5e, 0xb5, 0x2d, 0xc9, 0x96, 0x77, 0x79,
+	0x74, 0x6d, 0x53, 0xd2, 0x2c, 0x49, 0x0e, 0x38, 0x4c, 0xe2, 0x24, 0xec, 0x91, 0xba, 0x2e, 0xab,
+	0x36, 0x80, 0x13, 0x47, 0x10, 0x7e, 0xc5, 0x71, 0xbb, 0x75, 0x6a, 0xf7, 0x2a, 0x79, 0xa3, 0x49,
+	0x2c, 0x5b, 0xbc, 0x8d, 0x86, 0x43, 0xad, 0x96, 0xa1, 0x6b, 0x16, 0xc1, 0x2f, 0xa0, 0x1e, 0x36,
+	0x3f, 0xa3, 0xc2, 0x84, 0x70, 0xa1, 0x7f, 0xee, 0xd4, 0x4c, 0x82, 0x9b, 0xcf, 0x30, 0xa1, 0xf9,
+	0xbe, 0x07, 0x9f, 0x8f, 0x1f, 0xf9, 0xe5, 0xbf, 0x3e, 0x9a, 0x16, 0xaa, 0x20, 0x25, 0x56, 0xd1,
+	0x29, 0xaa, 0x76, 0x99, 0xd8, 0xeb, 0x4e, 0xf7, 0x79, 0x36, 0x89, 0x30, 0x2a, 0x1e, 0x41, 0xdd,
+	0xaa, 0xa6, 0x90, 0x7d, 0xaa, 0xbd, 0xaf, 0xca, 0xbe, 0xe0, 0x33, 0x08, 0x81, 0xdd, 0x6b, 0xaa,
+	0x32, 0xda, 0x45, 0x7f, 0xea, 0x83, 0x96, 0x15, 0x45, 0xac, 0xa3, 0xd3, 0xc9, 0x3a, 0x01, 0xf3,
+	0x2a, 0x3a, 0x1e, 0xf2, 0x18, 0x80, 0x7e, 0x36, 0x19, 0x7a, 0x40, 0xc3, 0x7c, 0xd1, 0x21, 0x50,
+	0x3d, 0x66, 0x04, 0xda, 0xc4, 0x6f, 0x0b, 0x40, 0xa1, 0x52, 0xaf, 0x27, 0x51, 0x58, 0x42, 0xc8,
+	0x5f, 0xb7, 0x30, 0xd4, 0xa3, 0x33, 0x6c, 0x91, 0xcf, 0x38, 0x8b, 0x7c, 0x86, 0x45, 0x09, 0x58,
+	0xe4, 0x33, 0xeb, 0xd2, 0x8e, 0x2b, 0x5b, 0x0d, 0x48, 0x66, 0x91, 0xfe, 0xad, 0x00, 0xac, 0x63,
+	0x30, 0xd2, 0x59, 0x17, 0xda, 0x66, 0x8d, 0x97, 0x43, 0xac, 0xba, 0x28, 0xab, 0xf3, 0x99, 0xac,
+	0x18, 0x94, 0x20, 0x2d, 0xf1, 0x25, 0x54, 0x72, 0x27, 0x6b, 0xd3, 0x77, 0x57, 0xd7, 0x78, 0x03,
+	0xa8, 0x4b, 0x55, 0xa8, 0xd1, 0x8a, 0xd5, 0x2e, 0x55, 0xc9, 0x32, 0xc2, 0x8e, 0xef, 0x4d, 0x21,
+	0x65, 0x60, 0x82, 0x1b, 0xa8, 0x3f, 0xb0, 0x24, 0x60, 0x2e, 0x26, 0x12, 0x0d, 0x10, 0x10, 0x07,
+	0xfe, 0x41, 0x51, 0xf1, 0x03, 0x01, 0x60, 0x57, 0xea, 0xf5, 0x04, 0xd8, 0x5f, 0xcd, 0x9c, 0xe3,
+	0xf3, 0x68, 0x50, 0x37, 0x15, 0x62, 0xd6, 0x4c, 0xb2, 0x4d, 0x4c, 0xe2, 0x4c, 0x6a, 0x81, 0xf6,
+	0x19, 0xa0, 0xcd, 0x55, 0xb7, 0x55, 0xfc, 0x28, 0xe0, 0xa3, 0xb9, 0x0c, 0x53, 0x68, 0xd3, 0x30,
+	0x9d, 0xf3, 0x8b, 0x9b, 0xe8, 0xa4, 0x3b, 0x95, 0x1b, 0x5e, 0xc8, 0x6b, 0xd3, 0x2d, 0x64, 0xdf,
+	0xc7, 0x82, 0xba, 0x80, 0xfc, 0x22, 0x42, 0x7e, 0x50, 0x85, 0xc9, 0x1a, 0x4f, 0xe4, 0xee, 0x0b,
+	0x03, 0xf5, 0x80, 0xa0, 0xf8, 0x96, 0x00, 0x88, 0x2b, 0xf5, 0x7a, 0x1c, 0xf1, 0x57, 0x14, 0x05,
+	0x82, 0x7e, 0x99, 0x83, 0x6a, 0xa1, 0x2d, 0xaa, 0x9d, 0x9b, 0xe4, 0x59, 0xf4, 0x7f, 0xee, 0xc4,
+	0xac, 0xd0, 0xb3, 0x82, 0x6b, 0xae, 0x51, 0xd4, 0x2b, 0x29, 0x8a, 0x49, 0x2c, 0x0b, 0x22, 0xbf,
+	0xfb, 0x55, 0xdc, 0x40, 0x27, 0xa2, 0x22, 0x40, 0xee, 0x19, 0xd4, 0xc3, 0x0e, 0x1c, 0xdc, 0xad,
+	0x88, 0x09, 0x01, 0x29, 0x10, 0x10, 0x6b, 0x80, 0xa3, 0x52, 0xaf, 0x87, 0x71, 0x74, 0x68, 0xda,
+	0xc4, 0x9f, 0x08, 0x00, 0x3b, 0x30, 0x42, 0x02, 0xec, 0x42, 0x4b, 0xb0, 0x3b, 0x37, 0x0f, 0x57,
+	0x21, 0x3c, 0xb0, 0x51, 0x2a, 0xf5, 0xba, 0xfe, 0x66, 0x70, 0x0b, 0x4b, 0x9f, 0x8d, 0xfb, 0xee,
+	0xae, 0x13, 0x93, 0x04, 0x76, 0x67, 0xd1, 0x31, 0x62, 0xe8, 0xf2, 0x6e, 0x4d, 0x6b, 0x36, 0xb6,
+	0x60, 0x6a, 0x0a, 0xd5, 0x7e, 0xda, 0xb6, 0x46, 0x9b, 0xf0, 0x29, 0xd4, 0xc7, 0xba, 0xc8, 0x92,
+	0x41, 0x49, 0x14, 0xab, 0x47, 0x69, 0xc3, 0x75, 0xc9, 0xc0, 0x27, 0xc0, 0x3a, 0x0a, 0x8d, 0x6c,
+	0x45, 0xa0, 0xae, 0xe0, 0xd3, 0xa8, 0xcf, 0x24, 0x0d, 0x49, 0xd5, 0x54, 0x6d, 0x67, 0xb4, 0x48,
+	0x7f, 0xf2, 0x1b, 0x9c, 0x5f, 0x9b, 0x5a, 0x5d, 0x6d, 0xa8, 0x36, 0x51, 0x46, 0xbb, 0x27, 0x84,
+	0x0b, 0x47, 0xab, 0x7e, 0x83, 0xf8, 0xcf, 0x2e, 0x74, 0x96, 0x82, 0x0e, 0xc4, 0x32, 0x6b, 0xfe,
+	0xa0, 0xc2, 0x38, 0x65, 0x92, 0xc6, 0xcb, 0xa8, 0x4f, 0x51, 0x4d, 0x22, 0x7b, 0x56, 0x1f, 0x98,
+	0x7b, 0x2c, 0x2b, 0x56, 0x2e, 0xb8, 0x02, 0x55, 0x5f, 0x16, 0x3f, 0x8f, 0x7a, 0xed, 0xfd, 0x9a,
+	0x7d, 0x60, 0xb0, 0xb8, 0x3d, 0x30, 0x77, 0x2e, 0x4b, 0xcd, 0xe6, 0x81, 0x41, 0xaa, 0x3d, 0xf6,
+	0xbe, 0xf3, 0xbf, 0x13, 0x0b, 0x2c, 0x5b, 0x32, 0xed, 0x9a, 0xad, 0x36, 0x08, 0x35, 0x42, 0xa1,
+	0xda, 0x47, 0x5b, 0x36, 0xd5, 0x06, 0xc1, 0x27, 0xd1, 0x51, 0xa2, 0x29, 0xec, 0xc7, 0x6e, 0xfa,
+	0x63, 0x2f, 0xd1, 0x14, 0xfa, 0x53, 0xd8, 0xad, 0x7b, 0x3a, 0x14, 0x8d, 0x7a, 0xa3, 0xd1, 0xe8,
+	0xbb, 0x5d, 0x48, 0xe4, 0x19, 0xfa, 0xa1, 0xdd, 0x7d, 0xf0, 0x3a, 0x42, 0xf2, 0x2e, 0x91, 0x5f,
+	0xa7, 0x23, 0xd3, 0xc9, 0xe9, 0x9f, 0x9b, 0xce, 0x42, 0x74, 0xdd, 0x93, 0x70, 0x63, 0xa6, 0xaf,
+	0x43, 0xfc, 0x95, 0x00, 0xb6, 0xf0, 0x23, 0xab, 0x35, 0x7f, 0x00, 0x46, 0xf5, 0x03, 0x0e, 0xf5,
+	0x6b, 0x68, 0x03, 0xbf, 0xf3, 0x1b, 0x22, 0xf3, 0xd6, 0xd5, 0xa1, 0x79, 0x2b, 0x24, 0x9c, 0x25,
+	0x27, 0xb9, 0x58, 0x1f, 0xd2, 0xed, 0xe4, 0x2f, 0x02, 0x1a, 0x8f, 0xe3, 0xde, 0xb0, 0x25, 0xbb,
+	0xe9, 0x2d, 0xeb, 0xe7, 0x51, 0x8f, 0x45, 0x1b, 0xa8, 0x75, 0x07, 0xe6, 0xa6, 0x32, 0xf0, 0x82,
+	0x34, 0x08, 0x7d, 0x55, 0x33, 0x70, 0x5f, 0x40, 0x13, 0xe9, 0x4c, 0x1e, 0x52, 0xf3, 0xbf, 0x86,
+	0xce, 0x44, 0x30, 0x6f, 0x34, 0x1b, 0x0d, 0xc9, 0x3c, 0xc8, 0xe7, 0xdc, 0x99, 0xb7, 0xba, 0xb1,
+	0x34, 0xed, 0x60, 0x8f, 0x49, 0x74, 0xdc, 0x20, 0x9a, 0xa2, 0x6a, 0x3b, 0x35, 0xfa, 0x34, 0x01,
+	0x07, 0xc4, 0x63, 0xd0, 0x78, 0xdd, 0x69, 0xc3, 0x53, 0x68, 0xc0, 0xed, 0x24, 0x35, 0x68, 0x2f,
+	0xb6, 0xe5, 0xb8, 0xa2, 0x15, 0xda, 0x28, 0x1a, 0xe0, 0x49, 0xb7, 0x0d, 0x59, 0x6f, 0xa8, 0xda,
+	0xce, 0xe2, 0xbe, 0xa1, 0x9a, 0xec, 0x1d, 0x25, 0x7b, 0x83, 0x18, 0x41, 0xdd, 0x4d, 0xcd, 0x56,
+	0xeb, 0x54, 0x75, 0xa1, 0xca, 0xbe, 0x64, 0x4d, 0xf9, 0x1b, 0x30, 0xe3, 0x89, 0x23, 0x02, 0xc3,
+	0xab, 0xa8, 0x58, 0xd7, 0x6d, 0x0b, 0xe6, 0xfa, 0x4c, 0xfa, 0xd5, 0x6d, 0x55, 0x77, 0x67, 0x9a,
+	0x0a, 0x38, 0x88, 0x6c, 0xdd, 0x96, 0xea, 0x40, 0x96, 0x7d, 0x11, 0x2f, 0xf8, 0x67, 0xa9, 0x75,
+	0x86, 0x23, 0x7e, 0xc0, 0xee, 0x73, 0x0e, 0xd8, 0xe2, 0x6b, 0xe8, 0xff, 0x63, 0x3d, 0x01, 0x53,
+	0x05, 0xf5, 0x02, 0x89, 0xec, 0x7b, 0x34, 0xc8, 0x02, 0x34, 0x57, 0x4e, 0xfc, 0x86, 0x7f, 0x38,
+	0x8a, 0xe0, 0xe8, 0xd4, 0xf9, 0xeb, 0xe7, 0x02, 0x10, 0x08, 0x0e, 0x91, 0x44, 0xa0, 0xd0, 0x0e,
+	0x81, 0xce, 0x2d, 0xa1, 0xc7, 0x7c, 0x3b, 0xbf, 0x0c, 0x4f, 0x63, 0x29, 0x77, 0x1e, 0xf1, 0xeb,
+	0x68, 0x34, 0xde, 0x15, 0x28, 0xbd, 0x88, 0x8e, 0xba, 0x2f, 0x6b, 0x60, 0xb4, 0x64, 0x5f, 0x71,
+	0x05, 0x81, 0x8f, 0x27, 0x24, 0x4a, 0xbe, 0xb9, 0xa2, 0x38, 0x3a, 0x35, 0x25, 0x3f, 0x13, 0x80,
+	0x40, 0x68, 0x8c, 0x44, 0x02, 0x85, 0x96, 0x09, 0x74, 0x6e, 0x46, 0x2e, 0xc3, 0x6b, 0xd8, 0x06,
+	0x7d, 0xbf, 0x73, 0x8d, 0x10, 0x5e, 0xcb, 0x42, 0x74, 0x2d, 0xbf, 0x09, 0x8f, 0x65, 0xae, 0x90,
+	0xff, 0x58, 0xc6, 0x9e, 0x01, 0xb9, 0x4f, 0x0f, 0xd4, 0xd1, 0x98, 0xa4, 0x7b, 0xde, 0x67, 0x52,
+	0x78, 0x02, 0xf5, 0xcb, 0xaa, 0x29, 0x37, 0xeb, 0x92, 0xed, 0x1c, 0x7b, 0xd9, 0x5a, 0x0e, 0x36,
+	0x89, 0xaf, 0xc2, 0x71, 0xfc, 0x55, 0x78, 0xd2, 0xbb, 0x41, 0x24, 0xc5, 0xd4, 0xf5, 0x46, 0x76,
+	0xcc, 0xa2, 0xb1, 0x59, 0x56, 0x0d, 0x95, 0x40, 0x48, 0xa4, 0xb1, 0x19, 0x1a, 0xc4, 0x7d, 0x34,
+	0x14, 0x55, 0xe9, 0xc4, 0x14, 0x7a, 0xa2, 0x06, 0x9f, 0x64, 0x5f, 0x30, 0x46, 0xc5, 0xa6, 0x45,
+	0x14, 0x00, 0x47, 0x3f, 0x87, 0x0f, 0xeb, 0x05, 0xee, 0x61, 0xbd, 0x18, 0x3d, 0xac, 0xbf, 0x5b,
+	0x84, 0x5d, 0x25, 0x4e, 0x09, 0xac, 0xba, 0x84, 0x7a, 0x68, 0x67, 0xf7, 0x09, 0x72, 0x32, 0xd1,
+	0xaa, 0xae, 0xf8, 0x2a, 0xed, 0x1a, 0x7a, 0x8a, 0x64, 0xd2, 0x78, 0x0c, 0x21, 0x7d, 0x8f, 0x98,
+	0xa6, 0xaa, 0x28, 0x84, 0xb9, 0xcc, 0xd1, 0x6a, 0xa0, 0x05, 0xaf, 0xa2, 0x7e, 0x45, 0x52, 0xeb,
+	0x07, 0x35, 0xcb, 0xd9, 0x2a, 0xe0, 0x50, 0x38, 0xc5, 0x1d, 0xcc, 0xc5, 0xea, 0xee, 0xba, 0x54,
+	0x7e, 0xc3, 0x11, 0xc7, 0x6b, 0xe8, 0xd8, 0x9b, 0x84, 0xbc, 0xee, 0xa9, 0x2b, 0xb6, 0xae, 0xae,
+	0x9f, 0x29, 0x60, 0xfa, 0xaa, 0x68, 0x80, 0xa1, 0xa3, 0xe7, 0xe1, 0x6d, 0x62, 0xd2, 0x33, 0x7f,
+	0x8b, 0x1a, 0x8f, 0x53, 0x15, 0x9b, 0xa0, 0x01, 0x6f, 0xa2, 0x41, 0xc0, 0xe8, 0x29, 0xed, 0x69,
+	0x5d, 0xe9, 0x00, 0xd3, 0xe1, 0x69, 0x5d, 0x72, 0xed, 0x48, 0xaf, 0x72, 0xf4, 0xd6, 0x90, 0x57,
+	0x23, 0x58, 0x90, 0x5e, 0x36, 0xc5, 0xa7, 0xfd, 0x67, 0xde, 0x25, 0x9a, 0x92, 0xa8, 0xb0, 0x8c,
+	0x44, 0xf6, 0xad, 0xd5, 0x00, 0x97, 0x8a, 0x4b, 0x82, 0x4b, 0xdd, 0x42, 0x03, 0xe1, 0x2c, 0x07,
+	0xb8, 0x96, 0x98, 0x88, 0x32, 0xa4, 0xc3, 0xb5, 0xe4, 0x76, 0xb0, 0x51, 0xdc, 0xf6, 0x1f, 0x67,
+	0x13, 0xb1, 0x76, 0x2a, 0xa8, 0xfe, 0x41, 0x00, 0x6a, 0xf1, 0x81, 0x38, 0xd4, 0x0a, 0x87, 0xa0,
+	0xf6, 0x3f, 0x79, 0xf1, 0xa3, 0x21, 0x70, 0xd9, 0x0c, 0xec, 0x3a, 0xfc, 0x80, 0x0b, 0x9b, 0x63,
+	0x97, 0xb7, 0x39, 0x2a, 0xfe, 0x8b, 0x5f, 0x50, 0x97, 0x17, 0x31, 0xfa, 0x03, 0x99, 0x20, 0xee,
+	0x93, 0x9f, 0x2f, 0xed, 0xae, 0x61, 0xc3, 0x6b, 0x11, 0xdf, 0xf7, 0xb6, 0x30, 0xd9, 0x56, 0xf7,
+	0x08, 0x6d, 0xb4, 0x72, 0x22, 0x9e, 0x40, 0xfd, 0x5b, 0x44, 0x23, 0xdb, 0xaa, 0xac, 0x4a, 0xe6,
+	0x01, 0x44, 0xdc, 0x60, 0x53, 0xc4, 0x27, 0x0a, 0x6d, 0xfb, 0xc4, 0x07, 0xde, 0xc3, 0x64, 0x08,
+	0x65, 0x9a, 0x2d, 0x0a, 0x6d, 0xd9, 0xa2, 0x73, 0x6e, 0x70, 0x0b, 0xce, 0xf9, 0xa1, 0xc7, 0xda,
+	0x79, 0xc9, 0x96, 0x77, 0xdb, 0xf4, 0x85, 0x4f, 0xdc, 0x5b, 0x61, 0x92, 0x46, 0xb0, 0xc2, 0x6d,
+	0x34, 0x14, 0x4d, 0xac, 0x81, 0x5b, 0x9c, 0xcb, 0xba, 0x50, 0x39, 0x7d, 0xc1, 0x1e, 0x83, 0x56,
+	0xb8, 0x19, 0x2f, 0xa3, 0x7e, 0xbf, 0xc9, 0x1a, 0xed, 0x6a, 0xe5, 0x8a, 0x16, 0x94, 0x14, 0xff,
+	0x2a, 0xa0, 0xa9, 0xc8, 0xed, 0x87, 0x8e, 0x40, 0x62, 0xf7, 0xdb, 0x0c, 0xe3, 0xcc, 0x7b, 0xd7,
+	0x5f, 0xf6, 0x70, 0x35, 0x9d, 0x87, 0x1e, 0xf7, 0x0e, 0xdc, 0xbe, 0x63, 0x3e, 0x10, 0xd0, 0xa3,
+	0x59, 0xa4, 0xb8, 0xf3, 0x53, 0x38, 0xfc, 0xfc, 0x74, 0xc8, 0x69, 0xaf, 0xc1, 0x03, 0xea, 0xa6,
+	0x49, 0x24, 0xab, 0x69, 0x1e, 0x44, 0x72, 0x80, 0x19, 0xc7, 0xc5, 0x2a, 0xec, 0x0e, 0x31, 0x69,
+	0x60, 0x9f, 0x7e, 0x6a, 0x1b, 0x45, 0xbd, 0x6e, 0x3a, 0x8f, 0x39, 0xbc, 0xfb, 0x55, 0xbc, 0xe4,
+	0x2f, 0x23, 0x50, 0xb7, 0x01, 0xb9, 0xfd, 0xb4, 0x0b, 0xc5, 0xbe, 0xbf, 0x4c, 0x62, 0x12, 0xfe,
+	0x34, 0x44, 0x2b, 0x05, 0xb8, 0xcb, 0x24, 0xa2, 0xc7, 0x9d, 0x86, 0xad, 0x70, 0xb3, 0xb8, 0x0b,
+	0x58, 0x2b, 0xf5, 0x7a, 0x0a, 0xd6, 0x4e, 0xed, 0x8f, 0x5e, 0x2c, 0x48, 0x1a, 0x8a, 0x4b, 0xb2,
+	0x70, 0x48, 0x92, 0x9d, 0xf3, 0xb5, 0xef, 0x0b, 0x90, 0xad, 0x80, 0x81, 0x2b, 0x79, 0x37, 0xc9,
+	0x80, 0x1b, 0x75, 0x85, 0xdd, 0x68, 0x1c, 0xf5, 0xbb, 0x54, 0xdd, 0xb7, 0x89, 0x62, 0x15, 0xb9,
+	0x4d, 0x2b, 0xf4, 0x04, 0x6f, 0xab, 0x0d, 0x62, 0xd9, 0x52, 0xc3, 0x70, 0x5f, 0x9a, 0xbd, 0x06,
+	0x71, 0x1f, 0xee, 0xef, 0x01, 0x40, 0xbe, 0xe7, 0x06, 0x93, 0xec, 0xbe, 0x7f, 0xe2, 0x49, 0xe4,
+	0x9c, 0x23, 0x1a, 0xbe, 0x89, 0xd9, 0x81, 0xfb, 0x98, 0xd3, 0xe8, 0xd9, 0x2c, 0x0b, 0x97, 0xf8,
+	0x47, 0xd7, 0x16, 0x4e, 0xbc, 0x08, 0x25, 0xdc, 0xda, 0xb6, 0x05, 0x46, 0x45, 0x07, 0x03, 0x1d,
+	0xac, 0x50, 0xa5, 0x9f, 0x9d, 0xa5, 0x62, 0xeb, 0xc0, 0xbb, 0xcb, 0xd6, 0x23, 0xee, 0xd8, 0xdd,
+	0xb6, 0x3b, 0xfe, 0xb8, 0x00, 0x96, 0x0b, 0xc0, 0x07, 0xcb, 0x9d, 0x47, 0x83, 0xba, 0x41, 0x9c,
+	0x0b, 0x52, 0x2d, 0x6c, 0xc1, 0x01, 0x68, 0x76, 0x33, 0xf1, 0x2f, 0x38, 0xd7, 0x30, 0x8d, 0xb8,
+	0xbb, 0x4b, 0xf2, 0x39, 0xce, 0xd3, 0xbf, 0xaa, 0x6a, 0x6e, 0x42, 0x9f, 0x89, 0xe1, 0x0a, 0xea,
+	0xa1, 0xaf, 0x41, 0xd6, 0x68, 0x81, 0x2a, 0x98, 0xe4, 0x2b, 0xd8, 0x74, 0xfa, 0xba, 0xf7, 0x52,
+	0x26, 0x88, 0x67, 0xd1, 0x48, 0x20, 0x3a, 0xbb, 0x2f, 0x7a, 0x0a, 0xe4, 0x65, 0x86, 0xad, 0x68,
+	0x36, 0x94, 0x28, 0xb8, 0x8c, 0x86, 0x43, 0x22, 0xdb, 0x4d, 0x4d, 0x81, 0x5c, 0x4d, 0xb1, 0x8a,
+	0x83, 0x12, 0xec, 0x17, 0xc7, 0x1e, 0x72, 0x5d, 0xb7, 0x82, 0xf6, 0xe8, 0x61, 0xf6, 0x80, 0xe6,
+	0xe4, 0xca, 0x84, 0xde, 0xf6, 0xd7, 0xd9, 0x4f, 0x05, 0x74, 0x3c, 0x64, 0xb7, 0xce, 0xd5, 0x0f,
+	0xe0, 0x13, 0xa8, 0x47, 0x36, 0x89, 0xa2, 0xba, 0xaf, 0x8f, 0xf0, 0xcd, 0xb9, 0x53, 0x2b, 0x64,
+	0x4b, 0xb5, 0xc1, 0xd5, 0xd9, 0x97, 0xe0, 0x2a, 0x2a, 0x86, 0xa3, 0xfc, 0x3b, 0x02, 0x1a, 0x08,
+	0x4f, 0x4d, 0x30, 0xa9, 0x24, 0xb4, 0x91, 0x54, 0x1a, 0x41, 0xdd, 0x72, 0xe0, 0x59, 0x94, 0x7d,
+	0x09, 0xe0, 0x2d, 0x24, 0xe3, 0x2d, 0x06, 0xf0, 0x4e, 0xff, 0x47, 0x40, 0x23, 0x49, 0xb9, 0x2f,
+	0x7c, 0x13, 0x9d, 0xdd, 0xac, 0x56, 0xd6, 0x36, 0x2a, 0xd7, 0x37, 0x57, 0x6e, 0xad, 0xd5, 0x16,
+	0x56, 0xaa, 0x8b, 0xec, 0xd3, 0xed, 0xb5, 0x8d, 0xf5, 0xc5, 0xeb, 0x2b, 0x4b, 0x2b, 0x8b, 0x0b,
+	0x43, 0x47, 0x4a, 0x93, 0xf7, 0xde, 0x9b, 0x18, 0x4f, 0x52, 0x70, 0x5b, 0xb3, 0x0c, 0x22, 0xab,
+	0xdb, 0x2a, 0x51, 0xf0, 0x35, 0x54, 0x4a, 0xd6, 0xb5, 0xb1, 0xb8, 0xb6, 0x39, 0x24, 0x94, 0x4e,
+	0xdf, 0x7b, 0x6f, 0x62, 0x34, 0x49, 0xc9, 0x06, 0xd1, 0x6c, 0xbc, 0x80, 0xc6, 0x92, 0xa5, 0xab,
+	0x8b, 0xd7, 0x17, 0x57, 0x5e, 0x5d, 0x5c, 0x18, 0xea, 0x2a, 0x4d, 0xdc, 0x7b, 0x6f, 0xe2, 0x74,
+	0x62, 0x0e, 0x8f, 0xc8, 0x44, 0xdd, 0x23, 0x4a, 0xa9, 0xf8, 0x9d, 0xf7, 0xc7, 0x8e, 0xcc, 0xbd,
+	0x7b, 0x11, 0x75, 0xd3, 0x55, 0x8c, 0xbf, 0x25, 0xa0, 0x1e, 0x56, 0xeb, 0x84, 0xcf, 0x27, 0x5a,
+	0x3d, 0x5e, 0x58, 0x55, 0xba, 0x90, 0xdd, 0x91, 0x39, 0xa7, 0x38, 0xf9, 0xd6, 0x67, 0xff, 0x78,
+	0xa7, 0xeb, 0x0c, 0x3e, 0x55, 0x4e, 0x2f, 0x93, 0xc3, 0x7f, 0x12, 0xd0, 0x60, 0xa4, 0xf0, 0x09,
+	0x5f, 0x4a, 0x1f, 0x22, 0xb9, 0xee, 0xaa, 0x34, 0xdb, 0x82, 0x04, 0xa0, 0x5b, 0xa6, 0xe8, 0x2a,
+	0xf8, 0xc5, 0x64, 0x74, 0x2c, 0xf2, 0x96, 0xef, 0xf8, 0x41, 0xf9, 0x6e, 0xb8, 0x6e, 0xaf, 0x7c,
+	0x87, 0x16, 0x77, 0xdd, 0xc5, 0xbf, 0x17, 0xd0, 0xd0, 0xaa, 0x6a, 0xe5, 0xa6, 0x90, 0x5c, 0x77,
+	0xc5, 0xa3, 0x90, 0x52, 0x22, 0x25, 0xbe, 0x48, 0x29, 0x3c, 0x83, 0xaf, 0xb6, 0x49, 0x01, 0xff,
+	0x4e, 0x40, 0x03, 0xe1, 0xda, 0x23, 0x5c, 0xe6, 0x5a, 0x32, 0x5e, 0x3b, 0x54, 0xba, 0x94, 0x5f,
+	0x00, 0x60, 0xcf, 0x53, 0xd8, 0xd7, 0xf0, 0xb3, 0xf9, 0x61, 0x07, 0x62, 0x51, 0xf9, 0x8e, 0xaa,
+	0xdc, 0xc5, 0x1f, 0x0b, 0x68, 0xd0, 0x31, 0x7a, 0x4e, 0xe8, 0x89, 0x65, 0x4f, 0xa5, 0x4b, 0xf9,
+	0x05, 0x00, 0xfa, 0xf3, 0x14, 0xfa, 0x55, 0xfc, 0x64, 0x5b, 0xd0, 0x1d, 0xd4, 0xc7, 0x43, 0xb7,
+	0x3a, 0x3c, 0xc3, 0xb5, 0x5e, 0xac, 0x2e, 0xa7, 0x54, 0xce, 0xdd, 0x1f, 0x10, 0x57, 0x28, 0xe2,
+	0xe7, 0xf0, 0x33, 0xf9, 0x11, 0xfb, 0xbb, 0x19, 0xb3, 0xf5, 0x87, 0x02, 0x1a, 0x70, 0x6c, 0x9d,
+	0x0f, 0x76, 0x52, 0x39, 0x51, 0xa9, 0x9c, 0xbb, 0x3f, 0xc0, 0xbe, 0x46, 0x61, 0x3f, 0x85, 0xaf,
+	0xb4, 0x03, 0x1b, 0xbf, 0x23, 0xa0, 0x3e, 0xaf, 0xe0, 0x06, 0x4f, 0x73, 0x6d, 0x16, 0x2a, 0xa0,
+	0x29, 0x3d, 0x9e, 0xab, 0x2f, 0x80, 0xbc, 0x48, 0x41, 0x9e, 0xc7, 0x53, 0xe5, 0xf4, 0x6a, 0xe2,
+	0xf2, 0x1d, 0x38, 0xa8, 0xdd, 0xc5, 0xf7, 0x04, 0x84, 0x1c, 0x3b, 0x66, 0xc3, 0x8a, 0xd6, 0xf5,
+	0xf0, 0x60, 0xc5, 0x2a, 0x74, 0x32, 0xe2, 0x2e, 0xd4, 0xe2, 0x7c, 0x28, 0xa0, 0xc1, 0x48, 0x11,
+	0x0c, 0x2f, 0x68, 0x25, 0x57, 0xda, 0xf0, 0x82, 0x56, 0x4a, 0x85, 0x8d, 0x78, 0x95, 0xa2, 0x9b,
+	0xc5, 0xe5, 0x5c, 0x46, 0x2b, 0x4b, 0x1e, 0xba, 0xcf, 0x05, 0x74, 0x32, 0xb2, 0xe4, 0xfd, 0xe2,
+	0x0c, 0xfc, 0x54, 0x3a, 0x12, 0x5e, 0xd9, 0x4c, 0xe9, 0x6a, 0xcb, 0x72, 0xc0, 0x63, 0x9d, 0xf2,
+	0xb8, 0x89, 0x6f, 0xe4, 0xf7, 0x50, 0x20, 0x15, 0x60, 0x17, 0x08, 0x0e, 0x96, 0x43, 0xf0, 0x44,
+	0x72, 0x05, 0x03, 0xe6, 0xa0, 0xe4, 0xd6, 0x67, 0x94, 0x9e, 0x6e, 0x5d, 0x10, 0xf8, 0xbd, 0x42,
+	0xf9, 0xbd, 0x84, 0x57, 0xda, 0xe0, 0xe7, 0x25, 0xc9, 0x83, 0xab, 0xd2, 0xc2, 0x9f, 0x0a, 0x68,
+	0x38, 0xa1, 0x40, 0x00, 0x5f, 0xc9, 0x09, 0x32, 0xf4, 0x72, 0x54, 0x7a, 0xb2, 0x45, 0x29, 0xe0,
+	0xb5, 0x4a, 0x79, 0x2d, 0xe1, 0x85, 0xb6, 0x02, 0x22, 0x7b, 0x52, 0x2a, 0xdf, 0x61, 0xff, 0xdf,
+	0xc5, 0x7f, 0x16, 0xd0, 0x23, 0xb1, 0x0c, 0x3f, 0x9e, 0xcb, 0x03, 0x2d, 0x5c, 0x6c, 0x50, 0xba,
+	0xdc, 0x92, 0x0c, 0x90, 0x59, 0xa0, 0x64, 0x5e, 0xc0, 0xd7, 0xda, 0x21, 0x53, 0xb3, 0x00, 0xee,
+	0x67, 0x02, 0x1a, 0x4e, 0x48, 0xe3, 0xf3, 0xe6, 0x25, 0xbd, 0xce, 0x80, 0x37, 0x2f, 0x9c, 0x5a,
+	0x01, 0xf1, 0x16, 0xa5, 0xb2, 0x82, 0x97, 0x0f, 0xb3, 0x9e, 0x48, 0x00, 0xfd, 0xdb, 0x02, 0x42,
+	0x7e, 0xfe, 0x1f, 0xf3, 0x23, 0x7b, 0x38, 0x8f, 0x5f, 0x7a, 0x22, 0x5f, 0x67, 0x80, 0xfe, 0x18,
+	0x85, 0x3e, 0x89, 0xcf, 0xf2, 0xa1, 0x3b, 0x7b, 0xe9, 0xf7, 0x04, 0xd4, 0x4f, 0x0f, 0x8b, 0xd9,
+	0xa8, 0x62, 0xd5, 0x05, 0x3c, 0x54, 0xf1, 0x3a, 0x01, 0xf1, 0x1c, 0x45, 0x35, 0x86, 0x4f, 0xf3,
+	0x50, 0xe1, 0x1f, 0x09, 0xa8, 0x3f, 0x90, 0x92, 0xc7, 0x7c, 0xe6, 0x91, 0xe4, 0x7a, 0xe9, 0x62,
+	0xce, 0xde, 0x00, 0x69, 0x9a, 0x42, 0x3a, 0x87, 0xc5, 0x32, 0xef, 0x8f, 0x6b, 0x98, 0xa5, 0xde,
+	0x16, 0xd0, 0x31, 0xc7, 0x52, 0x79, 0x90, 0xc5, 0xd3, 0xfe, 0x3c, 0x64, 0x09, 0x09, 0x7c, 0x71,
+	0x8a, 0x22, 0x1b, 0xc7, 0x67, 0xb8, 0xc8, 0xf0, 0x0f, 0x05, 0xd4, 0xc3, 0x52, 0xdd, 0xbc, 0x0b,
+	0x53, 0x28, 0xf7, 0xce, 0xbb, 0x30, 0x85, 0xf3, 0xed, 0xe2, 0xd3, 0x14, 0xc4, 0x1c, 0xbe, 0xd4,
+	0xc2, 0x6a, 0x66, 0x60, 0xee, 0x0b, 0x09, 0x09, 0x6f, 0xce, 0xe6, 0x9c, 0x92, 0x6f, 0x2f, 0xcd,
+	0xb5, 0x22, 0x92, 0x6b, 0x43, 0x8f, 0xaf, 0x51, 0xf7, 0xcf, 0x77, 0xf0, 0x47, 0x02, 0x1a, 0x8a,
+	0xa6, 0x34, 0x31, 0xff, 0x26, 0x97, 0x94, 0x8c, 0xe4, 0x81, 0x4e, 0xcb, 0x98, 0x8a, 0x4f, 0x52,
+	0xd0, 0x65, 0x7c, 0xb1, 0x9c, 0xfd, 0x27, 0x63, 0x81, 0x23, 0xdc, 0x2f, 0x04, 0xf4, 0x88, 0xe3,
+	0x94, 0xb9, 0x31, 0xa7, 0x24, 0x50, 0x79, 0x98, 0xd3, 0x52, 0xa1, 0xe2, 0xe3, 0x14, 0xf3, 0x14,
+	0x9e, 0xcc, 0x81, 0x19, 0xff, 0x9a, 0x5d, 0x35, 0xfc, 0x1c, 0x58, 0xc6, 0x55, 0x23, 0x96, 0xc2,
+	0xcc, 0xb8, 0x6a, 0xc4, 0xd3, 0x94, 0xed, 0x9c, 0xd9, 0x69, 0x12, 0x8f, 0xad, 0xf7, 0x4f, 0xe0,
+	0x1a, 0x1d, 0xcc, 0xfa, 0x61, 0xde, 0x2a, 0x8e, 0xe7, 0x30, 0x4b, 0x33, 0x79, 0xbb, 0x03, 0xe2,
+	0x35, 0x8a, 0xf8, 0x06, 0x5e, 0x6a, 0x63, 0xcf, 0x09, 0xa4, 0x3e, 0x81, 0x87, 0x85, 0x1f, 0x08,
+	0x08, 0xc7, 0xb3, 0x76, 0xf8, 0x72, 0xce, 0x4b, 0x5b, 0x30, 0x6b, 0x58, 0xba, 0xd2, 0x9a, 0x50,
+	0xfb, 0xaf, 0x1a, 0xd1, 0x44, 0x15, 0x9b, 0x8e, 0x7f, 0x0b, 0xe8, 0x64, 0x6a, 0x9e, 0x0b, 0x3f,
+	0x9b, 0xe7, 0xb0, 0x92, 0x9c, 0xf1, 0x2b, 0x3d, 0xd7, 0x96, 0x2c, 0xf0, 0xab, 0x52, 0x7e, 0xab,
+	0xf8, 0xe6, 0x21, 0xf8, 0x45, 0xcf, 0x70, 0xf7, 0x05, 0x34, 0x18, 0x49, 0x65, 0xf1, 0xae, 0x42,
+	0xc9, 0x39, 0x33, 0xde, 0x55, 0x28, 0x25, 0x4f, 0x26, 0x3e, 0x4b, 0xc9, 0x5c, 0xc1, 0x73, 0xad,
+	0xbc, 0x26, 0x30, 0x55, 0xf8, 0x63, 0xe6, 0x6a, 0x91, 0x64, 0x4e, 0x86, 0xab, 0x25, 0x67, 0xab,
+	0x32, 0x5c, 0x2d, 0x25, 0xef, 0x24, 0xce, 0x51, 0xf4, 0x4f, 0xe0, 0xe9, 0x72, 0x9e, 0xbf, 0xd0,
+	0xf5, 0x9e, 0x12, 0x86, 0x9d, 0x45, 0xde, 0x02, 0xec, 0xd4, 0x24, 0x1b, 0x0f, 0x76, 0x7a, 0xba,
+	0x2c, 0xe3, 0xd2, 0x1e, 0x85, 0x8d, 0x7f, 0x23, 0xa0, 0x3e, 0x2f, 0x4f, 0xc4, 0xbb, 0xb3, 0x47,
+	0xb3, 0x5b, 0xbc, 0x3b, 0x7b, 0x2c, 0xf1, 0x74, 0xa8, 0x48, 0xe4, 0xed, 0xac, 0x2e, 0x78, 0xc9,
+	0x76, 0xdc, 0xa3, 0xcf, 0x7b, 0x68, 0xe7, 0xc1, 0x8e, 0x26, 0xa2, 0x78, 0xb0, 0x63, 0x59, 0x1f,
+	0xf1, 0x65, 0x0a, 0x7b, 0x19, 0x2f, 0x1e, 0x06, 0xb6, 0xe5, 0xaa, 0x9d, 0x9f, 0x7b, 0xf0, 0xc5,
+	0x98, 0xf0, 0xe9, 0x17, 0x63, 0xc2, 0xdf, 0xbf, 0x18, 0x13, 0x7e, 0xf0, 0xe5, 0xd8, 0x91, 0x4f,
+	0xbf, 0x1c, 0x3b, 0xf2, 0xb7, 0x2f, 0xc7, 0x8e, 0x7c, 0x6d, 0xd4, 0xd7, 0xbf, 0xef, 0x8e, 0x60,
+	0x1f, 0x18, 0xc4, 0xda, 0xea, 0xa1, 0x7f, 0x05, 0x7c, 0xf9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x5c, 0xb3, 0x96, 0x3b, 0xd1, 0x3e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListPointBalance Queries a list of PointBalance items.
+	GetPointBalance(ctx context.Context, in *QueryGetPointBalanceRequest, opts ...grpc.CallOption) (*QueryGetPointBalanceResponse, error)
+	// ListPointBalance defines the ListPointBalance RPC.
+	ListPointBalance(ctx context.Context, in *QueryAllPointBalanceRequest, opts ...grpc.CallOption) (*QueryAllPointBalanceResponse, error)
+	// ListTransaction Queries a list of Transaction items.
+	GetTransaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error)
+	// ListTransaction defines the ListTransaction RPC.
+	ListTransaction(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error)
+	// ListSettlement Queries a list of Settlement items.
+	GetSettlement(ctx context.Context, in *QueryGetSettlementRequest, opts ...grpc.CallOption) (*QueryGetSettlementResponse, error)
+	// ListSettlement defines the ListSettlement RPC.
+	ListSettlement(ctx context.Context, in *QueryAllSettlementRequest, opts ...grpc.CallOption) (*QueryAllSettlementResponse, error)
+	// GetIssuer queries an issuer by address.
+	GetIssuer(ctx context.Context, in *QueryGetIssuerRequest, opts ...grpc.CallOption) (*QueryGetIssuerResponse, error)
+	// ListIssuer queries all registered issuers.
+	ListIssuer(ctx context.Context, in *QueryAllIssuerRequest, opts ...grpc.CallOption) (*QueryAllIssuerResponse, error)
+	// IssuerAllowance queries how much an issuer may still issue in the current epoch.
+	IssuerAllowance(ctx context.Context, in *QueryIssuerAllowanceRequest, opts ...grpc.CallOption) (*QueryIssuerAllowanceResponse, error)
+	// ListTransactionsByAddress queries the transactions an address sent or
+	// received, newest last unless pagination.reverse is set.
+	ListTransactionsByAddress(ctx context.Context, in *QueryTransactionsByAddressRequest, opts ...grpc.CallOption) (*QueryTransactionsByAddressResponse, error)
+	// SettlementsByRequester queries the settlements an address requested.
+	SettlementsByRequester(ctx context.Context, in *QuerySettlementsByRequesterRequest, opts ...grpc.CallOption) (*QuerySettlementsByRequesterResponse, error)
+	// SettlementsByStatus queries the settlements in a status.
+	SettlementsByStatus(ctx context.Context, in *QuerySettlementsByStatusRequest, opts ...grpc.CallOption) (*QuerySettlementsByStatusResponse, error)
+	// SettlementSummary queries the number and total amount of pending
+	// settlements, optionally for a single requester.
+	SettlementSummary(ctx context.Context, in *QuerySettlementSummaryRequest, opts ...grpc.CallOption) (*QuerySettlementSummaryResponse, error)
+	// UpcomingExpirations queries an account's point lots that will expire,
+	// soonest first.
+	UpcomingExpirations(ctx context.Context, in *QueryUpcomingExpirationsRequest, opts ...grpc.CallOption) (*QueryUpcomingExpirationsResponse, error)
+	// GetProgram queries a point program by id.
+	GetProgram(ctx context.Context, in *QueryGetProgramRequest, opts ...grpc.CallOption) (*QueryGetProgramResponse, error)
+	// ListProgram queries all point programs.
+	ListProgram(ctx context.Context, in *QueryAllProgramRequest, opts ...grpc.CallOption) (*QueryAllProgramResponse, error)
+	// GetMerchant queries a merchant by id.
+	GetMerchant(ctx context.Context, in *QueryGetMerchantRequest, opts ...grpc.CallOption) (*QueryGetMerchantResponse, error)
+	// ListMerchant queries all merchants.
+	ListMerchant(ctx context.Context, in *QueryAllMerchantRequest, opts ...grpc.CallOption) (*QueryAllMerchantResponse, error)
+	// Supply queries the supply counters of a program.
+	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
+	// VelocityHeadroom queries how much an account may still spend, transfer
+	// and, when recipient is set, issue to recipient in the current windows.
+	VelocityHeadroom(ctx context.Context, in *QueryVelocityHeadroomRequest, opts ...grpc.CallOption) (*QueryVelocityHeadroomResponse, error)
+	// GetFrozenAccount queries the compliance hold on an account.
+	GetFrozenAccount(ctx context.Context, in *QueryGetFrozenAccountRequest, opts ...grpc.CallOption) (*QueryGetFrozenAccountResponse, error)
+	// ListFrozenAccount queries the accounts that are frozen at the current
+	// block time.
+	ListFrozenAccount(ctx context.Context, in *QueryAllFrozenAccountRequest, opts ...grpc.CallOption) (*QueryAllFrozenAccountResponse, error)
+	// GetPointGrant queries a point grant by id.
+	GetPointGrant(ctx context.Context, in *QueryGetPointGrantRequest, opts ...grpc.CallOption) (*QueryGetPointGrantResponse, error)
+	// ListActiveGrants queries the grants of a beneficiary in a program that
+	// still have tranches to release.
+	ListActiveGrants(ctx context.Context, in *QueryActiveGrantsRequest, opts ...grpc.CallOption) (*QueryActiveGrantsResponse, error)
+	// GetSettlementBatch queries a settlement batch by id.
+	GetSettlementBatch(ctx context.Context, in *QueryGetSettlementBatchRequest, opts ...grpc.CallOption) (*QueryGetSettlementBatchResponse, error)
+	// SettlementBatchesByStatus queries the settlement batches in a status.
+	SettlementBatchesByStatus(ctx context.Context, in *QuerySettlementBatchesByStatusRequest, opts ...grpc.CallOption) (*QuerySettlementBatchesByStatusResponse, error)
+	// TreasuryBalance queries the points held by the points treasury in a
+	// program.
+	TreasuryBalance(ctx context.Context, in *QueryTreasuryBalanceRequest, opts ...grpc.CallOption) (*QueryTreasuryBalanceResponse, error)
+	// GetBalanceSnapshot queries a balance snapshot by id.
+	GetBalanceSnapshot(ctx context.Context, in *QueryGetBalanceSnapshotRequest, opts ...grpc.CallOption) (*QueryGetBalanceSnapshotResponse, error)
+	// ListBalanceSnapshot queries all balance snapshots.
+	ListBalanceSnapshot(ctx context.Context, in *QueryAllBalanceSnapshotRequest, opts ...grpc.CallOption) (*QueryAllBalanceSnapshotResponse, error)
+	// BalanceAt queries the balance an account held in a program as of a
+	// snapshot or a point in time.
+	BalanceAt(ctx context.Context, in *QueryBalanceAtRequest, opts ...grpc.CallOption) (*QueryBalanceAtResponse, error)
+	// Statement queries the statement of an account in a program over a time
+	// range: its opening balance, its transactions with a running balance,
+	// totals by transaction type and its closing balance.
+	Statement(ctx context.Context, in *QueryStatementRequest, opts ...grpc.CallOption) (*QueryStatementResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPointBalance(ctx context.Context, in *QueryGetPointBalanceRequest, opts ...grpc.CallOption) (*QueryGetPointBalanceResponse, error) {
+	out := new(QueryGetPointBalanceResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetPointBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPointBalance(ctx context.Context, in *QueryAllPointBalanceRequest, opts ...grpc.CallOption) (*QueryAllPointBalanceResponse, error) {
+	out := new(QueryAllPointBalanceResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListPointBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTransaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error) {
+	out := new(QueryGetTransactionResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTransaction(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error) {
+	out := new(QueryAllTransactionResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSettlement(ctx context.Context, in *QueryGetSettlementRequest, opts ...grpc.CallOption) (*QueryGetSettlementResponse, error) {
+	out := new(QueryGetSettlementResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetSettlement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSettlement(ctx context.Context, in *QueryAllSettlementRequest, opts ...grpc.CallOption) (*QueryAllSettlementResponse, error) {
+	out := new(QueryAllSettlementResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListSettlement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetIssuer(ctx context.Context, in *QueryGetIssuerRequest, opts ...grpc.CallOption) (*QueryGetIssuerResponse, error) {
+	out := new(QueryGetIssuerResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListIssuer(ctx context.Context, in *QueryAllIssuerRequest, opts ...grpc.CallOption) (*QueryAllIssuerResponse, error) {
+	out := new(QueryAllIssuerResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IssuerAllowance(ctx context.Context, in *QueryIssuerAllowanceRequest, opts ...grpc.CallOption) (*QueryIssuerAllowanceResponse, error) {
+	out := new(QueryIssuerAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/IssuerAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTransactionsByAddress(ctx context.Context, in *QueryTransactionsByAddressRequest, opts ...grpc.CallOption) (*QueryTransactionsByAddressResponse, error) {
+	out := new(QueryTransactionsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListTransactionsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettlementsByRequester(ctx context.Context, in *QuerySettlementsByRequesterRequest, opts ...grpc.CallOption) (*QuerySettlementsByRequesterResponse, error) {
+	out := new(QuerySettlementsByRequesterResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/SettlementsByRequester", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettlementsByStatus(ctx context.Context, in *QuerySettlementsByStatusRequest, opts ...grpc.CallOption) (*QuerySettlementsByStatusResponse, error) {
+	out := new(QuerySettlementsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/SettlementsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettlementSummary(ctx context.Context, in *QuerySettlementSummaryRequest, opts ...grpc.CallOption) (*QuerySettlementSummaryResponse, error) {
+	out := new(QuerySettlementSummaryResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/SettlementSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpcomingExpirations(ctx context.Context, in *QueryUpcomingExpirationsRequest, opts ...grpc.CallOption) (*QueryUpcomingExpirationsResponse, error) {
+	out := new(QueryUpcomingExpirationsResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/UpcomingExpirations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProgram(ctx context.Context, in *QueryGetProgramRequest, opts ...grpc.CallOption) (*QueryGetProgramResponse, error) {
+	out := new(QueryGetProgramResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListProgram(ctx context.Context, in *QueryAllProgramRequest, opts ...grpc.CallOption) (*QueryAllProgramResponse, error) {
+	out := new(QueryAllProgramResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMerchant(ctx context.Context, in *QueryGetMerchantRequest, opts ...grpc.CallOption) (*QueryGetMerchantResponse, error) {
+	out := new(QueryGetMerchantResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListMerchant(ctx context.Context, in *QueryAllMerchantRequest, opts ...grpc.CallOption) (*QueryAllMerchantResponse, error) {
+	out := new(QueryAllMerchantResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error) {
+	out := new(QuerySupplyResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/Supply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VelocityHeadroom(ctx context.Context, in *QueryVelocityHeadroomRequest, opts ...grpc.CallOption) (*QueryVelocityHeadroomResponse, error) {
+	out := new(QueryVelocityHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/VelocityHeadroom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFrozenAccount(ctx context.Context, in *QueryGetFrozenAccountRequest, opts ...grpc.CallOption) (*QueryGetFrozenAccountResponse, error) {
+	out := new(QueryGetFrozenAccountResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetFrozenAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListFrozenAccount(ctx context.Context, in *QueryAllFrozenAccountRequest, opts ...grpc.CallOption) (*QueryAllFrozenAccountResponse, error) {
+	out := new(QueryAllFrozenAccountResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListFrozenAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPointGrant(ctx context.Context, in *QueryGetPointGrantRequest, opts ...grpc.CallOption) (*QueryGetPointGrantResponse, error) {
+	out := new(QueryGetPointGrantResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetPointGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListActiveGrants(ctx context.Context, in *QueryActiveGrantsRequest, opts ...grpc.CallOption) (*QueryActiveGrantsResponse, error) {
+	out := new(QueryActiveGrantsResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListActiveGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSettlementBatch(ctx context.Context, in *QueryGetSettlementBatchRequest, opts ...grpc.CallOption) (*QueryGetSettlementBatchResponse, error) {
+	out := new(QueryGetSettlementBatchResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetSettlementBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettlementBatchesByStatus(ctx context.Context, in *QuerySettlementBatchesByStatusRequest, opts ...grpc.CallOption) (*QuerySettlementBatchesByStatusResponse, error) {
+	out := new(QuerySettlementBatchesByStatusResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/SettlementBatchesByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryBalance(ctx context.Context, in *QueryTreasuryBalanceRequest, opts ...grpc.CallOption) (*QueryTreasuryBalanceResponse, error) {
+	out := new(QueryTreasuryBalanceResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/TreasuryBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetBalanceSnapshot(ctx context.Context, in *QueryGetBalanceSnapshotRequest, opts ...grpc.CallOption) (*QueryGetBalanceSnapshotResponse, error) {
+	out := new(QueryGetBalanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/GetBalanceSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListBalanceSnapshot(ctx context.Context, in *QueryAllBalanceSnapshotRequest, opts ...grpc.CallOption) (*QueryAllBalanceSnapshotResponse, error) {
+	out := new(QueryAllBalanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/ListBalanceSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceAt(ctx context.Context, in *QueryBalanceAtRequest, opts ...grpc.CallOption) (*QueryBalanceAtResponse, error) {
+	out := new(QueryBalanceAtResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/BalanceAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Statement(ctx context.Context, in *QueryStatementRequest, opts ...grpc.CallOption) (*QueryStatementResponse, error) {
+	out := new(QueryStatementResponse)
+	err := c.cc.Invoke(ctx, "/scontract.points.v1.Query/Statement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListPointBalance Queries a list of PointBalance items.
+	GetPointBalance(context.Context, *QueryGetPointBalanceRequest) (*QueryGetPointBalanceResponse, error)
+	// ListPointBalance defines the ListPointBalance RPC.
+	ListPointBalance(context.Context, *QueryAllPointBalanceRequest) (*QueryAllPointBalanceResponse, error)
+	// ListTransaction Queries a list of Transaction items.
+	GetTransaction(context.Context, *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error)
+	// ListTransaction defines the ListTransaction RPC.
+	ListTransaction(context.Context, *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error)
+	// ListSettlement Queries a list of Settlement items.
+	GetSettlement(context.Context, *QueryGetSettlementRequest) (*QueryGetSettlementResponse, error)
+	// ListSettlement defines the ListSettlement RPC.
+	ListSettlement(context.Context, *QueryAllSettlementRequest) (*QueryAllSettlementResponse, error)
+	// GetIssuer queries an issuer by address.
+	GetIssuer(context.Context, *QueryGetIssuerRequest) (*QueryGetIssuerResponse, error)
+	// ListIssuer queries all registered issuers.
+	ListIssuer(context.Context, *QueryAllIssuerRequest) (*QueryAllIssuerResponse, error)
+	// IssuerAllowance queries how much an issuer may still issue in the current epoch.
+	IssuerAllowance(context.Context, *QueryIssuerAllowanceRequest) (*QueryIssuerAllowanceResponse, error)
+	// ListTransactionsByAddress queries the transactions an address sent or
+	// received, newest last unless pagination.reverse is set.
+	ListTransactionsByAddress(context.Context, *QueryTransactionsByAddressRequest) (*QueryTransactionsByAddressResponse, error)
+	// SettlementsByRequester queries the settlements an address requested.
+	SettlementsByRequester(context.Context, *QuerySettlementsByRequesterRequest) (*QuerySettlementsByRequesterResponse, error)
+	// SettlementsByStatus queries the settlements in a status.
+	SettlementsByStatus(context.Context, *QuerySettlementsByStatusRequest) (*QuerySettlementsByStatusResponse, error)
+	// SettlementSummary queries the number and total amount of pending
+	// settlements, optionally for a single requester.
+	SettlementSummary(context.Context, *QuerySettlementSummaryRequest) (*QuerySettlementSummaryResponse, error)
+	// UpcomingExpirations queries an account's point lots that will expire,
+	// soonest first.
+	UpcomingExpirations(context.Context, *QueryUpcomingExpirationsRequest) (*QueryUpcomingExpirationsResponse, error)
+	// GetProgram queries a point program by id.
+	GetProgram(context.Context, *QueryGetProgramRequest) (*QueryGetProgramResponse, error)
+	// ListProgram queries all point programs.
+	ListProgram(context.Context, *QueryAllProgramRequest) (*QueryAllProgramResponse, error)
+	// GetMerchant queries a merchant by id.
+	GetMerchant(context.Context, *QueryGetMerchantRequest) (*QueryGetMerchantResponse, error)
+	// ListMerchant queries all merchants.
+	ListMerchant(context.Context, *QueryAllMerchantRequest) (*QueryAllMerchantResponse, error)
+	// Supply queries the supply counters of a program.
+	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
+	// VelocityHeadroom queries how much an account may still spend, transfer
+	// and, when recipient is set, issue to recipient in the current windows.
+	VelocityHeadroom(context.Context, *QueryVelocityHeadroomRequest) (*QueryVelocityHeadroomResponse, error)
+	// GetFrozenAccount queries the compliance hold on an account.
+	GetFrozenAccount(context.Context, *QueryGetFrozenAccountRequest) (*QueryGetFrozenAccountResponse, error)
+	// ListFrozenAccount queries the accounts that are frozen at the current
+	// block time.
+	ListFrozenAccount(context.Context, *QueryAllFrozenAccountRequest) (*QueryAllFrozenAccountResponse, error)
+	// GetPointGrant queries a point grant by id.
+	GetPointGrant(context.Context, *QueryGetPointGrantRequest) (*QueryGetPointGrantResponse, error)
+	// ListActiveGrants queries the grants of a beneficiary in a program that
+	// still have tranches to release.
+	ListActiveGrants(context.Context, *QueryActiveGrantsRequest) (*QueryActiveGrantsResponse, error)
+	// GetSettlementBatch queries a settlement batch by id.
+	GetSettlementBatch(context.Context, *QueryGetSettlementBatchRequest) (*QueryGetSettlementBatchResponse, error)
+	// SettlementBatchesByStatus queries the settlement batches in a status.
+	SettlementBatchesByStatus(context.Context, *QuerySettlementBatchesByStatusRequest) (*QuerySettlementBatchesByStatusResponse, error)
+	// TreasuryBalance queries the points held by the points treasury in a
+	// program.
+	TreasuryBalance(context.Context, *QueryTreasuryBalanceRequest) (*QueryTreasuryBalanceResponse, error)
+	// GetBalanceSnapshot queries a balance snapshot by id.
+	GetBalanceSnapshot(context.Context, *QueryGetBalanceSnapshotRequest) (*QueryGetBalanceSnapshotResponse, error)
+	// ListBalanceSnapshot queries all balance snapshots.
+	ListBalanceSnapshot(context.Context, *QueryAllBalanceSnapshotRequest) (*QueryAllBalanceSnapshotResponse, error)
+	// BalanceAt queries the balance an account held in a program as of a
+	// snapshot or a point in time.
+	BalanceAt(context.Context, *QueryBalanceAtRequest) (*QueryBalanceAtResponse, error)
+	// Statement queries the statement of an account in a program over a time
+	// range: its opening balance, its transactions with a running balance,
+	// totals by transaction type and its closing balance.
+	Statement(context.Context, *QueryStatementRequest) (*QueryStatementResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetPointBalance(ctx context.Context, req *QueryGetPointBalanceRequest) (*QueryGetPointBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPointBalance not implemented")
+}
+func (*UnimplementedQueryServer) ListPointBalance(ctx context.Context, req *QueryAllPointBalanceRequest) (*QueryAllPointBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPointBalance not implemented")
+}
+func (*UnimplementedQueryServer) GetTransaction(ctx context.Context, req *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (*UnimplementedQueryServer) ListTransaction(ctx context.Context, req *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransaction not implemented")
+}
+func (*UnimplementedQueryServer) GetSettlement(ctx context.Context, req *QueryGetSettlementRequest) (*QueryGetSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlement not implemented")
+}
+func (*UnimplementedQueryServer) ListSettlement(ctx context.Context, req *QueryAllSettlementRequest) (*QueryAllSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSettlement not implemented")
+}
+func (*UnimplementedQueryServer) GetIssuer(ctx context.Context, req *QueryGetIssuerRequest) (*QueryGetIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssuer not implemented")
+}
+func (*UnimplementedQueryServer) ListIssuer(ctx context.Context, req *QueryAllIssuerRequest) (*QueryAllIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssuer not implemented")
+}
+func (*UnimplementedQueryServer) IssuerAllowance(ctx context.Context, req *QueryIssuerAllowanceRequest) (*QueryIssuerAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuerAllowance not implemented")
+}
+func (*UnimplementedQueryServer) ListTransactionsByAddress(ctx context.Context, req *QueryTransactionsByAddressRequest) (*QueryTransactionsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactionsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) SettlementsByRequester(ctx context.Context, req *QuerySettlementsByRequesterRequest) (*QuerySettlementsByRequesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlementsByRequester not implemented")
+}
+func (*UnimplementedQueryServer) SettlementsByStatus(ctx context.Context, req *QuerySettlementsByStatusRequest) (*QuerySettlementsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlementsByStatus not implemented")
+}
+func (*UnimplementedQueryServer) SettlementSummary(ctx context.Context, req *QuerySettlementSummaryRequest) (*QuerySettlementSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlementSummary not implemented")
+}
+func (*UnimplementedQueryServer) UpcomingExpirations(ctx context.Context, req *QueryUpcomingExpirationsRequest) (*QueryUpcomingExpirationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingExpirations not implemented")
+}
+func (*UnimplementedQueryServer) GetProgram(ctx context.Context, req *QueryGetProgramRequest) (*QueryGetProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProgram not implemented")
+}
+func (*UnimplementedQueryServer) ListProgram(ctx context.Context, req *QueryAllProgramRequest) (*QueryAllProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProgram not implemented")
+}
+func (*UnimplementedQueryServer) GetMerchant(ctx context.Context, req *QueryGetMerchantRequest) (*QueryGetMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchant not implemented")
+}
+func (*UnimplementedQueryServer) ListMerchant(ctx context.Context, req *QueryAllMerchantRequest) (*QueryAllMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMerchant not implemented")
+}
+func (*UnimplementedQueryServer) Supply(ctx context.Context, req *QuerySupplyRequest) (*QuerySupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+}
+func (*UnimplementedQueryServer) VelocityHeadroom(ctx context.Context, req *QueryVelocityHeadroomRequest) (*QueryVelocityHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VelocityHeadroom not implemented")
+}
+func (*UnimplementedQueryServer) GetFrozenAccount(ctx context.Context, req *QueryGetFrozenAccountRequest) (*QueryGetFrozenAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFrozenAccount not implemented")
+}
+func (*UnimplementedQueryServer) ListFrozenAccount(ctx context.Context, req *QueryAllFrozenAccountRequest) (*QueryAllFrozenAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFrozenAccount not implemented")
+}
+func (*UnimplementedQueryServer) GetPointGrant(ctx context.Context, req *QueryGetPointGrantRequest) (*QueryGetPointGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPointGrant not implemented")
+}
+func (*UnimplementedQueryServer) ListActiveGrants(ctx context.Context, req *QueryActiveGrantsRequest) (*QueryActiveGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActiveGrants not implemented")
+}
+func (*UnimplementedQueryServer) GetSettlementBatch(ctx context.Context, req *QueryGetSettlementBatchRequest) (*QueryGetSettlementBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlementBatch not implemented")
+}
+func (*UnimplementedQueryServer) SettlementBatchesByStatus(ctx context.Context, req *QuerySettlementBatchesByStatusRequest) (*QuerySettlementBatchesByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlementBatchesByStatus not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryBalance(ctx context.Context, req *QueryTreasuryBalanceRequest) (*QueryTreasuryBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryBalance not implemented")
+}
+func (*UnimplementedQueryServer) GetBalanceSnapshot(ctx context.Context, req *QueryGetBalanceSnapshotRequest) (*QueryGetBalanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceSnapshot not implemented")
+}
+func (*UnimplementedQueryServer) ListBalanceSnapshot(ctx context.Context, req *QueryAllBalanceSnapshotRequest) (*QueryAllBalanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBalanceSnapshot not implemented")
+}
+func (*UnimplementedQueryServer) BalanceAt(ctx context.Context, req *QueryBalanceAtRequest) (*QueryBalanceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceAt not implemented")
+}
+func (*UnimplementedQueryServer) Statement(ctx context.Context, req *QueryStatementRequest) (*QueryStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Statement not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPointBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPointBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPointBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/GetPointBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPointBalance(ctx, req.(*QueryGetPointBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPointBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPointBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPointBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/ListPointBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPointBalance(ctx, req.(*QueryAllPointBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTransaction(ctx, req.(*QueryGetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/ListTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTransaction(ctx, req.(*QueryAllTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/GetSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSettlement(ctx, req.(*QueryGetSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/ListSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSettlement(ctx, req.(*QueryAllSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/GetIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetIssuer(ctx, req.(*QueryGetIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/ListIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListIssuer(ctx, req.(*QueryAllIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IssuerAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuerAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IssuerAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/IssuerAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuerAllowance(ctx, req.(*QueryIssuerAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTransactionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransactionsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTransactionsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/ListTransactionsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTransactionsByAddress(ctx, req.(*QueryTransactionsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettlementsByRequester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementsByRequesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettlementsByRequester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/SettlementsByRequester",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettlementsByRequester(ctx, req.(*QuerySettlementsByRequesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettlementsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettlementsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/SettlementsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettlementsByStatus(ctx, req.(*QuerySettlementsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettlementSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettlementSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/SettlementSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettlementSummary(ctx, req.(*QuerySettlementSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpcomingExpirations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingExpirationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingExpirations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/UpcomingExpirations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingExpirations(ctx, req.(*QueryUpcomingExpirationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/GetProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProgram(ctx, req.(*QueryGetProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/ListProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListProgram(ctx, req.(*QueryAllProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/GetMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMerchant(ctx, req.(*QueryGetMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/ListMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListMerchant(ctx, req.(*QueryAllMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Supply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/Supply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VelocityHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVelocityHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VelocityHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/VelocityHeadroom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VelocityHeadroom(ctx, req.(*QueryVelocityHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFrozenAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFrozenAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFrozenAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/GetFrozenAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFrozenAccount(ctx, req.(*QueryGetFrozenAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListFrozenAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFrozenAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListFrozenAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/ListFrozenAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListFrozenAccount(ctx, req.(*QueryAllFrozenAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPointGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPointGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPointGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/GetPointGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPointGrant(ctx, req.(*QueryGetPointGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListActiveGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListActiveGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/ListActiveGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListActiveGrants(ctx, req.(*QueryActiveGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSettlementBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSettlementBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSettlementBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/GetSettlementBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSettlementBatch(ctx, req.(*QueryGetSettlementBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettlementBatchesByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementBatchesByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettlementBatchesByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/SettlementBatchesByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettlementBatchesByStatus(ctx, req.(*QuerySettlementBatchesByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/TreasuryBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryBalance(ctx, req.(*QueryTreasuryBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBalanceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/GetBalanceSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBalanceSnapshot(ctx, req.(*QueryGetBalanceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListBalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBalanceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListBalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/ListBalanceSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBalanceSnapshot(ctx, req.(*QueryAllBalanceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/BalanceAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceAt(ctx, req.(*QueryBalanceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Statement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Statement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scontract.points.v1.Query/Statement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Statement(ctx, req.(*QueryStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "scontract.points.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetPointBalance",
+			Handler:    _Query_GetPointBalance_Handler,
+		},
+		{
+			MethodName: "ListPointBalance",
+			Handler:    _Query_ListPointBalance_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _Query_GetTransaction_Handler,
+		},
+		{
+			MethodName: "ListTransaction",
+			Handler:    _Query_ListTransaction_Handler,
+		},
+		{
+			MethodName: "GetSettlement",
+			Handler:    _Query_GetSettlement_Handler,
+		},
+		{
+			MethodName: "ListSettlement",
+			Handler:    _Query_ListSettlement_Handler,
+		},
+		{
+			MethodName: "GetIssuer",
+			Handler:    _Query_GetIssuer_Handler,
+		},
+		{
+			MethodName: "ListIssuer",
+			Handler:    _Query_ListIssuer_Handler,
+		},
+		{
+			MethodName: "IssuerAllowance",
+			Handler:    _Query_IssuerAllowance_Handler,
+		},
+		{
+			MethodName: "ListTransactionsByAddress",
+			Handler:    _Query_ListTransactionsByAddress_Handler,
+		},
+		{
+			MethodName: "SettlementsByRequester",
+			Handler:    _Query_SettlementsByRequester_Handler,
+		},
+		{
+			MethodName: "SettlementsByStatus",
+			Handler:    _Query_SettlementsByStatus_Handler,
+		},
+		{
+			MethodName: "SettlementSummary",
+			Handler:    _Query_SettlementSummary_Handler,
+		},
+		{
+			MethodName: "UpcomingExpirations",
+			Handler:    _Query_UpcomingExpirations_Handler,
+		},
+		{
+			MethodName: "GetProgram",
+			Handler:    _Query_GetProgram_Handler,
+		},
+		{
+			MethodName: "ListProgram",
+			Handler:    _Query_ListProgram_Handler,
+		},
+		{
+			MethodName: "GetMerchant",
+			Handler:    _Query_GetMerchant_Handler,
+		},
+		{
+			MethodName: "ListMerchant",
+			Handler:    _Query_ListMerchant_Handler,
+		},
+		{
+			MethodName: "Supply",
+			Handler:    _Query_Supply_Handler,
+		},
+		{
+			MethodName: "VelocityHeadroom",
+			Handler:    _Query_VelocityHeadroom_Handler,
+		},
+		{
+			MethodName: "GetFrozenAccount",
+			Handler:    _Query_GetFrozenAccount_Handler,
+		},
+		{
+			MethodName: "ListFrozenAccount",
+			Handler:    _Query_ListFrozenAccount_Handler,
+		},
+		{
+			MethodName: "GetPointGrant",
+			Handler:    _Query_GetPointGrant_Handler,
+		},
+		{
+			MethodName: "ListActiveGrants",
+			Handler:    _Query_ListActiveGrants_Handler,
+		},
+		{
+			MethodName: "GetSettlementBatch",
+			Handler:    _Query_GetSettlementBatch_Handler,
+		},
+		{
+			MethodName: "SettlementBatchesByStatus",
+			Handler:    _Query_SettlementBatchesByStatus_Handler,
+		},
+		{
+			MethodName: "TreasuryBalance",
+			Handler:    _Query_TreasuryBalance_Handler,
+		},
+		{
+			MethodName: "GetBalanceSnapshot",
+			Handler:    _Query_GetBalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "ListBalanceSnapshot",
+			Handler:    _Query_ListBalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "BalanceAt",
+			Handler:    _Query_BalanceAt_Handler,
+		},
+		{
+			MethodName: "Statement",
+			Handler:    _Query_Statement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "scontract/points/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPointBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPointBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPointBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPointBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPointBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPointBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PointBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPointBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPointBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPointBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPointBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPointBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPointBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PointBalance) > 0 {
+		for iNdEx := len(m.PointBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PointBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Transaction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderReference) > 0 {
+		i -= len(m.OrderReference)
+		copy(dAtA[i:], m.OrderReference)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderReference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transaction) > 0 {
+		for iNdEx := len(m.Transaction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transaction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSettlementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSettlementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSettlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSettlementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSettlementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSettlementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSettlementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSettlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSettlementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSettlementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Settlement) > 0 {
+		for iNdEx := len(m.Settlement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIssuerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIssuerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIssuerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Issuer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIssuerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIssuerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIssuerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		for iNdEx := len(m.Issuer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Issuer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuerAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuerAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuerAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuerAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuerAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuerAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Issued != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Issued))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransactionsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransactionsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransactionsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransactionsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransactionsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransactionsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transaction) > 0 {
+		for iNdEx := len(m.Transaction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transaction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementsByRequesterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementsByRequesterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementsByRequesterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementsByRequesterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementsByRequesterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementsByRequesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Settlement) > 0 {
+		for iNdEx := len(m.Settlement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Settlement) > 0 {
+		for iNdEx := len(m.Settlement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PendingCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingExpirationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingExpirationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingExpirationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Until != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Until))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingExpirationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingExpirationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingExpirationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lots) > 0 {
+		for iNdEx := len(m.Lots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProgramRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProgramRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProgramRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Program.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProgramRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProgramRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProgramRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Program) > 0 {
+		for iNdEx := len(m.Program) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Program[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMerchantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMerchantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMerchantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMerchantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Merchant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMerchantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMerchantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMerchantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMerchantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchant) > 0 {
+		for iNdEx := len(m.Merchant) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Merchant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Circulating != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Circulating))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVelocityHeadroomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVelocityHeadroomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVelocityHeadroomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VelocityHeadroom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VelocityHeadroom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VelocityHeadroom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Used != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVelocityHeadroomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVelocityHeadroomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVelocityHeadroomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DailyIssue != nil {
+		{
+			size, err := m.DailyIssue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.WeeklyTransfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.DailyTransfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.WeeklySpend.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.DailySpend.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Overridden {
+		i--
+		if m.Overridden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFrozenAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFrozenAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFrozenAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFrozenAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFrozenAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFrozenAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FrozenAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFrozenAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFrozenAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFrozenAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFrozenAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFrozenAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFrozenAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FrozenAccount) > 0 {
+		for iNdEx := len(m.FrozenAccount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPointGrantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPointGrantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPointGrantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPointGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPointGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPointGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PointGrant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PointGrant) > 0 {
+		for iNdEx := len(m.PointGrant) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PointGrant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSettlementBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSettlementBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSettlementBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSettlementBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSettlementBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSettlementBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SettlementBatch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementBatchesByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementBatchesByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementBatchesByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementBatchesByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementBatchesByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementBatchesByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SettlementBatch) > 0 {
+		for iNdEx := len(m.SettlementBatch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementBatch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBalanceSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBalanceSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBalanceSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBalanceSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBalanceSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBalanceSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BalanceSnapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBalanceSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBalanceSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBalanceSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBalanceSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBalanceSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBalanceSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BalanceSnapshot) > 0 {
+		for iNdEx := len(m.BalanceSnapshot) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceSnapshot[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalanceAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SnapshotId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalanceAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromSnapshot {
+		i--
+		if m.FromSnapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Balance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.To != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.From != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ClosingBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClosingBalance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SettlementRefunded != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SettlementRefunded))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SettlementRequested != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SettlementRequested))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Totals) > 0 {
+		for iNdEx := len(m.Totals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Totals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Lines) > 0 {
+		for iNdEx := len(m.Lines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.OpeningBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OpeningBalance))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatementLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatementLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatementLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Debit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Debit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Credit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Credit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Transaction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StatementTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatementTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatementTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Debit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Debit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Credit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Credit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPointBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPointBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PointBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPointBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPointBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PointBalance) > 0 {
+		for _, e := range m.PointBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transaction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderReference)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transaction) > 0 {
+		for _, e := range m.Transaction {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSettlementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSettlementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Settlement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSettlementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSettlementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Settlement) > 0 {
+		for _, e := range m.Settlement {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetIssuerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Issuer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllIssuerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		for _, e := range m.Issuer {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuerAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuerAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.EpochCap != 0 {
+		n += 1 + sovQuery(uint64(m.EpochCap))
+	}
+	if m.Issued != 0 {
+		n += 1 + sovQuery(uint64(m.Issued))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	if m.Unlimited {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTransactionsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	if m.TxType != 0 {
+		n += 1 + sovQuery(uint64(m.TxType))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransactionsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transaction) > 0 {
+		for _, e := range m.Transaction {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySettlementsByRequesterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySettlementsByRequesterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Settlement) > 0 {
+		for _, e := range m.Settlement {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySettlementsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySettlementsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Settlement) > 0 {
+		for _, e := range m.Settlement {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySettlementSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySettlementSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingCount != 0 {
+		n += 1 + sovQuery(uint64(m.PendingCount))
+	}
+	if m.PendingAmount != 0 {
+		n += 1 + sovQuery(uint64(m.PendingAmount))
+	}
+	return n
+}
+
+func (m *QueryUpcomingExpirationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Until != 0 {
+		n += 1 + sovQuery(uint64(m.Until))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpcomingExpirationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lots) > 0 {
+		for _, e := range m.Lots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *QueryGetProgramRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Program.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProgramRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Program) > 0 {
+		for _, e := range m.Program {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMerchantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetMerchantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Merchant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMerchantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMerchantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Merchant) > 0 {
+		for _, e := range m.Merchant {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Circulating != 0 {
+		n += 1 + sovQuery(uint64(m.Circulating))
+	}
+	return n
+}
+
+func (m *QueryVelocityHeadroomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VelocityHeadroom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.Used != 0 {
+		n += 1 + sovQuery(uint64(m.Used))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	if m.Unlimited {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryVelocityHeadroomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Overridden {
+		n += 2
+	}
+	l = m.DailySpend.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeeklySpend.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DailyTransfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeeklyTransfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DailyIssue != nil {
+		l = m.DailyIssue.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFrozenAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFrozenAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FrozenAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllFrozenAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFrozenAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FrozenAccount) > 0 {
+		for _, e := range m.FrozenAccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPointGrantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPointGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PointGrant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActiveGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PointGrant) > 0 {
+		for _, e := range m.PointGrant {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSettlementBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetSettlementBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SettlementBatch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySettlementBatchesByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySettlementBatchesByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SettlementBatch) > 0 {
+		for _, e := range m.SettlementBatch {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovQuery(uint64(m.Balance))
+	}
+	return n
+}
+
+func (m *QueryGetBalanceSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetBalanceSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BalanceSnapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBalanceSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalanceSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BalanceSnapshot) > 0 {
+		for _, e := range m.BalanceSnapshot {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SnapshotId != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotId))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryBalanceAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		n += 1 + sovQuery(uint64(m.Balance))
+	}
+	if m.FromSnapshot {
+		n += 2
+	}
+	if m.SnapshotId != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotId))
+	}
+	return n
+}
+
+func (m *QueryStatementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovQuery(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovQuery(uint64(m.To))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OpeningBalance != 0 {
+		n += 1 + sovQuery(uint64(m.OpeningBalance))
+	}
+	if len(m.Lines) > 0 {
+		for _, e := range m.Lines {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Totals) > 0 {
+		for _, e := range m.Totals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SettlementRequested != 0 {
+		n += 1 + sovQuery(uint64(m.SettlementRequested))
+	}
+	if m.SettlementRefunded != 0 {
+		n += 1 + sovQuery(uint64(m.SettlementRefunded))
+	}
+	if m.ClosingBalance != 0 {
+		n += 1 + sovQuery(uint64(m.ClosingBalance))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StatementLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transaction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Credit != 0 {
+		n += 1 + sovQuery(uint64(m.Credit))
+	}
+	if m.Debit != 0 {
+		n += 1 + sovQuery(uint64(m.Debit))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovQuery(uint64(m.Balance))
+	}
+	return n
+}
+
+func (m *StatementTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovQuery(uint64(m.TxType))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.Credit != 0 {
+		n += 1 + sovQuery(uint64(m.Credit))
+	}
+	if m.Debit != 0 {
+		n += 1 + sovQuery(uint64(m.Debit))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPointBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPointBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPointBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPointBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPointBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPointBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PointBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPointBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPointBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPointBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPointBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPointBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPointBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointBalance = append(m.PointBalance, PointBalance{})
+			if err := m.PointBalance[len(m.PointBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transaction = append(m.Transaction, Transaction{})
+			if err := m.Transaction[len(m.Transaction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSettlementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSettlementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSettlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSettlementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSettlementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Settlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSettlementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSettlementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSettlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSettlementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSettlementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlement = append(m.Settlement, Settlement{})
+			if err := m.Settlement[len(m.Settlement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetIssuerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetIssuerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetIssuerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Issuer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIssuerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIssuerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIssuerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = append(m.Issuer, Issuer{})
+			if err := m.Issuer[len(m.Issuer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIssuerAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuerAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuerAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIssuerAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuerAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuerAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCap", wireType)
+			}
+			m.EpochCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+			m.Issued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Issued |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unlimited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransactionsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransactionsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransactionsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= TransactionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= TransactionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransactionsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransactionsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransactionsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transaction = append(m.Transaction, Transaction{})
+			if err := m.Transaction[len(m.Transaction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementsByRequesterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementsByRequesterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementsByRequesterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementsByRequesterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementsByRequesterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementsByRequesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlement = append(m.Settlement, Settlement{})
+			if err := m.Settlement[len(m.Settlement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementsByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementsByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SettlementStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementsByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementsByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlement = append(m.Settlement, Settlement{})
+			if err := m.Settlement[len(m.Settlement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCount", wireType)
+			}
+			m.PendingCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAmount", wireType)
+			}
+			m.PendingAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingExpirationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingExpirationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingExpirationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingExpirationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingExpirationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingExpirationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lots = append(m.Lots, PointLot{})
+			if err := m.Lots[len(m.Lots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProgramRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProgramRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProgramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Program.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProgramRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProgramRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProgramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Program = append(m.Program, PointProgram{})
+			if err := m.Program[len(m.Program)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMerchantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMerchantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMerchantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMerchantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMerchantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Merchant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMerchantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMerchantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMerchantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMerchantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMerchantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = append(m.Merchant, Merchant{})
+			if err := m.Merchant[len(m.Merchant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Circulating", wireType)
+			}
+			m.Circulating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Circulating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVelocityHeadroomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVelocityHeadroomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVelocityHeadroomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VelocityHeadroom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VelocityHeadroom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VelocityHeadroom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unlimited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVelocityHeadroomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVelocityHeadroomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVelocityHeadroomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overridden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overridden = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailySpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklySpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeeklySpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklyTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeeklyTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyIssue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DailyIssue == nil {
+				m.DailyIssue = &VelocityHeadroom{}
+			}
+			if err := m.DailyIssue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetFrozenAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetFrozenAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetFrozenAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetFrozenAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetFrozenAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetFrozenAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllFrozenAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllFrozenAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllFrozenAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllFrozenAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllFrozenAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllFrozenAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccount = append(m.FrozenAccount, FrozenAccount{})
+			if err := m.FrozenAccount[len(m.FrozenAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPointGrantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPointGrantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPointGrantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPointGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPointGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPointGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointGrant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PointGrant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointGrant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointGrant = append(m.PointGrant, PointGrant{})
+			if err := m.PointGrant[len(m.PointGrant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSettlementBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSettlementBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSettlementBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSettlementBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSettlementBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSettlementBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlements = append(m.Settlements, Settlement{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementBatchesByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementBatchesByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementBatchesByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SettlementBatchStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementBatchesByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementBatchesByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementBatchesByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementBatch = append(m.SettlementBatch, SettlementBatch{})
+			if err := m.SettlementBatch[len(m.SettlementBatch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetSettlementRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	return n
-}
-
-func (m *QueryGetSettlementResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Settlement.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryAllSettlementRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryAllSettlementResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Settlement) > 0 {
-		for _, e := range m.Settlement {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1780,12 +15435,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1807,7 +15494,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1830,17 +15517,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1850,25 +15537,43 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1890,7 +15595,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPointBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBalanceSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1913,17 +15618,17 @@ func (m *QueryGetPointBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPointBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBalanceSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPointBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBalanceSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1933,24 +15638,11 @@ func (m *QueryGetPointBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1972,7 +15664,7 @@ func (m *QueryGetPointBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPointBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBalanceSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1995,15 +15687,15 @@ func (m *QueryGetPointBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPointBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBalanceSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPointBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBalanceSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PointBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceSnapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2030,7 +15722,7 @@ func (m *QueryGetPointBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PointBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BalanceSnapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2055,7 +15747,7 @@ func (m *QueryGetPointBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPointBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBalanceSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2078,10 +15770,10 @@ func (m *QueryAllPointBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPointBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBalanceSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPointBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBalanceSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2141,7 +15833,7 @@ func (m *QueryAllPointBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPointBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBalanceSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2164,15 +15856,15 @@ func (m *QueryAllPointBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPointBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBalanceSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPointBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBalanceSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PointBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceSnapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2199,8 +15891,8 @@ func (m *QueryAllPointBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PointBalance = append(m.PointBalance, PointBalance{})
-			if err := m.PointBalance[len(m.PointBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BalanceSnapshot = append(m.BalanceSnapshot, BalanceSnapshot{})
+			if err := m.BalanceSnapshot[len(m.BalanceSnapshot)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2261,7 +15953,7 @@ func (m *QueryAllPointBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceAtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2284,17 +15976,100 @@ func (m *QueryGetTransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceAtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Id = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2304,7 +16079,7 @@ func (m *QueryGetTransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2330,7 +16105,7 @@ func (m *QueryGetTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceAtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2353,17 +16128,17 @@ func (m *QueryGetTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceAtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			m.Balance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2373,25 +16148,50 @@ func (m *QueryGetTransactionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Balance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSnapshot", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FromSnapshot = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
 			}
-			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2413,7 +16213,7 @@ func (m *QueryGetTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStatementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2436,13 +16236,115 @@ func (m *QueryAllTransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatementRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2499,7 +16401,7 @@ func (m *QueryAllTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStatementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2522,15 +16424,68 @@ func (m *QueryAllTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpeningBalance", wireType)
+			}
+			m.OpeningBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpeningBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lines = append(m.Lines, StatementLine{})
+			if err := m.Lines[len(m.Lines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2542,27 +16497,84 @@ func (m *QueryAllTransactionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Totals = append(m.Totals, StatementTotal{})
+			if err := m.Totals[len(m.Totals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRequested", wireType)
+			}
+			m.SettlementRequested = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementRequested |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRefunded", wireType)
+			}
+			m.SettlementRefunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementRefunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingBalance", wireType)
+			}
+			m.ClosingBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosingBalance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Transaction = append(m.Transaction, Transaction{})
-			if err := m.Transaction[len(m.Transaction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2619,7 +16631,7 @@ func (m *QueryAllTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSettlementRequest) Unmarshal(dAtA []byte) error {
+func (m *StatementLine) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2642,17 +16654,17 @@ func (m *QueryGetSettlementRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSettlementRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatementLine: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSettlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatementLine: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2662,66 +16674,30 @@ func (m *QueryGetSettlementRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetSettlementResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSettlementResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
 			}
-			var msglen int
+			m.Credit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2731,80 +16707,16 @@ func (m *QueryGetSettlementResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Credit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Settlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllSettlementRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSettlementRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSettlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debit", wireType)
 			}
-			var msglen int
+			m.Debit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2814,28 +16726,30 @@ func (m *QueryAllSettlementRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Debit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2857,7 +16771,7 @@ func (m *QueryAllSettlementRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSettlementResponse) Unmarshal(dAtA []byte) error {
+func (m *StatementTotal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2880,17 +16794,17 @@ func (m *QueryAllSettlementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSettlementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatementTotal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatementTotal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
 			}
-			var msglen int
+			m.TxType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2900,31 +16814,16 @@ func (m *QueryAllSettlementResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TxType |= TransactionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Settlement = append(m.Settlement, Settlement{})
-			if err := m.Settlement[len(m.Settlement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2934,28 +16833,49 @@ func (m *QueryAllSettlementResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Credit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Credit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debit", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Debit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Debit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
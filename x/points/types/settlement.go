@@ -0,0 +1,28 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// IsValid reports whether status is a known settlement status.
+func (s SettlementStatus) IsValid() bool {
+	_, ok := SettlementStatus_name[int32(s)]
+	return ok && s != SettlementStatusUnspecified
+}
+
+// settlementTransitions lists the statuses a settlement may move to from each status.
+// Statuses without an entry are final.
+var settlementTransitions = map[SettlementStatus][]SettlementStatus{
+	SettlementStatusPending:  {SettlementStatusApproved, SettlementStatusRejected, SettlementStatusCancelled, SettlementStatusBatched},
+	SettlementStatusApproved: {SettlementStatusPaid},
+	SettlementStatusBatched:  {SettlementStatusPaid},
+}
+
+// ValidateSettlementTransition returns an error if a settlement may not move
+// from status from to status to.
+func ValidateSettlementTransition(from, to SettlementStatus) error {
+	for _, allowed := range settlementTransitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(ErrInvalidSettlementTransition, "%s -> %s", from, to)
+}
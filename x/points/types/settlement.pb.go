@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,13 +24,72 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SettlementStatus is the state of a settlement. Pending settlements may be
+// approved, rejected, cancelled or batched; approved and batched settlements
+// may be paid.
+type SettlementStatus int32
+
+const (
+	SettlementStatusUnspecified SettlementStatus = 0
+	SettlementStatusPending     SettlementStatus = 1
+	SettlementStatusApproved    SettlementStatus = 2
+	SettlementStatusRejected    SettlementStatus = 3
+	SettlementStatusCancelled   SettlementStatus = 4
+	SettlementStatusPaid        SettlementStatus = 5
+	SettlementStatusBatched     SettlementStatus = 6
+)
+
+var SettlementStatus_name = map[int32]string{
+	0: "SETTLEMENT_STATUS_UNSPECIFIED",
+	1: "SETTLEMENT_STATUS_PENDING",
+	2: "SETTLEMENT_STATUS_APPROVED",
+	3: "SETTLEMENT_STATUS_REJECTED",
+	4: "SETTLEMENT_STATUS_CANCELLED",
+	5: "SETTLEMENT_STATUS_PAID",
+	6: "SETTLEMENT_STATUS_BATCHED",
+}
+
+var SettlementStatus_value = map[string]int32{
+	"SETTLEMENT_STATUS_UNSPECIFIED": 0,
+	"SETTLEMENT_STATUS_PENDING":     1,
+	"SETTLEMENT_STATUS_APPROVED":    2,
+	"SETTLEMENT_STATUS_REJECTED":    3,
+	"SETTLEMENT_STATUS_CANCELLED":   4,
+	"SETTLEMENT_STATUS_PAID":        5,
+	"SETTLEMENT_STATUS_BATCHED":     6,
+}
+
+func (x SettlementStatus) String() string {
+	return proto.EnumName(SettlementStatus_name, int32(x))
+}
+
+func (SettlementStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c581354720698f76, []int{0}
+}
+
 // Settlement defines the Settlement message.
 type Settlement struct {
 	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Requester string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
 	Amount    uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// legacy_status is the free-form status settlements were recorded with
+	// before version 3 of the store. It is only read by store migrations.
+	LegacyStatus string `protobuf:"bytes,4,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"` // Deprecated: Do not use.
+	Timestamp    int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// decided_by is the account that moved the settlement out of pending.
+	DecidedBy string `protobuf:"bytes,6,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	// decided_at is the block time, in unix seconds, of that decision.
+	DecidedAt int64 `protobuf:"varint,7,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	// rejection_reason is the reason given when the settlement was rejected.
+	RejectionReason string `protobuf:"bytes,8,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	// payout is the amount paid out from the treasury. It is only set once the
+	// settlement is paid.
+	Payout *types.Coin `protobuf:"bytes,9,opt,name=payout,proto3" json:"payout,omitempty"`
+	// escrowed_lots are the point lots taken from the requester, restored if
+	// the settlement is rejected or cancelled.
+	EscrowedLots []PointLot       `protobuf:"bytes,10,rep,name=escrowed_lots,json=escrowedLots,proto3" json:"escrowed_lots"`
+	ProgramId    string           `protobuf:"bytes,11,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Status       SettlementStatus `protobuf:"varint,12,opt,name=status,proto3,enum=scontract.points.v1.SettlementStatus" json:"status,omitempty"`
 }
 
 func (m *Settlement) Reset()         { *m = Settlement{} }
@@ -85,9 +146,10 @@ func (m *Settlement) GetAmount() uint64 {
 	return 0
 }
 
-func (m *Settlement) GetStatus() string {
+// Deprecated: Do not use.
+func (m *Settlement) GetLegacyStatus() string {
 	if m != nil {
-		return m.Status
+		return m.LegacyStatus
 	}
 	return ""
 }
@@ -99,7 +161,57 @@ func (m *Settlement) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *Settlement) GetDecidedBy() string {
+	if m != nil {
+		return m.DecidedBy
+	}
+	return ""
+}
+
+func (m *Settlement) GetDecidedAt() int64 {
+	if m != nil {
+		return m.DecidedAt
+	}
+	return 0
+}
+
+func (m *Settlement) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
+func (m *Settlement) GetPayout() *types.Coin {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
+func (m *Settlement) GetEscrowedLots() []PointLot {
+	if m != nil {
+		return m.EscrowedLots
+	}
+	return nil
+}
+
+func (m *Settlement) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *Settlement) GetStatus() SettlementStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SettlementStatusUnspecified
+}
+
 func init() {
+	proto.RegisterEnum("scontract.points.v1.SettlementStatus", SettlementStatus_name, SettlementStatus_value)
 	proto.RegisterType((*Settlement)(nil), "scontract.points.v1.Settlement")
 }
 
@@ -108,21 +220,48 @@ func init() {
 }
 
 var fileDescriptor_c581354720698f76 = []byte{
-	// 213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x4e, 0xce, 0xcf,
-	0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0xd1, 0x2f, 0xc8, 0xcf, 0xcc, 0x2b, 0x29, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0x4e, 0x2d, 0x29, 0xc9, 0x49, 0xcd, 0x4d, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x86, 0xab, 0xd2, 0x83, 0xa8, 0xd2, 0x2b, 0x33, 0x54, 0xea, 0x60, 0xe4, 0xe2, 0x0a,
-	0x86, 0xab, 0x14, 0xe2, 0xe3, 0x62, 0xca, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0x62,
-	0xca, 0x4c, 0x11, 0x92, 0xe1, 0xe2, 0x2c, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x49, 0x2d, 0x92,
-	0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c, 0x42, 0x08, 0x08, 0x89, 0x71, 0xb1, 0x25, 0xe6, 0xe6, 0x97,
-	0xe6, 0x95, 0x48, 0x30, 0x83, 0x75, 0x40, 0x79, 0x20, 0xf1, 0xe2, 0x92, 0xc4, 0x92, 0xd2, 0x62,
-	0x09, 0x16, 0xb0, 0x16, 0x28, 0x0f, 0x64, 0x5a, 0x49, 0x66, 0x6e, 0x6a, 0x71, 0x49, 0x62, 0x6e,
-	0x81, 0x04, 0xab, 0x02, 0xa3, 0x06, 0x73, 0x10, 0x42, 0xc0, 0xc9, 0xe8, 0xc4, 0x23, 0x39, 0xc6,
-	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
-	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x24, 0x10, 0xfe, 0xab, 0x80, 0xf9, 0xb0, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0xec, 0x35, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x01, 0x5f,
-	0x32, 0x02, 0x01, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc7, 0xe3, 0x24, 0xcd, 0xbd, 0x9d, 0x7e, 0x5c, 0x6b, 0x6e, 0x55, 0xa6, 0x6e, 0xe3, 0x5a,
+	0x7c, 0x88, 0xc0, 0xc2, 0x51, 0x02, 0x2b, 0x04, 0x48, 0x49, 0x6c, 0x68, 0x50, 0x08, 0x91, 0xe3,
+	0xb2, 0x60, 0x63, 0x4d, 0x3c, 0x43, 0x30, 0x4a, 0x3c, 0xc6, 0x33, 0x09, 0xe4, 0x0d, 0x50, 0x56,
+	0xbc, 0x40, 0x56, 0xbc, 0x0b, 0xea, 0xb2, 0x4b, 0x36, 0x20, 0xd4, 0xbe, 0x08, 0xf2, 0x47, 0x1b,
+	0x94, 0x98, 0xdd, 0xf1, 0x7f, 0xce, 0xef, 0x1c, 0x9f, 0xff, 0xd1, 0x01, 0xb7, 0xb9, 0xcb, 0x7c,
+	0x11, 0x62, 0x57, 0x54, 0x03, 0xe6, 0xf9, 0x82, 0x57, 0xa7, 0xb5, 0x2a, 0xa7, 0x42, 0x8c, 0xe8,
+	0x98, 0xfa, 0x42, 0x0f, 0x42, 0x26, 0x18, 0xfc, 0xff, 0x3a, 0x4b, 0x4f, 0xb2, 0xf4, 0x69, 0x4d,
+	0x51, 0x5d, 0xc6, 0xc7, 0x8c, 0x57, 0x07, 0x98, 0xd3, 0xea, 0xb4, 0x36, 0xa0, 0x02, 0xd7, 0xaa,
+	0x2e, 0xf3, 0xfc, 0x04, 0x52, 0xf6, 0x86, 0x6c, 0xc8, 0xe2, 0xb0, 0x1a, 0x45, 0xa9, 0x7a, 0x2b,
+	0xab, 0x61, 0x1c, 0x39, 0x23, 0x96, 0xf6, 0xbb, 0xf9, 0xa3, 0x00, 0x40, 0xff, 0xfa, 0x27, 0xe0,
+	0x2e, 0xc8, 0x7b, 0x04, 0x49, 0x9a, 0x54, 0x29, 0x5a, 0x79, 0x8f, 0xc0, 0x23, 0xb0, 0x19, 0xd2,
+	0x0f, 0x13, 0xca, 0x05, 0x0d, 0x51, 0x5e, 0x93, 0x2a, 0x9b, 0xd6, 0x52, 0x80, 0xfb, 0xa0, 0x84,
+	0xc7, 0x6c, 0xe2, 0x0b, 0x54, 0x88, 0x89, 0xf4, 0x0b, 0xde, 0x05, 0x3b, 0x23, 0x3a, 0xc4, 0xee,
+	0xcc, 0xe1, 0x02, 0x8b, 0x09, 0x47, 0xc5, 0x88, 0x6c, 0xe6, 0x91, 0x64, 0x6d, 0x27, 0x0f, 0xfd,
+	0x58, 0x8f, 0xca, 0x0b, 0x6f, 0x4c, 0xb9, 0xc0, 0xe3, 0x00, 0x6d, 0x68, 0x52, 0xa5, 0x60, 0x2d,
+	0x05, 0x58, 0x06, 0x80, 0x50, 0xd7, 0x23, 0x94, 0x38, 0x83, 0x19, 0x2a, 0x25, 0xdd, 0x53, 0xa5,
+	0x39, 0xfb, 0xf3, 0x19, 0x0b, 0xf4, 0x4f, 0x42, 0xa7, 0x4a, 0x43, 0xc0, 0x7b, 0x40, 0x0e, 0xe9,
+	0x7b, 0xea, 0x0a, 0x8f, 0xf9, 0x4e, 0x48, 0x31, 0x67, 0x3e, 0xfa, 0x37, 0xae, 0xf1, 0xdf, 0xb5,
+	0x6e, 0xc5, 0x32, 0xac, 0x81, 0x52, 0x80, 0x67, 0x6c, 0x22, 0xd0, 0xa6, 0x26, 0x55, 0xb6, 0xea,
+	0x07, 0x7a, 0x62, 0xb8, 0x1e, 0x19, 0xae, 0xa7, 0x86, 0xeb, 0x2d, 0xe6, 0xf9, 0x56, 0x9a, 0x08,
+	0x4f, 0xc0, 0x0e, 0xe5, 0x6e, 0xc8, 0x3e, 0x52, 0x12, 0xb9, 0xc9, 0x11, 0xd0, 0x0a, 0x95, 0xad,
+	0x7a, 0x59, 0xcf, 0xd8, 0x9f, 0xde, 0x8b, 0xa2, 0x0e, 0x13, 0xcd, 0xe2, 0xd9, 0xcf, 0xe3, 0x9c,
+	0xb5, 0x7d, 0x45, 0x76, 0x98, 0xe0, 0xd1, 0x18, 0x41, 0xc8, 0x86, 0x21, 0x1e, 0x3b, 0x1e, 0x41,
+	0x5b, 0xc9, 0x94, 0xa9, 0xd2, 0x26, 0xf0, 0x09, 0x28, 0xa5, 0x26, 0x6e, 0x6b, 0x52, 0x65, 0xb7,
+	0x7e, 0x27, 0xb3, 0xc3, 0x72, 0x85, 0x89, 0xb3, 0x56, 0x0a, 0xdd, 0xff, 0x56, 0x00, 0xf2, 0xea,
+	0x23, 0x6c, 0x82, 0x72, 0xdf, 0xb4, 0xed, 0x8e, 0xf9, 0xd2, 0xec, 0xda, 0x4e, 0xdf, 0x6e, 0xd8,
+	0xa7, 0x7d, 0xe7, 0xb4, 0xdb, 0xef, 0x99, 0xad, 0xf6, 0xb3, 0xb6, 0x69, 0xc8, 0x39, 0xe5, 0x78,
+	0xbe, 0xd0, 0x0e, 0x57, 0xc1, 0x53, 0x9f, 0x07, 0xd4, 0xf5, 0xde, 0x7a, 0x94, 0xc0, 0x47, 0xe0,
+	0x60, 0xbd, 0x46, 0xcf, 0xec, 0x1a, 0xed, 0xee, 0x73, 0x59, 0x52, 0x0e, 0xe7, 0x0b, 0xed, 0xc6,
+	0x2a, 0xdf, 0xa3, 0x3e, 0xf1, 0xfc, 0x21, 0x7c, 0x0c, 0x94, 0x75, 0xb6, 0xd1, 0xeb, 0x59, 0xaf,
+	0x5e, 0x9b, 0x86, 0x9c, 0x57, 0x8e, 0xe6, 0x0b, 0x0d, 0xad, 0xc2, 0x8d, 0x20, 0x08, 0xd9, 0x94,
+	0x92, 0x6c, 0xda, 0x32, 0x5f, 0x98, 0x2d, 0xdb, 0x34, 0xe4, 0x42, 0x36, 0x6d, 0xc5, 0x2b, 0xa7,
+	0x04, 0x3e, 0x05, 0x87, 0xeb, 0x74, 0xab, 0xd1, 0x6d, 0x99, 0x9d, 0x8e, 0x69, 0xc8, 0x45, 0xa5,
+	0x3c, 0x5f, 0x68, 0x07, 0xab, 0x78, 0x0b, 0xfb, 0x2e, 0x1d, 0x8d, 0x28, 0x81, 0x0f, 0xc1, 0x7e,
+	0xc6, 0xdc, 0x8d, 0xb6, 0x21, 0x6f, 0x28, 0x68, 0xbe, 0xd0, 0xf6, 0xd6, 0x86, 0xc6, 0xde, 0x5f,
+	0xdc, 0x6a, 0x36, 0xec, 0xd6, 0x89, 0x69, 0xc8, 0xa5, 0x6c, 0xb7, 0x9a, 0x58, 0xb8, 0xef, 0x28,
+	0x51, 0x8a, 0x9f, 0xbf, 0xaa, 0xb9, 0x66, 0xfd, 0xec, 0x42, 0x95, 0xce, 0x2f, 0x54, 0xe9, 0xd7,
+	0x85, 0x2a, 0x7d, 0xb9, 0x54, 0x73, 0xe7, 0x97, 0x6a, 0xee, 0xfb, 0xa5, 0x9a, 0x7b, 0x83, 0x96,
+	0x77, 0xfe, 0xe9, 0xea, 0xd2, 0xc5, 0x2c, 0xa0, 0x7c, 0x50, 0x8a, 0x6f, 0xfc, 0xc1, 0xef, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xd4, 0xc3, 0xb4, 0xed, 0x7b, 0x04, 0x00, 0x00,
 }
 
 func (m *Settlement) Marshal() (dAtA []byte, err error) {
@@ -145,15 +284,72 @@ func (m *Settlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.EscrowedLots) > 0 {
+		for iNdEx := len(m.EscrowedLots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedLots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Payout != nil {
+		{
+			size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettlement(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RejectionReason) > 0 {
+		i -= len(m.RejectionReason)
+		copy(dAtA[i:], m.RejectionReason)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.RejectionReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DecidedAt != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.DecidedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DecidedBy) > 0 {
+		i -= len(m.DecidedBy)
+		copy(dAtA[i:], m.DecidedBy)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.DecidedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintSettlement(dAtA, i, uint64(m.Timestamp))
 		i--
 		dAtA[i] = 0x28
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+	if len(m.LegacyStatus) > 0 {
+		i -= len(m.LegacyStatus)
+		copy(dAtA[i:], m.LegacyStatus)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.LegacyStatus)))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -204,13 +400,41 @@ func (m *Settlement) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovSettlement(uint64(m.Amount))
 	}
-	l = len(m.Status)
+	l = len(m.LegacyStatus)
 	if l > 0 {
 		n += 1 + l + sovSettlement(uint64(l))
 	}
 	if m.Timestamp != 0 {
 		n += 1 + sovSettlement(uint64(m.Timestamp))
 	}
+	l = len(m.DecidedBy)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.DecidedAt != 0 {
+		n += 1 + sovSettlement(uint64(m.DecidedAt))
+	}
+	l = len(m.RejectionReason)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.Payout != nil {
+		l = m.Payout.Size()
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if len(m.EscrowedLots) > 0 {
+		for _, e := range m.EscrowedLots {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSettlement(uint64(m.Status))
+	}
 	return n
 }
 
@@ -321,7 +545,7 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -349,7 +573,7 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.LegacyStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
@@ -370,6 +594,210 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecidedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecidedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecidedAt", wireType)
+			}
+			m.DecidedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecidedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payout == nil {
+				m.Payout = &types.Coin{}
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedLots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedLots = append(m.EscrowedLots, PointLot{})
+			if err := m.EscrowedLots[len(m.EscrowedLots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SettlementStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettlement(dAtA[iNdEx:])
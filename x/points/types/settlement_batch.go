@@ -0,0 +1,49 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// IsValid reports whether status is a known settlement batch status.
+func (s SettlementBatchStatus) IsValid() bool {
+	_, ok := SettlementBatchStatus_name[int32(s)]
+	return ok && s != SettlementBatchStatusUnspecified
+}
+
+// SettlementBatchRoot returns the RFC 6962 Merkle root of settlement ids,
+// each leaf being the 8 big-endian bytes of an id. A payment processor
+// recomputes it from the batched settlements it pays out.
+func SettlementBatchRoot(ids []uint64) []byte {
+	leaves := make([][]byte, len(ids))
+	for i, id := range ids {
+		leaves[i] = binary.BigEndian.AppendUint64(nil, id)
+	}
+	return merkle.HashFromByteSlices(leaves)
+}
+
+// Validate performs basic validation of the batch. The settlement ids must
+// be in ascending order and match the Merkle root.
+func (b SettlementBatch) Validate() error {
+	if b.ProgramId == "" || b.Requester == "" {
+		return errorsmod.Wrap(ErrInvalidSettlementBatch, "program id and requester cannot be empty")
+	}
+	if len(b.SettlementIds) == 0 {
+		return errorsmod.Wrapf(ErrInvalidSettlementBatch, "batch %d has no settlements", b.Id)
+	}
+	for i := 1; i < len(b.SettlementIds); i++ {
+		if b.SettlementIds[i] <= b.SettlementIds[i-1] {
+			return errorsmod.Wrapf(ErrInvalidSettlementBatch, "batch %d settlement ids are not in ascending order", b.Id)
+		}
+	}
+	if !bytes.Equal(b.MerkleRoot, SettlementBatchRoot(b.SettlementIds)) {
+		return errorsmod.Wrapf(ErrInvalidSettlementBatch, "batch %d merkle root does not match its settlements", b.Id)
+	}
+	if !b.Status.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidSettlementBatch, "invalid status %s", b.Status)
+	}
+	return nil
+}
@@ -0,0 +1,961 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scontract/points/v1/settlement_batch.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SettlementBatchStatus is the state of a settlement batch. Pending batches
+// are paid once the payment processor confirms the payout.
+type SettlementBatchStatus int32
+
+const (
+	SettlementBatchStatusUnspecified SettlementBatchStatus = 0
+	SettlementBatchStatusPending     SettlementBatchStatus = 1
+	SettlementBatchStatusPaid        SettlementBatchStatus = 2
+)
+
+var SettlementBatchStatus_name = map[int32]string{
+	0: "SETTLEMENT_BATCH_STATUS_UNSPECIFIED",
+	1: "SETTLEMENT_BATCH_STATUS_PENDING",
+	2: "SETTLEMENT_BATCH_STATUS_PAID",
+}
+
+var SettlementBatchStatus_value = map[string]int32{
+	"SETTLEMENT_BATCH_STATUS_UNSPECIFIED": 0,
+	"SETTLEMENT_BATCH_STATUS_PENDING":     1,
+	"SETTLEMENT_BATCH_STATUS_PAID":        2,
+}
+
+func (x SettlementBatchStatus) String() string {
+	return proto.EnumName(SettlementBatchStatus_name, int32(x))
+}
+
+func (SettlementBatchStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ec63a160e312c76b, []int{0}
+}
+
+// SettlementBatch groups the settlements a requester had pending at the end
+// of a settlement batch epoch, so that they are paid out at once.
+type SettlementBatch struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProgramId string `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Requester string `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	// epoch_identifier and epoch_number identify the epoch whose end created
+	// the batch.
+	EpochIdentifier string `protobuf:"bytes,4,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	EpochNumber     int64  `protobuf:"varint,5,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// settlement_ids are the ids of the batched settlements, in ascending
+	// order.
+	SettlementIds []uint64 `protobuf:"varint,6,rep,packed,name=settlement_ids,json=settlementIds,proto3" json:"settlement_ids,omitempty"`
+	// total_amount is the amount of points of the batched settlements.
+	TotalAmount uint64 `protobuf:"varint,7,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// merkle_root is the RFC 6962 Merkle root of settlement_ids, each encoded
+	// as 8 big-endian bytes.
+	MerkleRoot []byte                `protobuf:"bytes,8,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	CreatedAt  int64                 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status     SettlementBatchStatus `protobuf:"varint,10,opt,name=status,proto3,enum=scontract.points.v1.SettlementBatchStatus" json:"status,omitempty"`
+	// paid_by is the settler that confirmed the payout.
+	PaidBy string `protobuf:"bytes,11,opt,name=paid_by,json=paidBy,proto3" json:"paid_by,omitempty"`
+	// paid_at is the block time, in unix seconds, the payout was confirmed.
+	PaidAt int64 `protobuf:"varint,12,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	// payment_reference identifies the payout outside of the chain, such as a
+	// bank transfer id.
+	PaymentReference string `protobuf:"bytes,13,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
+}
+
+func (m *SettlementBatch) Reset()         { *m = SettlementBatch{} }
+func (m *SettlementBatch) String() string { return proto.CompactTextString(m) }
+func (*SettlementBatch) ProtoMessage()    {}
+func (*SettlementBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ec63a160e312c76b, []int{0}
+}
+func (m *SettlementBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettlementBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementBatch.Merge(m, src)
+}
+func (m *SettlementBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementBatch proto.InternalMessageInfo
+
+func (m *SettlementBatch) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SettlementBatch) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *SettlementBatch) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *SettlementBatch) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *SettlementBatch) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *SettlementBatch) GetSettlementIds() []uint64 {
+	if m != nil {
+		return m.SettlementIds
+	}
+	return nil
+}
+
+func (m *SettlementBatch) GetTotalAmount() uint64 {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return 0
+}
+
+func (m *SettlementBatch) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *SettlementBatch) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *SettlementBatch) GetStatus() SettlementBatchStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SettlementBatchStatusUnspecified
+}
+
+func (m *SettlementBatch) GetPaidBy() string {
+	if m != nil {
+		return m.PaidBy
+	}
+	return ""
+}
+
+func (m *SettlementBatch) GetPaidAt() int64 {
+	if m != nil {
+		return m.PaidAt
+	}
+	return 0
+}
+
+func (m *SettlementBatch) GetPaymentReference() string {
+	if m != nil {
+		return m.PaymentReference
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("scontract.points.v1.SettlementBatchStatus", SettlementBatchStatus_name, SettlementBatchStatus_value)
+	proto.RegisterType((*SettlementBatch)(nil), "scontract.points.v1.SettlementBatch")
+}
+
+func init() {
+	proto.RegisterFile("scontract/points/v1/settlement_batch.proto", fileDescriptor_ec63a160e312c76b)
+}
+
+var fileDescriptor_ec63a160e312c76b = []byte{
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xdf, 0x8e, 0xd2, 0x40,
+	0x14, 0xc6, 0x29, 0x20, 0x2b, 0xc3, 0xfe, 0xc1, 0x51, 0xe3, 0x48, 0xd8, 0x6e, 0xfd, 0x97, 0x54,
+	0x4c, 0x20, 0xbb, 0x3e, 0x80, 0x29, 0x4b, 0xd5, 0x26, 0x2e, 0x21, 0x6d, 0xb9, 0xf1, 0xa6, 0x19,
+	0x3a, 0xb3, 0x6c, 0x23, 0x74, 0xea, 0xf4, 0xb0, 0x91, 0x37, 0x30, 0x5c, 0xf9, 0x02, 0x5c, 0xf9,
+	0x32, 0x5e, 0xee, 0xa5, 0xde, 0x19, 0x78, 0x05, 0x1f, 0xc0, 0x74, 0x60, 0x59, 0x63, 0xd8, 0xbb,
+	0xf6, 0xf7, 0x7d, 0xe7, 0xeb, 0x39, 0xa7, 0x39, 0xa8, 0x91, 0x86, 0x22, 0x06, 0x49, 0x43, 0x68,
+	0x25, 0x22, 0x8a, 0x21, 0x6d, 0x5d, 0x1e, 0xb7, 0x52, 0x0e, 0x30, 0xe2, 0x63, 0x1e, 0x43, 0x30,
+	0xa0, 0x10, 0x5e, 0x34, 0x13, 0x29, 0x40, 0xe0, 0xfb, 0x1b, 0x6f, 0x73, 0xe5, 0x6d, 0x5e, 0x1e,
+	0xd7, 0x1e, 0x0c, 0xc5, 0x50, 0x28, 0xbd, 0x95, 0x3d, 0xad, 0xac, 0x4f, 0x7f, 0x15, 0xd0, 0x81,
+	0xb7, 0x49, 0x69, 0x67, 0x21, 0x78, 0x1f, 0xe5, 0x23, 0x46, 0x34, 0x43, 0x33, 0x8b, 0x6e, 0x3e,
+	0x62, 0xf8, 0x10, 0xa1, 0x44, 0x8a, 0xa1, 0xa4, 0xe3, 0x20, 0x62, 0x24, 0x6f, 0x68, 0x66, 0xd9,
+	0x2d, 0xaf, 0x89, 0xc3, 0x70, 0x1d, 0x95, 0x25, 0xff, 0x3c, 0xe1, 0x29, 0x70, 0x49, 0x0a, 0x2b,
+	0x75, 0x03, 0xf0, 0x4b, 0x54, 0xe5, 0x89, 0x08, 0x2f, 0x82, 0x88, 0xf1, 0x18, 0xa2, 0xf3, 0x88,
+	0x4b, 0x52, 0x54, 0xa6, 0x03, 0xc5, 0x9d, 0x0d, 0xc6, 0x4f, 0xd0, 0xee, 0xca, 0x1a, 0x4f, 0xc6,
+	0x03, 0x2e, 0xc9, 0x1d, 0x43, 0x33, 0x0b, 0x6e, 0x45, 0xb1, 0xae, 0x42, 0xf8, 0x05, 0xda, 0xff,
+	0x67, 0xe6, 0x88, 0xa5, 0xa4, 0x64, 0x14, 0xcc, 0xa2, 0xbb, 0x77, 0x43, 0x1d, 0x96, 0x66, 0x49,
+	0x20, 0x80, 0x8e, 0x02, 0x3a, 0x16, 0x93, 0x18, 0xc8, 0x8e, 0x9a, 0xa5, 0xa2, 0x98, 0xa5, 0x10,
+	0x3e, 0x42, 0x95, 0x31, 0x97, 0x9f, 0x46, 0x3c, 0x90, 0x42, 0x00, 0xb9, 0x6b, 0x68, 0xe6, 0xae,
+	0x8b, 0x56, 0xc8, 0x15, 0x02, 0xb2, 0xa9, 0x43, 0xc9, 0x29, 0x70, 0x16, 0x50, 0x20, 0x65, 0xd5,
+	0x4b, 0x79, 0x4d, 0x2c, 0xc0, 0x6d, 0x54, 0x4a, 0x81, 0xc2, 0x24, 0x25, 0xc8, 0xd0, 0xcc, 0xfd,
+	0x93, 0x46, 0x73, 0xcb, 0xd2, 0x9b, 0xff, 0xad, 0xd6, 0x53, 0x15, 0xee, 0xba, 0x12, 0x3f, 0x42,
+	0x3b, 0x09, 0x8d, 0x58, 0x30, 0x98, 0x92, 0x8a, 0x5a, 0x49, 0x29, 0x7b, 0x6d, 0x4f, 0x37, 0x02,
+	0x05, 0xb2, 0xab, 0x3e, 0xac, 0x04, 0x0b, 0xf0, 0x2b, 0x74, 0x2f, 0xa1, 0x53, 0x35, 0xbc, 0xe4,
+	0xe7, 0x5c, 0xf2, 0x38, 0xe4, 0x64, 0x4f, 0xd5, 0x56, 0xd7, 0x82, 0x7b, 0xcd, 0x1b, 0x7f, 0x34,
+	0xf4, 0x70, 0x6b, 0x03, 0xf8, 0x0c, 0x3d, 0xf3, 0x6c, 0xdf, 0xff, 0x60, 0x9f, 0xd9, 0x5d, 0x3f,
+	0x68, 0x5b, 0xfe, 0xe9, 0xfb, 0xc0, 0xf3, 0x2d, 0xbf, 0xef, 0x05, 0xfd, 0xae, 0xd7, 0xb3, 0x4f,
+	0x9d, 0xb7, 0x8e, 0xdd, 0xa9, 0xe6, 0x6a, 0xcf, 0x67, 0x73, 0xc3, 0xd8, 0x9a, 0xd1, 0x8f, 0xd3,
+	0x84, 0x87, 0xd9, 0x8f, 0x63, 0xd8, 0x46, 0x47, 0xb7, 0xc5, 0xf5, 0xec, 0x6e, 0xc7, 0xe9, 0xbe,
+	0xab, 0x6a, 0x35, 0x63, 0x36, 0x37, 0xea, 0x5b, 0xa3, 0x7a, 0x3c, 0x66, 0x51, 0x3c, 0xc4, 0x6f,
+	0x50, 0xfd, 0xd6, 0x18, 0xcb, 0xe9, 0x54, 0xf3, 0xb5, 0xc3, 0xd9, 0xdc, 0x78, 0xbc, 0x3d, 0x83,
+	0x46, 0xac, 0x56, 0xfc, 0xfa, 0x5d, 0xcf, 0xb5, 0x4f, 0x7e, 0x2c, 0x74, 0xed, 0x6a, 0xa1, 0x6b,
+	0xbf, 0x17, 0xba, 0xf6, 0x6d, 0xa9, 0xe7, 0xae, 0x96, 0x7a, 0xee, 0xe7, 0x52, 0xcf, 0x7d, 0x24,
+	0x37, 0x37, 0xf4, 0xe5, 0xfa, 0x8a, 0x60, 0x9a, 0xf0, 0x74, 0x50, 0x52, 0xd7, 0xf0, 0xfa, 0x6f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x65, 0x6a, 0x8a, 0x66, 0x03, 0x00, 0x00,
+}
+
+func (m *SettlementBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaymentReference) > 0 {
+		i -= len(m.PaymentReference)
+		copy(dAtA[i:], m.PaymentReference)
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(len(m.PaymentReference)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PaidAt != 0 {
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(m.PaidAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PaidBy) > 0 {
+		i -= len(m.PaidBy)
+		copy(dAtA[i:], m.PaidBy)
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(len(m.PaidBy)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Status != 0 {
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TotalAmount != 0 {
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(m.TotalAmount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SettlementIds) > 0 {
+		dAtA2 := make([]byte, len(m.SettlementIds)*10)
+		var j1 int
+		for _, num := range m.SettlementIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlementBatch(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSettlementBatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSettlementBatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SettlementBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlementBatch(uint64(m.Id))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovSettlementBatch(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovSettlementBatch(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovSettlementBatch(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovSettlementBatch(uint64(m.EpochNumber))
+	}
+	if len(m.SettlementIds) > 0 {
+		l = 0
+		for _, e := range m.SettlementIds {
+			l += sovSettlementBatch(uint64(e))
+		}
+		n += 1 + sovSettlementBatch(uint64(l)) + l
+	}
+	if m.TotalAmount != 0 {
+		n += 1 + sovSettlementBatch(uint64(m.TotalAmount))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovSettlementBatch(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovSettlementBatch(uint64(m.CreatedAt))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSettlementBatch(uint64(m.Status))
+	}
+	l = len(m.PaidBy)
+	if l > 0 {
+		n += 1 + l + sovSettlementBatch(uint64(l))
+	}
+	if m.PaidAt != 0 {
+		n += 1 + sovSettlementBatch(uint64(m.PaidAt))
+	}
+	l = len(m.PaymentReference)
+	if l > 0 {
+		n += 1 + l + sovSettlementBatch(uint64(l))
+	}
+	return n
+}
+
+func sovSettlementBatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSettlementBatch(x uint64) (n int) {
+	return sovSettlementBatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SettlementBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlementBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSettlementBatch
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SettlementIds = append(m.SettlementIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSettlementBatch
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSettlementBatch
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSettlementBatch
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SettlementIds) == 0 {
+					m.SettlementIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSettlementBatch
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SettlementIds = append(m.SettlementIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementIds", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			m.TotalAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SettlementBatchStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAt", wireType)
+			}
+			m.PaidAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaidAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlementBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlementBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSettlementBatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSettlementBatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSettlementBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSettlementBatch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSettlementBatch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSettlementBatch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSettlementBatch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSettlementBatch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSettlementBatch = fmt.Errorf("proto: unexpected end of group")
+)
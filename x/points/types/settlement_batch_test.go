@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"scontract/x/points/types"
+)
+
+func TestSettlementBatchValidate(t *testing.T) {
+	ids := []uint64{3, 7, 8}
+	valid := types.SettlementBatch{ProgramId: "p", Requester: "a", SettlementIds: ids, MerkleRoot: types.SettlementBatchRoot(ids), Status: types.SettlementBatchStatusPending}
+	require.NoError(t, valid.Validate())
+	require.NotEqual(t, types.SettlementBatchRoot(ids), types.SettlementBatchRoot([]uint64{3, 7}))
+
+	tests := []struct {
+		desc   string
+		modify func(*types.SettlementBatch)
+	}{
+		{desc: "empty requester", modify: func(b *types.SettlementBatch) { b.Requester = "" }},
+		{desc: "no settlements", modify: func(b *types.SettlementBatch) { b.SettlementIds, b.MerkleRoot = nil, types.SettlementBatchRoot(nil) }},
+		{desc: "unordered settlements", modify: func(b *types.SettlementBatch) {
+			b.SettlementIds = []uint64{7, 3}
+			b.MerkleRoot = types.SettlementBatchRoot(b.SettlementIds)
+		}},
+		{desc: "root mismatch", modify: func(b *types.SettlementBatch) { b.MerkleRoot = types.SettlementBatchRoot([]uint64{3, 7}) }},
+		{desc: "unspecified status", modify: func(b *types.SettlementBatch) { b.Status = types.SettlementBatchStatusUnspecified }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			batch := valid
+			tc.modify(&batch)
+			require.ErrorIs(t, batch.Validate(), types.ErrInvalidSettlementBatch)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package types
+
+// Circulating returns the amount of points the supply counters expect the
+// program's balances to hold, leaving out tokenized points and the points
+// burned paying fees. It returns false
+// if more points left circulation than were issued.
+func (s PointSupply) Circulating() (uint64, bool) {
+	out := s.Settled
+	for _, amount := range []uint64{s.Expired, s.Tokenized, s.FeesPaid} {
+		if out+amount < out {
+			return 0, false
+		}
+		out += amount
+	}
+	if out > s.Issued {
+		return 0, false
+	}
+	return s.Issued - out, true
+}
@@ -0,0 +1,145 @@
+package types
+
+import "time"
+
+// Prunable reports whether tx is past the retention limits at blockTime,
+// nextID being the id the next transaction will get.
+func (r TransactionRetention) Prunable(tx Transaction, blockTime time.Time, nextID uint64) bool {
+	if r.MaxAge > 0 && tx.Timestamp < blockTime.Add(-r.MaxAge).Unix() {
+		return true
+	}
+	return r.MaxCount > 0 && nextID-tx.Id > r.MaxCount
+}
+
+// IsEnabled reports whether any transaction is ever pruned.
+func (r TransactionRetention) IsEnabled() bool {
+	return r.MaxAge > 0 || r.MaxCount > 0
+}
+
+// Add rolls tx up into the checkpoint of address. address must be the sender
+// or the recipient of tx; a transaction to oneself is counted both ways. The
+// sender's transfer fee is counted as sent.
+func (c *TransactionCheckpoint) Add(tx Transaction, address string) {
+	c.TransactionCount++
+	if tx.Sender == address {
+		c.Sent += tx.Amount + tx.Fee
+	}
+	if tx.Recipient == address {
+		c.Received += tx.Amount
+	}
+	if tx.Id >= c.LastTransactionId {
+		c.LastTransactionId = tx.Id
+		c.LastTimestamp = tx.Timestamp
+	}
+}
+
+// BalanceChange returns the points tx credited to and debited from the
+// balance of address. Issuers and the points module account are recorded as
+// the sender of issuances, grants and detokenizations, and the module account
+// as the recipient of tokenizations, without their balance moving. The
+// sender of a transfer is also debited its fee.
+func (tx Transaction) BalanceChange(address string) (credit, debit uint64) {
+	if tx.Recipient == address {
+		switch tx.TxType {
+		case TransactionTypeIssue, TransactionTypeSpend, TransactionTypeTransfer,
+			TransactionTypeDetokenize, TransactionTypeRefund, TransactionTypeGrant:
+			credit = tx.Amount
+		}
+	}
+	if tx.Sender == address {
+		switch tx.TxType {
+		case TransactionTypeSpend, TransactionTypeTransfer, TransactionTypeExpire,
+			TransactionTypeTokenize, TransactionTypeRefund, TransactionTypeFee:
+			debit = tx.Amount + tx.Fee
+		}
+	}
+	return credit, debit
+}
+
+// IsRefundable reports whether tx is a spend with points left to refund.
+func (tx Transaction) IsRefundable() bool {
+	return tx.TxType == TransactionTypeSpend && tx.Refunded < tx.Amount
+}
+
+// RefundRecord returns tx without its memo and metadata: what is kept of a
+// refundable spend once it is pruned.
+func (tx Transaction) RefundRecord() Transaction {
+	tx.Memo, tx.Metadata = "", nil
+	return tx
+}
+
+// RefundLots returns the parts of the lots a spend took that a refund of
+// amount restores: the spent lots, oldest first, after skipping the amount
+// already refunded. It returns nil for spends recorded without their lots.
+func (tx Transaction) RefundLots(amount uint64) []SpentLot {
+	var (
+		lots []SpentLot
+		skip = tx.Refunded
+	)
+	for _, lot := range tx.SpentLots {
+		if amount == 0 {
+			break
+		}
+		if skip >= lot.Amount {
+			skip -= lot.Amount
+			continue
+		}
+		lot.Amount -= skip
+		skip = 0
+		lot.Amount = min(lot.Amount, amount)
+		amount -= lot.Amount
+		lots = append(lots, lot)
+	}
+	return lots
+}
+
+// Parties returns the addresses tx is checkpointed under: its sender and
+// its recipient, when set.
+func (tx Transaction) Parties() []string {
+	var parties []string
+	if tx.Sender != "" {
+		parties = append(parties, tx.Sender)
+	}
+	if tx.Recipient != "" && tx.Recipient != tx.Sender {
+		parties = append(parties, tx.Recipient)
+	}
+	return parties
+}
+
+// CompactTransactions rolls the transactions past the transaction retention
+// param at blockTime into the transaction checkpoints and drops them from
+// the transaction list, as the EndBlocker would once it caught up. Spends
+// that are not fully refunded move to the refundable spend list.
+func (gs *GenesisState) CompactTransactions(blockTime time.Time) {
+	retention := gs.Params.TransactionRetention
+	if !retention.IsEnabled() {
+		return
+	}
+
+	index := make(map[string]int, len(gs.TransactionCheckpointList))
+	for i, elem := range gs.TransactionCheckpointList {
+		index[programOwnerKey(elem.ProgramId, elem.Address)] = i
+	}
+
+	kept := gs.TransactionList[:0]
+	for _, tx := range gs.TransactionList {
+		if !retention.Prunable(tx, blockTime, gs.TransactionCount) {
+			kept = append(kept, tx)
+			continue
+		}
+		for _, address := range tx.Parties() {
+			key := programOwnerKey(tx.ProgramId, address)
+			i, ok := index[key]
+			if !ok {
+				i = len(gs.TransactionCheckpointList)
+				index[key] = i
+				gs.TransactionCheckpointList = append(gs.TransactionCheckpointList, TransactionCheckpoint{ProgramId: tx.ProgramId, Address: address})
+			}
+			gs.TransactionCheckpointList[i].Add(tx, address)
+		}
+		if tx.IsRefundable() {
+			gs.RefundableSpendList = append(gs.RefundableSpendList, tx.RefundRecord())
+		}
+	}
+	gs.TransactionList = kept
+}
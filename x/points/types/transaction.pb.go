@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,14 +24,100 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TransactionType is the kind of a point transaction.
+type TransactionType int32
+
+const (
+	TransactionTypeUnspecified TransactionType = 0
+	// TRANSACTION_TYPE_ISSUE is an issuance by a program issuer.
+	TransactionTypeIssue TransactionType = 1
+	// TRANSACTION_TYPE_SPEND is a spend at a merchant.
+	TransactionTypeSpend TransactionType = 2
+	// TRANSACTION_TYPE_TRANSFER is a transfer between accounts.
+	TransactionTypeTransfer TransactionType = 3
+	// TRANSACTION_TYPE_EXPIRE records points that expired.
+	TransactionTypeExpire TransactionType = 4
+	// TRANSACTION_TYPE_TOKENIZE turns points into bank coins.
+	TransactionTypeTokenize TransactionType = 5
+	// TRANSACTION_TYPE_DETOKENIZE redeems bank coins back into points.
+	TransactionTypeDetokenize TransactionType = 6
+	// TRANSACTION_TYPE_REFUND is a merchant refunding a spend.
+	TransactionTypeRefund TransactionType = 7
+	// TRANSACTION_TYPE_FEE burns points to pay a transaction fee.
+	TransactionTypeFee TransactionType = 8
+	// TRANSACTION_TYPE_GRANT credits an unlocked tranche of a point grant.
+	TransactionTypeGrant TransactionType = 9
+)
+
+var TransactionType_name = map[int32]string{
+	0: "TRANSACTION_TYPE_UNSPECIFIED",
+	1: "TRANSACTION_TYPE_ISSUE",
+	2: "TRANSACTION_TYPE_SPEND",
+	3: "TRANSACTION_TYPE_TRANSFER",
+	4: "TRANSACTION_TYPE_EXPIRE",
+	5: "TRANSACTION_TYPE_TOKENIZE",
+	6: "TRANSACTION_TYPE_DETOKENIZE",
+	7: "TRANSACTION_TYPE_REFUND",
+	8: "TRANSACTION_TYPE_FEE",
+	9: "TRANSACTION_TYPE_GRANT",
+}
+
+var TransactionType_value = map[string]int32{
+	"TRANSACTION_TYPE_UNSPECIFIED": 0,
+	"TRANSACTION_TYPE_ISSUE":       1,
+	"TRANSACTION_TYPE_SPEND":       2,
+	"TRANSACTION_TYPE_TRANSFER":    3,
+	"TRANSACTION_TYPE_EXPIRE":      4,
+	"TRANSACTION_TYPE_TOKENIZE":    5,
+	"TRANSACTION_TYPE_DETOKENIZE":  6,
+	"TRANSACTION_TYPE_REFUND":      7,
+	"TRANSACTION_TYPE_FEE":         8,
+	"TRANSACTION_TYPE_GRANT":       9,
+}
+
+func (x TransactionType) String() string {
+	return proto.EnumName(TransactionType_name, int32(x))
+}
+
+func (TransactionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6bcad7215e7fdd3b, []int{0}
+}
+
 // Transaction defines the Transaction message.
 type Transaction struct {
 	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Amount    uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	TxType    string `protobuf:"bytes,5,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
-	Timestamp int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// legacy_tx_type is the free-form type transactions were recorded with
+	// before version 3 of the store. It is only read by store migrations.
+	LegacyTxType string `protobuf:"bytes,5,opt,name=legacy_tx_type,json=legacyTxType,proto3" json:"legacy_tx_type,omitempty"` // Deprecated: Do not use.
+	Timestamp    int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ProgramId    string `protobuf:"bytes,7,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// refunded is the amount of a spend the merchant has refunded so far.
+	Refunded uint64 `protobuf:"varint,8,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// refund_of is the id of the spend a refund transaction reverses. It is
+	// only set on refund transactions.
+	RefundOf uint64 `protobuf:"varint,9,opt,name=refund_of,json=refundOf,proto3" json:"refund_of,omitempty"`
+	// memo is the reason of an issuance, the description of a spend or the
+	// memo of a transfer or refund.
+	Memo string `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty"`
+	// order_reference is an external reference such as a POS order or receipt
+	// number. Transactions can be listed by it.
+	OrderReference string `protobuf:"bytes,11,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	// metadata is free-form data attached by the sender, bounded by the
+	// metadata_limits param.
+	Metadata []TransactionMetadata `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata"`
+	TxType   TransactionType       `protobuf:"varint,13,opt,name=tx_type,json=txType,proto3,enum=scontract.points.v1.TransactionType" json:"tx_type,omitempty"`
+	// fee is the transfer fee the sender paid on top of amount, credited to
+	// the points treasury.
+	Fee uint64 `protobuf:"varint,14,opt,name=fee,proto3" json:"fee,omitempty"`
+	// merchant_id is the merchant a spend was made at, or a refund was made
+	// by.
+	MerchantId uint64 `protobuf:"varint,15,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	// spent_lots are the parts of the spender's lots a spend took, oldest
+	// first, so that refunds can restore their expiries.
+	SpentLots []SpentLot `protobuf:"bytes,16,rep,name=spent_lots,json=spentLots,proto3" json:"spent_lots"`
 }
 
 func (m *Transaction) Reset()         { *m = Transaction{} }
@@ -93,9 +181,10 @@ func (m *Transaction) GetAmount() uint64 {
 	return 0
 }
 
-func (m *Transaction) GetTxType() string {
+// Deprecated: Do not use.
+func (m *Transaction) GetLegacyTxType() string {
 	if m != nil {
-		return m.TxType
+		return m.LegacyTxType
 	}
 	return ""
 }
@@ -107,8 +196,301 @@ func (m *Transaction) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *Transaction) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *Transaction) GetRefunded() uint64 {
+	if m != nil {
+		return m.Refunded
+	}
+	return 0
+}
+
+func (m *Transaction) GetRefundOf() uint64 {
+	if m != nil {
+		return m.RefundOf
+	}
+	return 0
+}
+
+func (m *Transaction) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *Transaction) GetOrderReference() string {
+	if m != nil {
+		return m.OrderReference
+	}
+	return ""
+}
+
+func (m *Transaction) GetMetadata() []TransactionMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Transaction) GetTxType() TransactionType {
+	if m != nil {
+		return m.TxType
+	}
+	return TransactionTypeUnspecified
+}
+
+func (m *Transaction) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *Transaction) GetMerchantId() uint64 {
+	if m != nil {
+		return m.MerchantId
+	}
+	return 0
+}
+
+func (m *Transaction) GetSpentLots() []SpentLot {
+	if m != nil {
+		return m.SpentLots
+	}
+	return nil
+}
+
+// SpentLot is the part of a lot a spend took.
+type SpentLot struct {
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// issued_at is the unix time the points were originally issued.
+	IssuedAt int64 `protobuf:"varint,2,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// expires_at is the unix time the points expire, zero if they never do.
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *SpentLot) Reset()         { *m = SpentLot{} }
+func (m *SpentLot) String() string { return proto.CompactTextString(m) }
+func (*SpentLot) ProtoMessage()    {}
+func (*SpentLot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bcad7215e7fdd3b, []int{1}
+}
+func (m *SpentLot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpentLot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpentLot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpentLot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpentLot.Merge(m, src)
+}
+func (m *SpentLot) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpentLot) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpentLot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpentLot proto.InternalMessageInfo
+
+func (m *SpentLot) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SpentLot) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *SpentLot) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// TransactionMetadata is a key/value pair attached to a transaction.
+type TransactionMetadata struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *TransactionMetadata) Reset()         { *m = TransactionMetadata{} }
+func (m *TransactionMetadata) String() string { return proto.CompactTextString(m) }
+func (*TransactionMetadata) ProtoMessage()    {}
+func (*TransactionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bcad7215e7fdd3b, []int{2}
+}
+func (m *TransactionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransactionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionMetadata.Merge(m, src)
+}
+func (m *TransactionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionMetadata proto.InternalMessageInfo
+
+func (m *TransactionMetadata) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *TransactionMetadata) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// TransactionCheckpoint rolls up the pruned transactions an account took part
+// in within a program, so that statements stay correct once the records are
+// gone.
+type TransactionCheckpoint struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// transaction_count is the number of pruned transactions of the account.
+	TransactionCount uint64 `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	// sent is the total amount, transfer fees included, of the pruned
+	// transactions the account sent.
+	Sent uint64 `protobuf:"varint,4,opt,name=sent,proto3" json:"sent,omitempty"`
+	// received is the total amount of the pruned transactions the account
+	// received.
+	Received uint64 `protobuf:"varint,5,opt,name=received,proto3" json:"received,omitempty"`
+	// last_transaction_id is the id of the newest pruned transaction of the
+	// account.
+	LastTransactionId uint64 `protobuf:"varint,6,opt,name=last_transaction_id,json=lastTransactionId,proto3" json:"last_transaction_id,omitempty"`
+	// last_timestamp is the unix time of that transaction.
+	LastTimestamp int64 `protobuf:"varint,7,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+}
+
+func (m *TransactionCheckpoint) Reset()         { *m = TransactionCheckpoint{} }
+func (m *TransactionCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*TransactionCheckpoint) ProtoMessage()    {}
+func (*TransactionCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bcad7215e7fdd3b, []int{3}
+}
+func (m *TransactionCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransactionCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionCheckpoint.Merge(m, src)
+}
+func (m *TransactionCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionCheckpoint proto.InternalMessageInfo
+
+func (m *TransactionCheckpoint) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *TransactionCheckpoint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TransactionCheckpoint) GetTransactionCount() uint64 {
+	if m != nil {
+		return m.TransactionCount
+	}
+	return 0
+}
+
+func (m *TransactionCheckpoint) GetSent() uint64 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *TransactionCheckpoint) GetReceived() uint64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *TransactionCheckpoint) GetLastTransactionId() uint64 {
+	if m != nil {
+		return m.LastTransactionId
+	}
+	return 0
+}
+
+func (m *TransactionCheckpoint) GetLastTimestamp() int64 {
+	if m != nil {
+		return m.LastTimestamp
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("scontract.points.v1.TransactionType", TransactionType_name, TransactionType_value)
 	proto.RegisterType((*Transaction)(nil), "scontract.points.v1.Transaction")
+	proto.RegisterType((*SpentLot)(nil), "scontract.points.v1.SpentLot")
+	proto.RegisterType((*TransactionMetadata)(nil), "scontract.points.v1.TransactionMetadata")
+	proto.RegisterType((*TransactionCheckpoint)(nil), "scontract.points.v1.TransactionCheckpoint")
 }
 
 func init() {
@@ -116,22 +498,64 @@ func init() {
 }
 
 var fileDescriptor_6bcad7215e7fdd3b = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x8f, 0x4f, 0x4a, 0xc4, 0x30,
-	0x14, 0xc6, 0xfb, 0x3a, 0x63, 0x65, 0x22, 0xb8, 0x88, 0xa0, 0x59, 0x48, 0x28, 0x82, 0xd0, 0x55,
-	0xcb, 0xe8, 0x0d, 0x3c, 0x42, 0x99, 0x95, 0x1b, 0x89, 0x69, 0x16, 0x59, 0x34, 0x09, 0xc9, 0x73,
-	0xe8, 0xdc, 0xc2, 0x33, 0x78, 0x1a, 0x97, 0x5d, 0xba, 0x94, 0xf6, 0x22, 0xd2, 0x3f, 0x4e, 0x96,
-	0xdf, 0xf7, 0xbe, 0xf7, 0x83, 0x1f, 0x79, 0x0c, 0xd2, 0x1a, 0xf4, 0x42, 0x62, 0xe5, 0xac, 0x36,
-	0x18, 0xaa, 0xe3, 0xbe, 0x42, 0x2f, 0x4c, 0x10, 0x12, 0xb5, 0x35, 0xa5, 0xf3, 0x16, 0x2d, 0xbd,
-	0x39, 0xcf, 0xca, 0x65, 0x56, 0x1e, 0xf7, 0x0f, 0x5f, 0x40, 0xae, 0x0e, 0x71, 0x4a, 0xaf, 0x49,
-	0xaa, 0x1b, 0x06, 0x39, 0x14, 0xdb, 0x3a, 0xd5, 0x0d, 0xbd, 0x25, 0x59, 0x50, 0xa6, 0x51, 0x9e,
-	0xa5, 0x39, 0x14, 0xbb, 0x7a, 0x4d, 0xf4, 0x9e, 0xec, 0xbc, 0x92, 0xda, 0x69, 0x65, 0x90, 0x6d,
-	0xe6, 0x53, 0x2c, 0xa6, 0x2f, 0xd1, 0xda, 0x0f, 0x83, 0x6c, 0x3b, 0x93, 0xd6, 0x44, 0xef, 0xc8,
-	0x25, 0x76, 0x6f, 0x78, 0x72, 0x8a, 0x5d, 0x2c, 0x38, 0xec, 0x0e, 0x27, 0xa7, 0x26, 0x1c, 0xea,
-	0x56, 0x05, 0x14, 0xad, 0x63, 0x59, 0x0e, 0xc5, 0xa6, 0x8e, 0xc5, 0xcb, 0xd3, 0xf7, 0xc0, 0xa1,
-	0x1f, 0x38, 0xfc, 0x0e, 0x1c, 0x3e, 0x47, 0x9e, 0xf4, 0x23, 0x4f, 0x7e, 0x46, 0x9e, 0xbc, 0xb2,
-	0xa8, 0xde, 0xfd, 0xcb, 0x4f, 0xf8, 0xf0, 0x9e, 0xcd, 0xd2, 0xcf, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0x2f, 0x7a, 0x17, 0x1d, 0x01, 0x00, 0x00,
+	// 898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x13, 0x37, 0x7f, 0x5e, 0x77, 0x53, 0x77, 0xda, 0xed, 0xba, 0xee, 0x36, 0x6b, 0x55,
+	0xac, 0x88, 0x16, 0x29, 0x61, 0x0b, 0xe2, 0x80, 0xb4, 0x88, 0xa4, 0x71, 0x56, 0x16, 0x90, 0x56,
+	0x4e, 0x2a, 0xc1, 0x1e, 0xb0, 0x8c, 0xe7, 0xa5, 0x6b, 0xb5, 0xb1, 0x2d, 0xcf, 0xb4, 0x6a, 0x39,
+	0x73, 0x40, 0x39, 0xf1, 0x05, 0x72, 0xe2, 0xc2, 0x91, 0x8f, 0xb1, 0x37, 0xf6, 0x82, 0xc4, 0x09,
+	0xa1, 0xf6, 0xc0, 0xd7, 0x40, 0x33, 0x4e, 0xd2, 0x90, 0x58, 0xda, 0x8b, 0xf5, 0xfe, 0xfd, 0xde,
+	0x9b, 0xf7, 0xde, 0x6f, 0x3c, 0xf0, 0x8c, 0xf9, 0x51, 0xc8, 0x13, 0xcf, 0xe7, 0xcd, 0x38, 0x0a,
+	0x42, 0xce, 0x9a, 0x57, 0x2f, 0x9a, 0x3c, 0xf1, 0x42, 0xe6, 0xf9, 0x3c, 0x88, 0xc2, 0x46, 0x9c,
+	0x44, 0x3c, 0x22, 0x5b, 0xf3, 0xb0, 0x46, 0x1a, 0xd6, 0xb8, 0x7a, 0x61, 0x6c, 0x7a, 0xa3, 0x20,
+	0x8c, 0x9a, 0xf2, 0x9b, 0xc6, 0x19, 0xdb, 0x67, 0xd1, 0x59, 0x24, 0xc5, 0xa6, 0x90, 0x52, 0xeb,
+	0xc1, 0x1f, 0x2a, 0xac, 0x0f, 0xee, 0x73, 0x92, 0x2a, 0xe4, 0x03, 0xaa, 0x2b, 0xa6, 0x52, 0x57,
+	0x9d, 0x7c, 0x40, 0xc9, 0x0e, 0x14, 0x19, 0x86, 0x14, 0x13, 0x3d, 0x6f, 0x2a, 0xf5, 0x8a, 0x33,
+	0xd5, 0xc8, 0x13, 0xa8, 0x24, 0xe8, 0x07, 0x71, 0x80, 0x21, 0xd7, 0x0b, 0xd2, 0x75, 0x6f, 0x10,
+	0x28, 0x6f, 0x14, 0x5d, 0x86, 0x5c, 0x57, 0x65, 0xa6, 0xa9, 0x46, 0xea, 0x50, 0xbd, 0xc0, 0x33,
+	0xcf, 0xbf, 0x71, 0xf9, 0xb5, 0xcb, 0x6f, 0x62, 0xd4, 0xd7, 0x04, 0xb4, 0x9d, 0xd7, 0x15, 0xe7,
+	0x41, 0xea, 0x19, 0x5c, 0x0f, 0x6e, 0x62, 0x14, 0xf9, 0x79, 0x30, 0x42, 0xc6, 0xbd, 0x51, 0xac,
+	0x17, 0x4d, 0xa5, 0x5e, 0x70, 0xee, 0x0d, 0x64, 0x1f, 0x20, 0x4e, 0xa2, 0xb3, 0xc4, 0x1b, 0xb9,
+	0x01, 0xd5, 0x4b, 0x69, 0xf9, 0xa9, 0xc5, 0xa6, 0xc4, 0x80, 0x72, 0x82, 0xc3, 0xcb, 0x90, 0x22,
+	0xd5, 0xcb, 0xf2, 0x00, 0x73, 0x9d, 0xec, 0x89, 0x83, 0x0b, 0xd9, 0x8d, 0x86, 0x7a, 0x65, 0xd1,
+	0x79, 0x3c, 0x24, 0x04, 0xd4, 0x11, 0x8e, 0x22, 0x1d, 0x64, 0x46, 0x29, 0x93, 0x0f, 0x61, 0x23,
+	0x4a, 0x28, 0x26, 0x6e, 0x82, 0x43, 0x4c, 0x30, 0xf4, 0x51, 0x5f, 0x97, 0xee, 0xaa, 0x34, 0x3b,
+	0x33, 0x2b, 0x39, 0x86, 0xf2, 0x08, 0xb9, 0x47, 0x3d, 0xee, 0xe9, 0x0f, 0xcc, 0x42, 0x7d, 0xfd,
+	0xb0, 0xde, 0xc8, 0xd8, 0x4d, 0x63, 0x61, 0xdc, 0xdf, 0x4c, 0xe3, 0xdb, 0x95, 0xb7, 0x7f, 0x3f,
+	0xcd, 0xfd, 0xf6, 0xef, 0xef, 0xcf, 0x15, 0x67, 0x9e, 0x84, 0xbc, 0x84, 0xd2, 0x6c, 0x4c, 0x0f,
+	0x4d, 0xa5, 0x5e, 0x3d, 0xfc, 0xe0, 0x7d, 0xf9, 0xc4, 0xe8, 0x9c, 0x22, 0x4f, 0x47, 0xa8, 0x41,
+	0x61, 0x88, 0xa8, 0x57, 0x65, 0x8f, 0x42, 0x24, 0x4f, 0x61, 0x7d, 0x84, 0x89, 0xff, 0xc6, 0x0b,
+	0xb9, 0x98, 0xdb, 0x86, 0xf4, 0xc0, 0xcc, 0x64, 0x53, 0xd2, 0x06, 0x60, 0x31, 0x86, 0xdc, 0xbd,
+	0x88, 0x38, 0xd3, 0x35, 0xd9, 0xc4, 0x7e, 0x66, 0xd1, 0xbe, 0x08, 0xfb, 0x3a, 0xe2, 0x6d, 0x55,
+	0x9c, 0xdc, 0xa9, 0xb0, 0xa9, 0xce, 0x0e, 0xbe, 0x87, 0xf2, 0xcc, 0xb9, 0xc0, 0x03, 0xe5, 0x7f,
+	0x3c, 0xd8, 0x83, 0x4a, 0xc0, 0xd8, 0x25, 0x52, 0xd7, 0xe3, 0x92, 0x58, 0x05, 0xa7, 0x9c, 0x1a,
+	0x5a, 0x5c, 0x2c, 0x17, 0xaf, 0xe3, 0x20, 0x41, 0x26, 0xbc, 0x85, 0x74, 0xf7, 0x53, 0x4b, 0x8b,
+	0x1f, 0xbc, 0x84, 0xad, 0x8c, 0x09, 0x8a, 0x6e, 0xcf, 0xf1, 0x46, 0xd6, 0xa9, 0x38, 0x42, 0x24,
+	0xdb, 0xb0, 0x76, 0xe5, 0x5d, 0x5c, 0xe2, 0x94, 0xb9, 0xa9, 0x72, 0xf0, 0x53, 0x1e, 0x1e, 0x2d,
+	0xe0, 0x8f, 0xde, 0xa0, 0x7f, 0x2e, 0xdb, 0x5a, 0x22, 0x95, 0xb2, 0x4c, 0x2a, 0x1d, 0x4a, 0x1e,
+	0xa5, 0x09, 0x32, 0x36, 0x4d, 0x38, 0x53, 0xc9, 0x47, 0xb0, 0xb9, 0x70, 0x2d, 0x5d, 0x5f, 0x36,
+	0x5c, 0x90, 0x0d, 0x6b, 0x0b, 0x8e, 0x23, 0xd9, 0x3a, 0x01, 0x95, 0xe1, 0xfc, 0x62, 0x48, 0x39,
+	0xe5, 0xab, 0x8f, 0xc1, 0x15, 0x52, 0x79, 0x21, 0x24, 0x25, 0x53, 0x9d, 0x34, 0x60, 0xeb, 0xc2,
+	0x63, 0xdc, 0x5d, 0xac, 0x10, 0x50, 0x79, 0x25, 0x54, 0x67, 0x53, 0xb8, 0x16, 0xba, 0xb1, 0x29,
+	0x79, 0x06, 0xd5, 0x34, 0x7e, 0x7e, 0x7b, 0x4a, 0x72, 0x82, 0x0f, 0x65, 0xe8, 0xcc, 0xf8, 0xfc,
+	0x4f, 0x15, 0x36, 0x96, 0x88, 0x43, 0xbe, 0x84, 0x27, 0x03, 0xa7, 0xd5, 0xeb, 0xb7, 0x8e, 0x06,
+	0xf6, 0x71, 0xcf, 0x1d, 0x7c, 0x77, 0x62, 0xb9, 0xa7, 0xbd, 0xfe, 0x89, 0x75, 0x64, 0x77, 0x6d,
+	0xab, 0xa3, 0xe5, 0x8c, 0xda, 0x78, 0x62, 0x1a, 0x4b, 0xb0, 0xd3, 0x90, 0xc5, 0xe8, 0x07, 0xc3,
+	0x00, 0x29, 0xf9, 0x14, 0x76, 0x56, 0x32, 0xd8, 0xfd, 0xfe, 0xa9, 0xa5, 0x29, 0x86, 0x3e, 0x9e,
+	0x98, 0xdb, 0x4b, 0x58, 0x5b, 0xec, 0x3c, 0x13, 0xd5, 0x3f, 0xb1, 0x7a, 0x1d, 0x2d, 0x9f, 0x89,
+	0x12, 0xf4, 0xa2, 0xe4, 0x73, 0xd8, 0x5d, 0x41, 0x49, 0x43, 0xd7, 0x72, 0xb4, 0x82, 0xb1, 0x37,
+	0x9e, 0x98, 0x8f, 0x97, 0x80, 0x52, 0x1d, 0x62, 0x42, 0x3e, 0x83, 0xc7, 0x2b, 0x58, 0xeb, 0xdb,
+	0x13, 0xdb, 0xb1, 0x34, 0xd5, 0xd8, 0x1d, 0x4f, 0xcc, 0x47, 0x4b, 0x48, 0x4b, 0xd2, 0x2f, 0xbb,
+	0xe6, 0xf1, 0x57, 0x56, 0xcf, 0x7e, 0x6d, 0x69, 0x6b, 0xd9, 0x35, 0xa3, 0x73, 0x0c, 0x83, 0x1f,
+	0x91, 0x7c, 0x01, 0x7b, 0x2b, 0xd8, 0x8e, 0x35, 0x47, 0x17, 0x8d, 0xfd, 0xf1, 0xc4, 0xdc, 0x5d,
+	0x42, 0x77, 0x90, 0xcf, 0xf0, 0x59, 0x67, 0x76, 0xac, 0xee, 0x69, 0xaf, 0xa3, 0x95, 0x32, 0xcf,
+	0xec, 0xc8, 0xbf, 0x1a, 0xf9, 0x18, 0xb6, 0x57, 0x70, 0x5d, 0xcb, 0xd2, 0xca, 0xc6, 0xce, 0x78,
+	0x62, 0x92, 0x25, 0x50, 0x17, 0xb3, 0xf7, 0xf1, 0xca, 0x69, 0xf5, 0x06, 0x5a, 0x25, 0x73, 0x1f,
+	0xaf, 0x12, 0x2f, 0xe4, 0x86, 0xfa, 0xf3, 0xaf, 0xb5, 0x5c, 0xfb, 0xf0, 0xed, 0x6d, 0x4d, 0x79,
+	0x77, 0x5b, 0x53, 0xfe, 0xb9, 0xad, 0x29, 0xbf, 0xdc, 0xd5, 0x72, 0xef, 0xee, 0x6a, 0xb9, 0xbf,
+	0xee, 0x6a, 0xb9, 0xd7, 0xfa, 0xfd, 0x73, 0x76, 0x3d, 0x7b, 0xd0, 0xc4, 0xbf, 0x8d, 0xfd, 0x50,
+	0x94, 0x4f, 0xd1, 0x27, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x69, 0xa7, 0x1a, 0x2f, 0xf1, 0x06,
+	0x00, 0x00,
 }
 
 func (m *Transaction) Marshal() (dAtA []byte, err error) {
@@ -154,15 +578,91 @@ func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpentLots) > 0 {
+		for iNdEx := len(m.SpentLots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpentLots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.MerchantId != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.MerchantId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Fee != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.TxType != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.OrderReference) > 0 {
+		i -= len(m.OrderReference)
+		copy(dAtA[i:], m.OrderReference)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.OrderReference)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RefundOf != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.RefundOf))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Refunded != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Refunded))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintTransaction(dAtA, i, uint64(m.Timestamp))
 		i--
 		dAtA[i] = 0x30
 	}
-	if len(m.TxType) > 0 {
-		i -= len(m.TxType)
-		copy(dAtA[i:], m.TxType)
-		i = encodeVarintTransaction(dAtA, i, uint64(len(m.TxType)))
+	if len(m.LegacyTxType) > 0 {
+		i -= len(m.LegacyTxType)
+		copy(dAtA[i:], m.LegacyTxType)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.LegacyTxType)))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -193,54 +693,759 @@ func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTransaction(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTransaction(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SpentLot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Transaction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SpentLot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpentLot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTransaction(uint64(m.Id))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTransaction(uint64(l))
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTransaction(uint64(l))
+	if m.IssuedAt != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.Amount != 0 {
-		n += 1 + sovTransaction(uint64(m.Amount))
-	}
-	l = len(m.TxType)
-	if l > 0 {
-		n += 1 + l + sovTransaction(uint64(l))
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Timestamp != 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastTimestamp != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.LastTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastTransactionId != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.LastTransactionId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Received != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sent != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Sent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TransactionCount != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.TransactionCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransaction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransaction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Transaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTransaction(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTransaction(uint64(m.Amount))
+	}
+	l = len(m.LegacyTxType)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Timestamp != 0 {
 		n += 1 + sovTransaction(uint64(m.Timestamp))
 	}
-	return n
-}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Refunded != 0 {
+		n += 1 + sovTransaction(uint64(m.Refunded))
+	}
+	if m.RefundOf != 0 {
+		n += 1 + sovTransaction(uint64(m.RefundOf))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.OrderReference)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.TxType != 0 {
+		n += 1 + sovTransaction(uint64(m.TxType))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovTransaction(uint64(m.Fee))
+	}
+	if m.MerchantId != 0 {
+		n += 1 + sovTransaction(uint64(m.MerchantId))
+	}
+	if len(m.SpentLots) > 0 {
+		for _, e := range m.SpentLots {
+			l = e.Size()
+			n += 2 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpentLot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTransaction(uint64(m.Amount))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovTransaction(uint64(m.IssuedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTransaction(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *TransactionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.TransactionCount != 0 {
+		n += 1 + sovTransaction(uint64(m.TransactionCount))
+	}
+	if m.Sent != 0 {
+		n += 1 + sovTransaction(uint64(m.Sent))
+	}
+	if m.Received != 0 {
+		n += 1 + sovTransaction(uint64(m.Received))
+	}
+	if m.LastTransactionId != 0 {
+		n += 1 + sovTransaction(uint64(m.LastTransactionId))
+	}
+	if m.LastTimestamp != 0 {
+		n += 1 + sovTransaction(uint64(m.LastTimestamp))
+	}
+	return n
+}
+
+func sovTransaction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransaction(x uint64) (n int) {
+	return sovTransaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Transaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyTxType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyTxType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			m.Refunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundOf", wireType)
+			}
+			m.RefundOf = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundOf |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, TransactionMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= TransactionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerchantId", wireType)
+			}
+			m.MerchantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerchantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentLots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentLots = append(m.SpentLots, SpentLot{})
+			if err := m.SpentLots[len(m.SpentLots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTransaction(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTransaction(x uint64) (n int) {
-	return sovTransaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Transaction) Unmarshal(dAtA []byte) error {
+func (m *SpentLot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -263,17 +1468,17 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpentLot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpentLot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Id = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -283,14 +1488,102 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -318,11 +1611,11 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -350,13 +1643,63 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Amount = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -366,14 +1709,27 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -401,13 +1757,70 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxType = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionCount", wireType)
+			}
+			m.TransactionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			m.Sent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransactionId", wireType)
 			}
-			m.Timestamp = 0
+			m.LastTransactionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -417,7 +1830,26 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.LastTransactionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
+			}
+			m.LastTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"scontract/testutil/sample"
+	"scontract/x/points/types"
+)
+
+func TestTransactionRetentionPrunable(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	old := types.Transaction{Id: 1, Timestamp: now.Add(-48 * time.Hour).Unix()}
+	recent := types.Transaction{Id: 8, Timestamp: now.Add(-time.Hour).Unix()}
+
+	require.False(t, types.DefaultTransactionRetention.IsEnabled())
+	require.False(t, types.DefaultTransactionRetention.Prunable(old, now, 10))
+
+	byAge := types.TransactionRetention{MaxAge: 24 * time.Hour}
+	require.True(t, byAge.Prunable(old, now, 10))
+	require.False(t, byAge.Prunable(recent, now, 10))
+
+	byCount := types.TransactionRetention{MaxCount: 2}
+	require.True(t, byCount.Prunable(recent, now, 11))
+	require.False(t, byCount.Prunable(recent, now, 10))
+}
+
+func TestGenesisStateCompactTransactions(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	old := now.Add(-48 * time.Hour).Unix()
+
+	gs := types.DefaultGenesis()
+	gs.TransactionList = []types.Transaction{
+		{ProgramId: "p", Id: 0, Recipient: alice, Amount: 10, Timestamp: old},
+		{ProgramId: "p", Id: 1, Sender: alice, Recipient: bob, Amount: 4, Fee: 1, Timestamp: old},
+		{ProgramId: "p", Id: 2, Sender: alice, Recipient: alice, Amount: 1, Timestamp: old},
+		{ProgramId: "p", Id: 3, Sender: bob, Amount: 2, Timestamp: now.Unix()},
+	}
+	gs.TransactionCount = 4
+	gs.TransactionCheckpointList = []types.TransactionCheckpoint{{ProgramId: "p", Address: bob, TransactionCount: 1, Received: 7}}
+
+	// without a retention nothing is compacted
+	gs.CompactTransactions(now)
+	require.Len(t, gs.TransactionList, 4)
+
+	gs.Params.TransactionRetention.MaxAge = 24 * time.Hour
+	gs.CompactTransactions(now)
+	require.Equal(t, []types.Transaction{{ProgramId: "p", Id: 3, Sender: bob, Amount: 2, Timestamp: now.Unix()}}, gs.TransactionList)
+	require.ElementsMatch(t, []types.TransactionCheckpoint{
+		{ProgramId: "p", Address: bob, TransactionCount: 2, Received: 11, LastTransactionId: 1, LastTimestamp: old},
+		{ProgramId: "p", Address: alice, TransactionCount: 3, Sent: 6, Received: 11, LastTransactionId: 2, LastTimestamp: old},
+	}, gs.TransactionCheckpointList)
+}
+
+func TestGenesisStateCompactTransactionsKeepsRefundableSpends(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	alice, shop := sample.AccAddress(), sample.AccAddress()
+	old := now.Add(-48 * time.Hour).Unix()
+	spend := types.Transaction{ProgramId: "p", Id: 0, Sender: alice, Recipient: shop, Amount: 10, Refunded: 4, TxType: types.TransactionTypeSpend, Timestamp: old, Memo: "shoes", OrderReference: "order-1"}
+	refunded := types.Transaction{ProgramId: "p", Id: 1, Sender: alice, Recipient: shop, Amount: 5, Refunded: 5, TxType: types.TransactionTypeSpend, Timestamp: old}
+
+	gs := types.DefaultGenesis()
+	gs.ProgramList = []types.PointProgram{{Id: "p", Name: "p", Owner: alice}}
+	gs.TransactionList = []types.Transaction{spend, refunded}
+	gs.TransactionCount = 2
+	gs.Params.TransactionRetention.MaxAge = 24 * time.Hour
+	gs.CompactTransactions(now)
+
+	require.Empty(t, gs.TransactionList)
+	spend.Memo = ""
+	require.Equal(t, []types.Transaction{spend}, gs.RefundableSpendList)
+	require.NoError(t, gs.Validate())
+}
+
+func TestTransactionBalanceChange(t *testing.T) {
+	tests := []struct {
+		txType types.TransactionType
+		// senderDebit and recipientCredit tell whether the parties' balances
+		// move
+		senderDebit, recipientCredit bool
+	}{
+		{txType: types.TransactionTypeIssue, recipientCredit: true},
+		{txType: types.TransactionTypeGrant, recipientCredit: true},
+		{txType: types.TransactionTypeDetokenize, recipientCredit: true},
+		{txType: types.TransactionTypeTokenize, senderDebit: true},
+		{txType: types.TransactionTypeExpire, senderDebit: true},
+		{txType: types.TransactionTypeFee, senderDebit: true},
+		{txType: types.TransactionTypeSpend, senderDebit: true, recipientCredit: true},
+		{txType: types.TransactionTypeTransfer, senderDebit: true, recipientCredit: true},
+		{txType: types.TransactionTypeRefund, senderDebit: true, recipientCredit: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.txType.String(), func(t *testing.T) {
+			tx := types.Transaction{Sender: "alice", Recipient: "bob", Amount: 10, Fee: 1, TxType: tc.txType}
+
+			credit, debit := tx.BalanceChange("alice")
+			require.Zero(t, credit)
+			if tc.senderDebit {
+				require.EqualValues(t, 11, debit)
+			} else {
+				require.Zero(t, debit)
+			}
+
+			credit, debit = tx.BalanceChange("bob")
+			require.Zero(t, debit)
+			if tc.recipientCredit {
+				require.EqualValues(t, 10, credit)
+			} else {
+				require.Zero(t, credit)
+			}
+		})
+	}
+
+	// a transfer to oneself only costs the fee
+	credit, debit := types.Transaction{Sender: "alice", Recipient: "alice", Amount: 10, Fee: 1, TxType: types.TransactionTypeTransfer}.BalanceChange("alice")
+	require.EqualValues(t, 10, credit)
+	require.EqualValues(t, 11, debit)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Velocity usage kinds.
+const (
+	VelocitySpend    = "spend"
+	VelocityTransfer = "transfer"
+	VelocityIssue    = "issue"
+)
+
+const (
+	// VelocityBucketSeconds is the length of a velocity usage bucket.
+	VelocityBucketSeconds = 60 * 60
+
+	// VelocityDayBuckets and VelocityWeekBuckets are the number of buckets
+	// in the daily and weekly rolling windows.
+	VelocityDayBuckets  = 24
+	VelocityWeekBuckets = 7 * VelocityDayBuckets
+)
+
+// VelocityBucket returns the usage bucket of a unix time.
+func VelocityBucket(unix int64) int64 {
+	return unix / VelocityBucketSeconds
+}
+
+// Validate checks that the usage is of a known kind, belongs to a valid
+// address and is not empty.
+func (u VelocityUsage) Validate() error {
+	switch u.Kind {
+	case VelocitySpend, VelocityTransfer:
+		if u.Counterparty != "" {
+			return fmt.Errorf("%s velocity usage cannot have a counterparty", u.Kind)
+		}
+	case VelocityIssue:
+		if _, err := sdk.AccAddressFromBech32(u.Counterparty); err != nil {
+			return fmt.Errorf("invalid velocity usage counterparty %q: %w", u.Counterparty, err)
+		}
+	default:
+		return fmt.Errorf("unknown velocity usage kind %q", u.Kind)
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Address); err != nil {
+		return fmt.Errorf("invalid velocity usage address %q: %w", u.Address, err)
+	}
+	if u.Amount == 0 {
+		return fmt.Errorf("velocity usage amount cannot be zero")
+	}
+	return nil
+}
+
+// Validate checks that no daily limit is above the weekly limit of its kind.
+// Zero limits are unlimited.
+func (l VelocityLimits) Validate() error {
+	for _, kind := range []string{VelocitySpend, VelocityTransfer} {
+		daily, weekly := l.Windows(kind)
+		if daily > 0 && weekly > 0 && daily > weekly {
+			return fmt.Errorf("daily %s limit %d exceeds weekly limit %d", kind, daily, weekly)
+		}
+	}
+	return nil
+}
+
+// Windows returns the daily and weekly limits of a usage kind. Issuance is
+// only limited per day.
+func (l VelocityLimits) Windows(kind string) (daily, weekly uint64) {
+	switch kind {
+	case VelocitySpend:
+		return l.DailySpend, l.WeeklySpend
+	case VelocityTransfer:
+		return l.DailyTransfer, l.WeeklyTransfer
+	case VelocityIssue:
+		return l.DailyIssuePerRecipient, 0
+	}
+	return 0, 0
+}
@@ -0,0 +1,1073 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scontract/points/v1/velocity.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VelocityLimits are the most points an account may move over rolling
+// windows. Amounts of every program count together, and zero means no limit.
+type VelocityLimits struct {
+	// daily_spend is the most an account may spend per 24 hours.
+	DailySpend uint64 `protobuf:"varint,1,opt,name=daily_spend,json=dailySpend,proto3" json:"daily_spend,omitempty"`
+	// weekly_spend is the most an account may spend per 7 days.
+	WeeklySpend uint64 `protobuf:"varint,2,opt,name=weekly_spend,json=weeklySpend,proto3" json:"weekly_spend,omitempty"`
+	// daily_transfer is the most an account may transfer per 24 hours.
+	DailyTransfer uint64 `protobuf:"varint,3,opt,name=daily_transfer,json=dailyTransfer,proto3" json:"daily_transfer,omitempty"`
+	// weekly_transfer is the most an account may transfer per 7 days.
+	WeeklyTransfer uint64 `protobuf:"varint,4,opt,name=weekly_transfer,json=weeklyTransfer,proto3" json:"weekly_transfer,omitempty"`
+	// daily_issue_per_recipient is the most an issuer may issue to a single
+	// recipient per 24 hours.
+	DailyIssuePerRecipient uint64 `protobuf:"varint,5,opt,name=daily_issue_per_recipient,json=dailyIssuePerRecipient,proto3" json:"daily_issue_per_recipient,omitempty"`
+}
+
+func (m *VelocityLimits) Reset()         { *m = VelocityLimits{} }
+func (m *VelocityLimits) String() string { return proto.CompactTextString(m) }
+func (*VelocityLimits) ProtoMessage()    {}
+func (*VelocityLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5a68b52c5dea6d8, []int{0}
+}
+func (m *VelocityLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VelocityLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VelocityLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VelocityLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VelocityLimits.Merge(m, src)
+}
+func (m *VelocityLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *VelocityLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_VelocityLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VelocityLimits proto.InternalMessageInfo
+
+func (m *VelocityLimits) GetDailySpend() uint64 {
+	if m != nil {
+		return m.DailySpend
+	}
+	return 0
+}
+
+func (m *VelocityLimits) GetWeeklySpend() uint64 {
+	if m != nil {
+		return m.WeeklySpend
+	}
+	return 0
+}
+
+func (m *VelocityLimits) GetDailyTransfer() uint64 {
+	if m != nil {
+		return m.DailyTransfer
+	}
+	return 0
+}
+
+func (m *VelocityLimits) GetWeeklyTransfer() uint64 {
+	if m != nil {
+		return m.WeeklyTransfer
+	}
+	return 0
+}
+
+func (m *VelocityLimits) GetDailyIssuePerRecipient() uint64 {
+	if m != nil {
+		return m.DailyIssuePerRecipient
+	}
+	return 0
+}
+
+// VelocityOverride replaces the velocity limits params for an account.
+type VelocityOverride struct {
+	Address string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Limits  VelocityLimits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits"`
+}
+
+func (m *VelocityOverride) Reset()         { *m = VelocityOverride{} }
+func (m *VelocityOverride) String() string { return proto.CompactTextString(m) }
+func (*VelocityOverride) ProtoMessage()    {}
+func (*VelocityOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5a68b52c5dea6d8, []int{1}
+}
+func (m *VelocityOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VelocityOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VelocityOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VelocityOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VelocityOverride.Merge(m, src)
+}
+func (m *VelocityOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *VelocityOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_VelocityOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VelocityOverride proto.InternalMessageInfo
+
+func (m *VelocityOverride) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VelocityOverride) GetLimits() VelocityLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return VelocityLimits{}
+}
+
+// VelocityUsage is the amount an account moved during one hour of a rolling
+// window.
+type VelocityUsage struct {
+	// kind is spend, transfer or issue.
+	Kind    string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// counterparty is the recipient of issued points, empty for other kinds.
+	Counterparty string `protobuf:"bytes,3,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// bucket is the unix hour the amount was moved in.
+	Bucket int64  `protobuf:"varint,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *VelocityUsage) Reset()         { *m = VelocityUsage{} }
+func (m *VelocityUsage) String() string { return proto.CompactTextString(m) }
+func (*VelocityUsage) ProtoMessage()    {}
+func (*VelocityUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5a68b52c5dea6d8, []int{2}
+}
+func (m *VelocityUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VelocityUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VelocityUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VelocityUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VelocityUsage.Merge(m, src)
+}
+func (m *VelocityUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *VelocityUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_VelocityUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VelocityUsage proto.InternalMessageInfo
+
+func (m *VelocityUsage) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *VelocityUsage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VelocityUsage) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *VelocityUsage) GetBucket() int64 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func (m *VelocityUsage) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*VelocityLimits)(nil), "scontract.points.v1.VelocityLimits")
+	proto.RegisterType((*VelocityOverride)(nil), "scontract.points.v1.VelocityOverride")
+	proto.RegisterType((*VelocityUsage)(nil), "scontract.points.v1.VelocityUsage")
+}
+
+func init() {
+	proto.RegisterFile("scontract/points/v1/velocity.proto", fileDescriptor_d5a68b52c5dea6d8)
+}
+
+var fileDescriptor_d5a68b52c5dea6d8 = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0xe3, 0xdd, 0x50, 0x14, 0x77, 0xb7, 0x80, 0x41, 0xab, 0xb0, 0x87, 0x14, 0x82, 0x10,
+	0x88, 0x43, 0xa2, 0x5d, 0x4e, 0x70, 0xdc, 0x03, 0x12, 0x12, 0x12, 0x28, 0xfc, 0x39, 0x70, 0xa9,
+	0xdc, 0x64, 0xa8, 0xac, 0xb6, 0x71, 0x64, 0xbb, 0x81, 0xbc, 0x05, 0xbc, 0x01, 0x47, 0x8e, 0x3c,
+	0x46, 0x8f, 0x3d, 0x72, 0x42, 0xa8, 0x15, 0x82, 0xc7, 0x40, 0x19, 0x3b, 0x81, 0x4a, 0x5c, 0xac,
+	0x99, 0xcf, 0x3f, 0x7f, 0xf2, 0x7c, 0x1a, 0x1a, 0xeb, 0x5c, 0x96, 0x46, 0xf1, 0xdc, 0xa4, 0x95,
+	0x14, 0xa5, 0xd1, 0x69, 0x7d, 0x96, 0xd6, 0xb0, 0x90, 0xb9, 0x30, 0x4d, 0x52, 0x29, 0x69, 0x24,
+	0xbb, 0xde, 0x33, 0x89, 0x65, 0x92, 0xfa, 0xec, 0xf4, 0x1a, 0x5f, 0x8a, 0x52, 0xa6, 0x78, 0x5a,
+	0xee, 0xf4, 0xc6, 0x4c, 0xce, 0x24, 0x96, 0x69, 0x5b, 0x59, 0x35, 0xfe, 0x49, 0xe8, 0xe8, 0x8d,
+	0x33, 0x7c, 0x26, 0x96, 0xc2, 0x68, 0x36, 0xa6, 0xc3, 0x82, 0x8b, 0x45, 0x33, 0xd1, 0x15, 0x94,
+	0x45, 0x48, 0x6e, 0x91, 0xfb, 0x7e, 0x46, 0x51, 0x7a, 0xd9, 0x2a, 0xec, 0x36, 0x3d, 0x7a, 0x0f,
+	0x30, 0xef, 0x89, 0x03, 0x24, 0x86, 0x56, 0xb3, 0xc8, 0x5d, 0x3a, 0xb2, 0x1e, 0x46, 0xf1, 0x52,
+	0xbf, 0x03, 0x15, 0x1e, 0x22, 0x74, 0x8c, 0xea, 0x2b, 0x27, 0xb2, 0x7b, 0xf4, 0x8a, 0x73, 0xea,
+	0x39, 0x1f, 0xb9, 0x91, 0x95, 0x7b, 0xf0, 0x11, 0xbd, 0x69, 0xfd, 0x84, 0xd6, 0x2b, 0x98, 0x54,
+	0xa0, 0x26, 0x0a, 0x72, 0x51, 0x09, 0x28, 0x4d, 0x78, 0x09, 0x9f, 0x9c, 0x20, 0xf0, 0xb4, 0xbd,
+	0x7f, 0x01, 0x2a, 0xeb, 0x6e, 0x1f, 0xfb, 0xbf, 0x3f, 0x8f, 0x49, 0x6c, 0xe8, 0xd5, 0x6e, 0xcc,
+	0xe7, 0x35, 0x28, 0x25, 0x0a, 0x60, 0x21, 0xbd, 0xcc, 0x8b, 0x42, 0x81, 0xd6, 0x38, 0x64, 0x90,
+	0x75, 0x2d, 0x7b, 0x42, 0x07, 0x0b, 0x0c, 0x03, 0x67, 0x1b, 0x9e, 0xdf, 0x49, 0xfe, 0x13, 0x72,
+	0xb2, 0x9f, 0xdb, 0x45, 0xb0, 0xfe, 0x3e, 0xf6, 0xbe, 0xfc, 0xfa, 0xfa, 0x80, 0x64, 0xee, 0x75,
+	0xfc, 0x89, 0xd0, 0xe3, 0x8e, 0x7a, 0xad, 0xf9, 0x0c, 0x18, 0xa3, 0xfe, 0x5c, 0xb8, 0x54, 0x83,
+	0x0c, 0xeb, 0x7f, 0xff, 0x71, 0xb0, 0xff, 0x8f, 0x98, 0x1e, 0xe5, 0x72, 0x55, 0x1a, 0x50, 0x15,
+	0x57, 0xa6, 0xc1, 0x10, 0x83, 0x6c, 0x4f, 0x63, 0x27, 0x74, 0x30, 0x5d, 0xe5, 0x73, 0x30, 0x18,
+	0xdd, 0x61, 0xe6, 0xba, 0x56, 0xe7, 0xcb, 0x16, 0x74, 0xf9, 0xb8, 0xee, 0xe2, 0x7c, 0xbd, 0x8d,
+	0xc8, 0x66, 0x1b, 0x91, 0x1f, 0xdb, 0x88, 0x7c, 0xdc, 0x45, 0xde, 0x66, 0x17, 0x79, 0xdf, 0x76,
+	0x91, 0xf7, 0x36, 0xfc, 0xbb, 0x6d, 0x1f, 0xba, 0x7d, 0x33, 0x4d, 0x05, 0x7a, 0x3a, 0xc0, 0x65,
+	0x79, 0xf8, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x7e, 0xf8, 0xa2, 0x90, 0x02, 0x00, 0x00,
+}
+
+func (this *VelocityLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VelocityLimits)
+	if !ok {
+		that2, ok := that.(VelocityLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DailySpend != that1.DailySpend {
+		return false
+	}
+	if this.WeeklySpend != that1.WeeklySpend {
+		return false
+	}
+	if this.DailyTransfer != that1.DailyTransfer {
+		return false
+	}
+	if this.WeeklyTransfer != that1.WeeklyTransfer {
+		return false
+	}
+	if this.DailyIssuePerRecipient != that1.DailyIssuePerRecipient {
+		return false
+	}
+	return true
+}
+func (m *VelocityLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VelocityLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VelocityLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DailyIssuePerRecipient != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.DailyIssuePerRecipient))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WeeklyTransfer != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.WeeklyTransfer))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DailyTransfer != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.DailyTransfer))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WeeklySpend != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.WeeklySpend))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DailySpend != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.DailySpend))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VelocityOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VelocityOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VelocityOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVelocity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VelocityUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VelocityUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VelocityUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Bucket != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVelocity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVelocity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VelocityLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DailySpend != 0 {
+		n += 1 + sovVelocity(uint64(m.DailySpend))
+	}
+	if m.WeeklySpend != 0 {
+		n += 1 + sovVelocity(uint64(m.WeeklySpend))
+	}
+	if m.DailyTransfer != 0 {
+		n += 1 + sovVelocity(uint64(m.DailyTransfer))
+	}
+	if m.WeeklyTransfer != 0 {
+		n += 1 + sovVelocity(uint64(m.WeeklyTransfer))
+	}
+	if m.DailyIssuePerRecipient != 0 {
+		n += 1 + sovVelocity(uint64(m.DailyIssuePerRecipient))
+	}
+	return n
+}
+
+func (m *VelocityOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	l = m.Limits.Size()
+	n += 1 + l + sovVelocity(uint64(l))
+	return n
+}
+
+func (m *VelocityUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	if m.Bucket != 0 {
+		n += 1 + sovVelocity(uint64(m.Bucket))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovVelocity(uint64(m.Amount))
+	}
+	return n
+}
+
+func sovVelocity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVelocity(x uint64) (n int) {
+	return sovVelocity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VelocityLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVelocity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VelocityLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VelocityLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySpend", wireType)
+			}
+			m.DailySpend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailySpend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklySpend", wireType)
+			}
+			m.WeeklySpend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeeklySpend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyTransfer", wireType)
+			}
+			m.DailyTransfer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailyTransfer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklyTransfer", wireType)
+			}
+			m.WeeklyTransfer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeeklyTransfer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyIssuePerRecipient", wireType)
+			}
+			m.DailyIssuePerRecipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailyIssuePerRecipient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVelocity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VelocityOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVelocity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VelocityOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VelocityOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVelocity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VelocityUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVelocity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VelocityUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VelocityUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVelocity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVelocity(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVelocity
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVelocity
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVelocity
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVelocity
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVelocity        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVelocity          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVelocity = fmt.Errorf("proto: unexpected end of group")
+)